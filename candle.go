@@ -0,0 +1,436 @@
+package indc
+
+import (
+	"math"
+	"math/big"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Candle holds the price and volume information of a single period.
+type Candle struct {
+	// Timestamp specifies the start of the candle's period.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Open specifies the first price of the period.
+	Open decimal.Decimal `json:"open"`
+
+	// High specifies the highest price of the period.
+	High decimal.Decimal `json:"high"`
+
+	// Low specifies the lowest price of the period.
+	Low decimal.Decimal `json:"low"`
+
+	// Close specifies the last price of the period.
+	Close decimal.Decimal `json:"close"`
+
+	// Volume specifies the traded volume of the period.
+	Volume decimal.Decimal `json:"volume"`
+}
+
+// trueRanges calculates true range of every candle, except the first
+// one, which is only used as the previous candle of the second one.
+func trueRanges(cc []Candle) []decimal.Decimal {
+	if len(cc) < 2 {
+		return nil
+	}
+
+	res := make([]decimal.Decimal, len(cc)-1)
+
+	for i := 1; i < len(cc); i++ {
+		res[i-1] = decimal.Max(
+			cc[i].High.Sub(cc[i].Low),
+			cc[i].High.Sub(cc[i-1].Close).Abs(),
+			cc[i].Low.Sub(cc[i-1].Close).Abs(),
+		)
+	}
+
+	return res
+}
+
+// typicalPrice calculates the average of the high, low and close prices
+// of the candle.
+func typicalPrice(c Candle) decimal.Decimal {
+	return c.High.Add(c.Low).Add(c.Close).Div(decimal.NewFromInt(3))
+}
+
+// weightedClose calculates the average of the high, low and twice
+// weighted close prices of the candle.
+func weightedClose(c Candle) decimal.Decimal {
+	return c.High.Add(c.Low).Add(c.Close.Mul(decimal.NewFromInt(2))).Div(decimal.NewFromInt(4))
+}
+
+// calcPrices calculates the indicator from the prices of the provided
+// candles slice. If the indicator is nil, the price of the newest candle
+// is returned.
+func calcPrices(cc []Candle, price func(Candle) decimal.Decimal, ind Indicator) (decimal.Decimal, error) {
+	if ind == nil {
+		return price(cc[len(cc)-1]), nil
+	}
+
+	dd := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		dd[i] = price(cc[i])
+	}
+
+	return ind.Calc(dd)
+}
+
+// describePrices returns structured information about the indicator that
+// is calculated from candle prices.
+func describePrices(name string, ind Indicator) Description {
+	desc := Description{
+		Overlay: true,
+	}
+
+	if ind != nil {
+		desc = describe(ind)
+	}
+
+	desc.Name = name
+	desc.Input = InputCandle
+
+	return desc
+}
+
+// moneyFlowMultiplier calculates where the close price of the candle is
+// located within its range, from -1 (at the low) to 1 (at the high).
+func moneyFlowMultiplier(c Candle) decimal.Decimal {
+	rng := c.High.Sub(c.Low)
+	if rng.Equal(decimal.Zero) {
+		return decimal.Zero
+	}
+
+	return c.Close.Sub(c.Low).Sub(c.High.Sub(c.Close)).Div(rng)
+}
+
+// medianPrice calculates the average of the high and low prices of the
+// candle.
+func medianPrice(c Candle) decimal.Decimal {
+	return c.High.Add(c.Low).Div(decimal.NewFromInt(2))
+}
+
+// directionalMovements calculates positive and negative directional
+// movements of every candle, except the first one, which is only used as
+// the previous candle of the second one.
+func directionalMovements(cc []Candle) (plus, minus []decimal.Decimal) {
+	if len(cc) < 2 {
+		return nil, nil
+	}
+
+	plus = make([]decimal.Decimal, len(cc)-1)
+	minus = make([]decimal.Decimal, len(cc)-1)
+
+	for i := 1; i < len(cc); i++ {
+		up := cc[i].High.Sub(cc[i-1].High)
+		down := cc[i-1].Low.Sub(cc[i].Low)
+
+		plus[i-1], minus[i-1] = decimal.Zero, decimal.Zero
+
+		switch {
+		case up.GreaterThan(down) && up.GreaterThan(decimal.Zero):
+			plus[i-1] = up
+		case down.GreaterThan(up) && down.GreaterThan(decimal.Zero):
+			minus[i-1] = down
+		}
+	}
+
+	return plus, minus
+}
+
+// directionalIndexes calculates positive and negative directional
+// indexes from the provided candles slice. The amount of candles must
+// match the smoothing's count plus one.
+func directionalIndexes(cc []Candle, length int, smoothing Smoothing) (plus, minus decimal.Decimal, err error) {
+	tr, err := smoothing.calc(trueRanges(cc), length)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+
+	if tr.Equal(decimal.Zero) {
+		return decimal.Zero, decimal.Zero, nil
+	}
+
+	pdm, mdm := directionalMovements(cc)
+
+	plus, err = smoothing.calc(pdm, length)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, decimal.Zero, err
+	}
+
+	minus, err = smoothing.calc(mdm, length)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, decimal.Zero, err
+	}
+
+	return plus.Div(tr).Mul(_hundred), minus.Div(tr).Mul(_hundred), nil
+}
+
+// extremes finds the highest high and the lowest low prices of the
+// provided candles slice.
+func extremes(cc []Candle) (high, low decimal.Decimal) {
+	if len(cc) == 0 {
+		return decimal.Zero, decimal.Zero
+	}
+
+	high, low = cc[0].High, cc[0].Low
+
+	for i := 1; i < len(cc); i++ {
+		high = decimal.Max(high, cc[i].High)
+		low = decimal.Min(low, cc[i].Low)
+	}
+
+	return high, low
+}
+
+// midpoint calculates the average of the highest high and the lowest low
+// prices of the provided candles slice.
+func midpoint(cc []Candle) decimal.Decimal {
+	high, low := extremes(cc)
+
+	return high.Add(low).Div(decimal.NewFromInt(2))
+}
+
+// closes extracts the close price of every candle of the provided candles
+// slice.
+func closes(cc []Candle) []decimal.Decimal {
+	res := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		res[i] = cc[i].Close
+	}
+
+	return res
+}
+
+// volumes extracts the volume of every candle of the provided candles
+// slice.
+func volumes(cc []Candle) []decimal.Decimal {
+	res := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		res[i] = cc[i].Volume
+	}
+
+	return res
+}
+
+// volumeIndex accumulates negative or positive volume index one candle at
+// a time.
+type volumeIndex struct {
+	// started specifies whether at least one candle was added.
+	started bool
+
+	// prev specifies the latest added candle.
+	prev Candle
+
+	// value specifies the accumulated index value.
+	value decimal.Decimal
+}
+
+// add adds the next candle to the index and returns its updated value.
+// The index starts at 1000 and only changes when the volume decreases
+// (negative index) or increases (positive index).
+func (vi *volumeIndex) add(c Candle, positive bool) decimal.Decimal {
+	if !vi.started {
+		vi.started = true
+		vi.prev = c
+		vi.value = decimal.NewFromInt(1000)
+
+		return vi.value
+	}
+
+	changed := c.Volume.LessThan(vi.prev.Volume)
+	if positive {
+		changed = c.Volume.GreaterThan(vi.prev.Volume)
+	}
+
+	if changed && !vi.prev.Close.Equal(decimal.Zero) {
+		vi.value = vi.value.Mul(c.Close).Div(vi.prev.Close)
+	}
+
+	vi.prev = c
+
+	return vi.value
+}
+
+// VolumeIndexLines holds all lines calculated by NVI and PVI.
+type VolumeIndexLines struct {
+	// Index specifies the volume index line.
+	Index decimal.Decimal `json:"index"`
+
+	// Signal specifies the exponential moving average of the index
+	// line. It is zero when no signal line is configured.
+	Signal decimal.Decimal `json:"signal"`
+}
+
+// calcVolumeIndex calculates negative or positive volume index of the
+// provided candles slice together with its optional signal line.
+func calcVolumeIndex(cc []Candle, positive bool, signal EMA) (VolumeIndexLines, error) {
+	var vi volumeIndex
+
+	dd := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		dd[i] = vi.add(cc[i], positive)
+	}
+
+	res := VolumeIndexLines{
+		Index: vi.value,
+	}
+
+	if !signal.valid {
+		return res, nil
+	}
+
+	var err error
+
+	res.Signal, err = signal.Calc(dd[len(dd)-signal.Count():])
+	if err != nil {
+		return VolumeIndexLines{}, err
+	}
+
+	return res, nil
+}
+
+// MissingRange holds a range of consecutive candles that are missing
+// from a candle series.
+type MissingRange struct {
+	// Start specifies the timestamp of the first missing candle.
+	Start time.Time `json:"start"`
+
+	// End specifies the timestamp of the last missing candle.
+	End time.Time `json:"end"`
+
+	// Count specifies how many candles are missing. It saturates at
+	// math.MaxInt64.
+	Count int64 `json:"count"`
+}
+
+// Report holds all data quality issues found in a candle series.
+type Report struct {
+	// Missing specifies the ranges of the candles that are missing from
+	// the series.
+	Missing []MissingRange `json:"missing,omitempty"`
+
+	// Unordered specifies the indexes of the candles which timestamp is
+	// before the latest timestamp of the preceding candles.
+	Unordered []int `json:"unordered,omitempty"`
+
+	// Duplicate specifies the indexes of the candles which timestamp
+	// was already used by one of the preceding candles.
+	Duplicate []int `json:"duplicate,omitempty"`
+
+	// ZeroVolume specifies the indexes of the candles that have no
+	// volume.
+	ZeroVolume []int `json:"zero_volume,omitempty"`
+
+	// Inconsistent specifies the indexes of the candles which high price
+	// is lower than their low price.
+	Inconsistent []int `json:"inconsistent,omitempty"`
+}
+
+// OK checks whether the report contains no issues.
+func (r Report) OK() bool {
+	return len(r.Missing) == 0 && len(r.Unordered) == 0 &&
+		len(r.Duplicate) == 0 && len(r.ZeroVolume) == 0 &&
+		len(r.Inconsistent) == 0
+}
+
+// CheckCandles scans the candle series and reports missing, unordered,
+// duplicate, zero volume and inconsistent candles.
+// Interval specifies the expected duration between two consecutive
+// candles, if it is not positive, missing candles are not reported.
+func CheckCandles(cc []Candle, interval time.Duration) Report {
+	var (
+		r    Report
+		last time.Time
+	)
+
+	seen := make(map[int64]struct{}, len(cc))
+
+	for i := range cc {
+		key := cc[i].Timestamp.UnixNano()
+
+		if _, ok := seen[key]; ok {
+			r.Duplicate = append(r.Duplicate, i)
+		}
+
+		seen[key] = struct{}{}
+
+		if cc[i].Volume.Equal(decimal.Zero) {
+			r.ZeroVolume = append(r.ZeroVolume, i)
+		}
+
+		if cc[i].High.LessThan(cc[i].Low) {
+			r.Inconsistent = append(r.Inconsistent, i)
+		}
+
+		if i == 0 {
+			last = cc[i].Timestamp
+			continue
+		}
+
+		if cc[i].Timestamp.Before(last) {
+			r.Unordered = append(r.Unordered, i)
+			continue
+		}
+
+		if interval > 0 {
+			if mr, ok := missingRange(last, cc[i].Timestamp, interval); ok {
+				r.Missing = append(r.Missing, mr)
+			}
+		}
+
+		last = cc[i].Timestamp
+	}
+
+	return r
+}
+
+// missingRange determines the range of the candles that are missing
+// between the two provided timestamps. The calculation is done in
+// nanoseconds with arbitrary precision, so that gaps longer than the
+// range of time.Duration, e.g. after a zero timestamp, are reported
+// correctly.
+func missingRange(from, to time.Time, interval time.Duration) (MissingRange, bool) {
+	nanos := func(t time.Time) *big.Int {
+		res := big.NewInt(t.Unix())
+		res.Mul(res, big.NewInt(int64(time.Second)))
+
+		return res.Add(res, big.NewInt(int64(t.Nanosecond())))
+	}
+
+	step := big.NewInt(int64(interval))
+
+	// the candle at the end of the gap is not missing, hence the
+	// nanosecond that is subtracted from the gap.
+	n := new(big.Int).Sub(nanos(to), nanos(from))
+	n.Sub(n, big.NewInt(1))
+	n.Quo(n, step)
+
+	if n.Sign() <= 0 {
+		return MissingRange{}, false
+	}
+
+	end := new(big.Int).Mul(n, step)
+	end.Add(end, nanos(from))
+
+	sec, nsec := new(big.Int).DivMod(end, big.NewInt(int64(time.Second)), new(big.Int))
+
+	mr := MissingRange{
+		Start: from.Add(interval),
+		End:   time.Unix(sec.Int64(), nsec.Int64()).In(from.Location()),
+		Count: math.MaxInt64,
+	}
+
+	if n.IsInt64() {
+		mr.Count = n.Int64()
+	}
+
+	return mr, true
+}
@@ -0,0 +1,338 @@
+package indc
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// testCandles returns a small set of candles used in calculation tests.
+func testCandles(t *testing.T) []Candle {
+	t.Helper()
+
+	candle := func(o, h, l, c, v int64) Candle {
+		return Candle{
+			Open:   decimal.NewFromInt(o),
+			High:   decimal.NewFromInt(h),
+			Low:    decimal.NewFromInt(l),
+			Close:  decimal.NewFromInt(c),
+			Volume: decimal.NewFromInt(v),
+		}
+	}
+
+	return []Candle{
+		candle(9, 10, 8, 9, 100),
+		candle(9, 11, 9, 10, 200),
+		candle(10, 12, 9, 11, 150),
+		candle(11, 11, 10, 10, 300),
+		candle(10, 15, 11, 14, 250),
+	}
+}
+
+func Test_trueRanges(t *testing.T) {
+	assert.Nil(t, trueRanges(testCandles(t)[:1]))
+
+	res := trueRanges(testCandles(t))
+	assert.Equal(t, []string{"2", "3", "1", "5"}, decimalStrings(res))
+}
+
+func Test_typicalPrice(t *testing.T) {
+	assert.Equal(t, "9", typicalPrice(testCandles(t)[0]).String())
+}
+
+func Test_weightedClose(t *testing.T) {
+	assert.Equal(t, "13.5", weightedClose(testCandles(t)[4]).String())
+}
+
+func Test_calcPrices(t *testing.T) {
+	res, err := calcPrices(testCandles(t), medianPrice, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "13", res.String())
+
+	_, err = calcPrices(testCandles(t)[3:], medianPrice, SMA{length: 2})
+	assert.Equal(t, ErrInvalidIndicator, err)
+
+	res, err = calcPrices(testCandles(t)[3:], medianPrice, SMA{valid: true, length: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, "11.75", res.String())
+}
+
+func Test_describePrices(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    "test",
+		Input:   InputCandle,
+		Overlay: true,
+	}, describePrices("test", nil))
+
+	assertEqualDescription(t, Description{
+		Name:    "test",
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}, describePrices("test", RSI{}))
+}
+
+func Test_moneyFlowMultiplier(t *testing.T) {
+	assert.Equal(t, "0", moneyFlowMultiplier(Candle{}).String())
+	assert.Equal(t, "0.5", moneyFlowMultiplier(testCandles(t)[4]).String())
+}
+
+func Test_medianPrice(t *testing.T) {
+	assert.Equal(t, "10.5", medianPrice(testCandles(t)[2]).String())
+}
+
+func Test_directionalMovements(t *testing.T) {
+	plus, minus := directionalMovements(testCandles(t)[:1])
+	assert.Nil(t, plus)
+	assert.Nil(t, minus)
+
+	cc := []Candle{
+		{High: decimal.NewFromInt(10), Low: decimal.NewFromInt(8)},
+		{High: decimal.NewFromInt(11), Low: decimal.NewFromInt(7)},
+		{High: decimal.NewFromInt(10), Low: decimal.NewFromInt(5)},
+		{High: decimal.NewFromInt(13), Low: decimal.NewFromInt(6)},
+	}
+
+	plus, minus = directionalMovements(cc)
+	assert.Equal(t, []string{"0", "0", "3"}, decimalStrings(plus))
+	assert.Equal(t, []string{"0", "2", "0"}, decimalStrings(minus))
+}
+
+func Test_directionalIndexes(t *testing.T) {
+	cc := map[string]struct {
+		Candles []Candle
+		Plus    string
+		Minus   string
+		Error   error
+	}{
+		"Invalid data size": {
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			Candles: []Candle{{}, {}, {}},
+			Plus:    "0",
+			Minus:   "0",
+		},
+		"Successful calculation": {
+			Candles: []Candle{
+				{High: decimal.NewFromInt(10), Low: decimal.NewFromInt(8), Close: decimal.NewFromInt(9)},
+				{High: decimal.NewFromInt(11), Low: decimal.NewFromInt(7), Close: decimal.NewFromInt(8)},
+				{High: decimal.NewFromInt(10), Low: decimal.NewFromInt(6), Close: decimal.NewFromInt(7)},
+			},
+			Plus:  "0",
+			Minus: "12.5",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			plus, minus, err := directionalIndexes(c.Candles, 2, SmoothingSMA)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Plus, plus.String())
+			assert.Equal(t, c.Minus, minus.String())
+		})
+	}
+}
+
+func Test_extremes(t *testing.T) {
+	high, low := extremes(nil)
+	assert.Equal(t, "0", high.String())
+	assert.Equal(t, "0", low.String())
+
+	high, low = extremes(testCandles(t)[:3])
+	assert.Equal(t, "12", high.String())
+	assert.Equal(t, "8", low.String())
+}
+
+func Test_midpoint(t *testing.T) {
+	assert.Equal(t, "0", midpoint(nil).String())
+	assert.Equal(t, "10", midpoint(testCandles(t)[:3]).String())
+}
+
+func Test_closes(t *testing.T) {
+	assert.Empty(t, closes(nil))
+	assert.Equal(t, []string{"9", "10", "11"}, decimalStrings(closes(testCandles(t)[:3])))
+}
+
+func Test_volumes(t *testing.T) {
+	assert.Empty(t, volumes(nil))
+	assert.Equal(t, []string{"100", "200", "150"}, decimalStrings(volumes(testCandles(t)[:3])))
+}
+
+func Test_volumeIndex_add(t *testing.T) {
+	var nvi, pvi volumeIndex
+
+	nn := make([]decimal.Decimal, 0, 6)
+	pp := make([]decimal.Decimal, 0, 6)
+
+	cc := append(testCandles(t), Candle{
+		Volume: decimal.NewFromInt(100),
+	}, Candle{
+		Close:  decimal.NewFromInt(10),
+		Volume: decimal.NewFromInt(50),
+	})
+
+	for i := range cc {
+		nn = append(nn, nvi.add(cc[i], false))
+		pp = append(pp, pvi.add(cc[i], true).Round(2))
+	}
+
+	assert.Equal(t, []string{"1000", "1000", "1100", "1100", "1540", "0", "0"}, decimalStrings(nn))
+	assert.Equal(t, []string{"1000", "1111.11", "1111.11", "1010.1", "1010.1", "1010.1", "1010.1"}, decimalStrings(pp))
+}
+
+func Test_calcVolumeIndex(t *testing.T) {
+	signal := EMA{valid: true, sma: SMA{valid: true, length: 2}}
+
+	res, err := calcVolumeIndex(testCandles(t), false, EMA{})
+	assert.NoError(t, err)
+	assert.Equal(t, "1540", res.Index.String())
+	assert.Equal(t, "0", res.Signal.String())
+
+	_, err = calcVolumeIndex(testCandles(t), false, EMA{valid: true, sma: SMA{length: 2}})
+	assert.Equal(t, ErrInvalidIndicator, err)
+
+	res, err = calcVolumeIndex(testCandles(t), false, signal)
+	assert.NoError(t, err)
+	assert.Equal(t, "1540", res.Index.String())
+	assert.Equal(t, "1393.33333333", res.Signal.Round(8).String())
+}
+
+func Test_Report_OK(t *testing.T) {
+	assert.True(t, Report{}.OK())
+	assert.False(t, Report{Missing: []MissingRange{{}}}.OK())
+	assert.False(t, Report{Unordered: []int{1}}.OK())
+	assert.False(t, Report{Duplicate: []int{1}}.OK())
+	assert.False(t, Report{ZeroVolume: []int{1}}.OK())
+	assert.False(t, Report{Inconsistent: []int{1}}.OK())
+}
+
+func Test_CheckCandles(t *testing.T) {
+	ts := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	candle := func(min int, high, low, vol int64) Candle {
+		return Candle{
+			Timestamp: ts.Add(time.Duration(min) * time.Minute),
+			High:      decimal.NewFromInt(high),
+			Low:       decimal.NewFromInt(low),
+			Volume:    decimal.NewFromInt(vol),
+		}
+	}
+
+	cc := map[string]struct {
+		Candles  []Candle
+		Interval time.Duration
+		Result   Report
+	}{
+		"Empty series": {},
+		"Valid series": {
+			Candles: []Candle{
+				candle(0, 2, 1, 5),
+				candle(1, 3, 1, 5),
+				candle(2, 3, 3, 5),
+			},
+			Interval: time.Minute,
+		},
+		"Missing candles are not checked without interval": {
+			Candles: []Candle{
+				candle(0, 2, 1, 5),
+				candle(3, 3, 1, 5),
+			},
+		},
+		"Series with a zero timestamp": {
+			Candles: []Candle{
+				{
+					High:   decimal.NewFromInt(2),
+					Low:    decimal.NewFromInt(1),
+					Volume: decimal.NewFromInt(5),
+				},
+				candle(0, 2, 1, 5),
+			},
+			Interval: time.Minute,
+			Result: Report{
+				Missing: []MissingRange{
+					{
+						Start: time.Time{}.Add(time.Minute),
+						End:   ts.Add(-time.Minute),
+						Count: (ts.Unix()-time.Time{}.Unix())/60 - 1,
+					},
+				},
+			},
+		},
+		"Series with issues": {
+			Candles: []Candle{
+				candle(0, 2, 1, 5),
+				candle(3, 1, 2, 5),
+				candle(3, 3, 1, 0),
+				candle(1, 3, 1, 5),
+				candle(4, 3, 1, 5),
+			},
+			Interval: time.Minute,
+			Result: Report{
+				Missing: []MissingRange{
+					{
+						Start: ts.Add(time.Minute),
+						End:   ts.Add(2 * time.Minute),
+						Count: 2,
+					},
+				},
+				Unordered:    []int{3},
+				Duplicate:    []int{2},
+				ZeroVolume:   []int{2},
+				Inconsistent: []int{1},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, c.Result, CheckCandles(c.Candles, c.Interval))
+		})
+	}
+}
+
+func Test_missingRange(t *testing.T) {
+	ts := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, ok := missingRange(ts, ts.Add(time.Minute), time.Minute)
+	assert.False(t, ok)
+
+	_, ok = missingRange(ts, ts.Add(90*time.Second), time.Minute)
+	assert.True(t, ok)
+
+	mr, ok := missingRange(ts, ts.Add(150*time.Second), time.Minute)
+	assert.True(t, ok)
+	assert.Equal(t, MissingRange{
+		Start: ts.Add(time.Minute),
+		End:   ts.Add(2 * time.Minute),
+		Count: 2,
+	}, mr)
+
+	mr, ok = missingRange(time.Time{}, ts, time.Minute)
+	assert.True(t, ok)
+	assert.Equal(t, time.Time{}.Add(time.Minute), mr.Start)
+	assert.Equal(t, ts.Add(-time.Minute), mr.End)
+	assert.Equal(t, (ts.Unix()-time.Time{}.Unix())/60-1, mr.Count)
+
+	mr, ok = missingRange(time.Time{}, ts, time.Nanosecond)
+	assert.True(t, ok)
+	assert.Equal(t, ts.Add(-time.Nanosecond), mr.End)
+	assert.Equal(t, int64(math.MaxInt64), mr.Count)
+}
@@ -0,0 +1,107 @@
+package indc
+
+import (
+	"math"
+
+	"github.com/shopspring/decimal"
+)
+
+// Point holds a single data point of a series together with its index
+// in the original series.
+type Point struct {
+	// Index specifies the position of the data point in the original
+	// series.
+	Index int `json:"index"`
+
+	// Value specifies the value of the data point.
+	Value decimal.Decimal `json:"value"`
+}
+
+// Stride downsamples the series by keeping every n-th data point.
+// The last data point is always kept so that the downsampled series ends
+// at the same position as the original one.
+func Stride(dd []decimal.Decimal, n int) ([]Point, error) {
+	if n < 1 {
+		return nil, ErrInvalidLength
+	}
+
+	res := make([]Point, 0, len(dd)/n+1)
+
+	for i := 0; i < len(dd); i += n {
+		res = append(res, Point{Index: i, Value: dd[i]})
+	}
+
+	if len(dd) > 0 && res[len(res)-1].Index != len(dd)-1 {
+		res = append(res, Point{Index: len(dd) - 1, Value: dd[len(dd)-1]})
+	}
+
+	return res, nil
+}
+
+// LTTB downsamples the series to the threshold amount of data points
+// while preserving its visual shape.
+// If the series contains fewer data points than the threshold, all of
+// them are returned.
+// Algorithm is based on the thesis by Sveinn Steinarsson.
+// https://skemman.is/handle/1946/15343.
+func LTTB(dd []decimal.Decimal, threshold int) ([]Point, error) {
+	if threshold < 3 {
+		return nil, ErrInvalidLength
+	}
+
+	if threshold >= len(dd) {
+		res := make([]Point, len(dd))
+		for i := range dd {
+			res[i] = Point{Index: i, Value: dd[i]}
+		}
+
+		return res, nil
+	}
+
+	every := float64(len(dd)-2) / float64(threshold-2)
+	bucket := func(i int) int {
+		return int(math.Floor(float64(i)*every)) + 1
+	}
+
+	res := make([]Point, 0, threshold)
+	res = append(res, Point{Index: 0, Value: dd[0]})
+
+	a := 0
+
+	for i := 0; i < threshold-2; i++ {
+		next, end := bucket(i+1), bucket(i+2)
+		if end > len(dd) {
+			end = len(dd)
+		}
+
+		avgX := decimal.NewFromInt(int64(next + end - 1)).Div(decimal.NewFromInt(2))
+		avgY := avg(dd[next:end])
+
+		ax := decimal.NewFromInt(int64(a))
+		ay := dd[a]
+
+		sel := bucket(i)
+		area := decimal.NewFromInt(-1)
+
+		for j := bucket(i); j < next; j++ {
+			jx := decimal.NewFromInt(int64(j))
+
+			// the area is doubled, but it doesn't matter during the
+			// comparison.
+			jarea := ax.Sub(avgX).Mul(dd[j].Sub(ay)).
+				Sub(ax.Sub(jx).Mul(avgY.Sub(ay))).Abs()
+
+			if jarea.GreaterThan(area) {
+				area = jarea
+				sel = j
+			}
+		}
+
+		res = append(res, Point{Index: sel, Value: dd[sel]})
+		a = sel
+	}
+
+	res = append(res, Point{Index: len(dd) - 1, Value: dd[len(dd)-1]})
+
+	return res, nil
+}
@@ -0,0 +1,118 @@
+package indc
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Stride(t *testing.T) {
+	cc := map[string]struct {
+		Data   []decimal.Decimal
+		N      int
+		Result []Point
+		Error  error
+	}{
+		"Invalid stride": {
+			Error: ErrInvalidLength,
+		},
+		"Empty series": {
+			N:      2,
+			Result: []Point{},
+		},
+		"Last data point is included": {
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(4),
+			},
+			N: 2,
+			Result: []Point{
+				{Index: 0, Value: decimal.NewFromInt(1)},
+				{Index: 2, Value: decimal.NewFromInt(3)},
+				{Index: 3, Value: decimal.NewFromInt(4)},
+			},
+		},
+		"Last data point matches the stride": {
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(3),
+			},
+			N: 2,
+			Result: []Point{
+				{Index: 0, Value: decimal.NewFromInt(1)},
+				{Index: 2, Value: decimal.NewFromInt(3)},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := Stride(c.Data, c.N)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_LTTB(t *testing.T) {
+	cc := map[string]struct {
+		Data      []decimal.Decimal
+		Threshold int
+		Result    []Point
+		Error     error
+	}{
+		"Invalid threshold": {
+			Threshold: 2,
+			Error:     ErrInvalidLength,
+		},
+		"Threshold exceeds data size": {
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(2),
+			},
+			Threshold: 3,
+			Result: []Point{
+				{Index: 0, Value: decimal.NewFromInt(1)},
+				{Index: 1, Value: decimal.NewFromInt(2)},
+			},
+		},
+		"Successful downsampling": {
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(8),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(4),
+			},
+			Threshold: 4,
+			Result: []Point{
+				{Index: 0, Value: decimal.NewFromInt(1)},
+				{Index: 1, Value: decimal.NewFromInt(5)},
+				{Index: 3, Value: decimal.NewFromInt(8)},
+				{Index: 6, Value: decimal.NewFromInt(4)},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := LTTB(c.Data, c.Threshold)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
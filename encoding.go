@@ -0,0 +1,4593 @@
+package indc
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/shopspring/decimal"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Available indicator names that are used to distinguish indicators
+// when they are encoded.
+const (
+	NameADX                  = "adx"
+	NameAlligator            = "alligator"
+	NameALMA                 = "alma"
+	NameAPO                  = "apo"
+	NameAroon                = "aroon"
+	NameAroonOsc             = "aroonosc"
+	NameATR                  = "atr"
+	NameBB                   = "bb"
+	NameBBW                  = "bbw"
+	NameBeta                 = "beta"
+	NameBOP                  = "bop"
+	NameCalmar               = "calmar"
+	NameCandleStats          = "candle_stats"
+	NameCCI                  = "cci"
+	NameCMF                  = "cmf"
+	NameCMO                  = "cmo"
+	NameCorrelation          = "correlation"
+	NameDecycler             = "decycler"
+	NameDEMA                 = "dema"
+	NameDMI                  = "dmi"
+	NameDonchian             = "donchian"
+	NameDonchianWidth        = "donchian_width"
+	NameDYMI                 = "dymi"
+	NameElderRay             = "elder_ray"
+	NameEMA                  = "ema"
+	NameER                   = "er"
+	NameErgodic              = "ergodic"
+	NameForceIndex           = "force_index"
+	NameFRAMA                = "frama"
+	NameGap                  = "gap"
+	NameGator                = "gator"
+	NameGMMA                 = "gmma"
+	NameHighest              = "highest"
+	NameHilbertPeriod        = "hilbert_period"
+	NameHMA                  = "hma"
+	NameIchimoku             = "ichimoku"
+	NameIntradayIntensity    = "intraday_intensity"
+	NameKeltner              = "keltner"
+	NameKeltnerWidth         = "keltner_width"
+	NameKST                  = "kst"
+	NameKurtosis             = "kurtosis"
+	NameLinReg               = "linreg"
+	NameLowest               = "lowest"
+	NameLSMA                 = "lsma"
+	NameMACD                 = "macd"
+	NameMcGinley             = "mcginley"
+	NameMedianPrice          = "median_price"
+	NameMFI                  = "mfi"
+	NameNormalize            = "normalize"
+	NameNVI                  = "nvi"
+	NameOBV                  = "obv"
+	NameOffset               = "offset"
+	NamePercentB             = "percent_b"
+	NamePeriodogram          = "periodogram"
+	NamePivots               = "pivots"
+	NamePPO                  = "ppo"
+	NameProjectionBands      = "projection_bands"
+	NameProjectionOscillator = "projection_oscillator"
+	NamePVI                  = "pvi"
+	NamePVO                  = "pvo"
+	NameQstick               = "qstick"
+	NameRainbow              = "rainbow"
+	NameRibbon               = "ribbon"
+	NameROC                  = "roc"
+	NameRSC                  = "rsc"
+	NameRSI                  = "rsi"
+	NameSavitzkyGolay        = "savitzky_golay"
+	NameSharpe               = "sharpe"
+	NameSkew                 = "skew"
+	NameSMA                  = "sma"
+	NameSMI                  = "smi"
+	NameSMMA                 = "smma"
+	NameSortino              = "sortino"
+	NameSource               = "source"
+	NameSRSI                 = "srsi"
+	NameStoch                = "stoch"
+	NameStochFull            = "stoch_full"
+	NameStochOf              = "stoch_of"
+	NameSuperSmoother        = "super_smoother"
+	NameT3                   = "t3"
+	NameTDSequential         = "td_sequential"
+	NameTEMA                 = "tema"
+	NameTSI                  = "tsi"
+	NameTTMSqueeze           = "ttm_squeeze"
+	NameTypicalPrice         = "typical_price"
+	NameUlcer                = "ulcer"
+	NameVHF                  = "vhf"
+	NameVolumeProfile        = "volume_profile"
+	NameVWMA                 = "vwma"
+	NameVWMACD               = "vwmacd"
+	NameWeightedClose        = "weighted_close"
+	NameWeisWave             = "weis_wave"
+	NameWillR                = "willr"
+	NameWMA                  = "wma"
+	NameZLEMA                = "zlema"
+)
+
+// Available stream names that are used to distinguish stream state
+// snapshots when they are encoded.
+const (
+	NameEMAStream   = "ema_stream"
+	NameNVIStream   = "nvi_stream"
+	NameOBVStream   = "obv_stream"
+	NamePVIStream   = "pvi_stream"
+	NameRSIStream   = "rsi_stream"
+	NameSMAStream   = "sma_stream"
+	NameStochStream = "stoch_stream"
+)
+
+// spec holds the encodable configuration of a single indicator.
+type spec interface {
+	// name should return the unique name of the indicator.
+	name() string
+
+	// build should validate the configuration and create a new indicator
+	// from it.
+	build() (interface{}, error)
+}
+
+// specifier is implemented by every indicator whose configuration can be
+// encoded.
+type specifier interface {
+	// spec should return the encodable configuration of the indicator.
+	spec() spec
+}
+
+// newSpec creates an empty spec of the indicator with the provided name.
+func newSpec(name string) (spec, error) {
+	switch name {
+	case NameADX:
+		return &adxSpec{}, nil
+	case NameAlligator:
+		return &alligatorSpec{}, nil
+	case NameALMA:
+		return &almaSpec{}, nil
+	case NameAPO:
+		return &apoSpec{}, nil
+	case NameAroon:
+		return &aroonSpec{}, nil
+	case NameAroonOsc:
+		return &aroonOscSpec{}, nil
+	case NameATR:
+		return &atrSpec{}, nil
+	case NameBB:
+		return &bbSpec{}, nil
+	case NameBBW:
+		return &bbwSpec{}, nil
+	case NameBeta:
+		return &betaSpec{}, nil
+	case NameBOP:
+		return &bopSpec{}, nil
+	case NameCalmar:
+		return &calmarSpec{}, nil
+	case NameCandleStats:
+		return &candleStatsSpec{}, nil
+	case NameCCI:
+		return &cciSpec{}, nil
+	case NameCMF:
+		return &cmfSpec{}, nil
+	case NameCMO:
+		return &cmoSpec{}, nil
+	case NameCorrelation:
+		return &correlationSpec{}, nil
+	case NameDecycler:
+		return &decyclerSpec{}, nil
+	case NameDEMA:
+		return &demaSpec{}, nil
+	case NameDMI:
+		return &dmiSpec{}, nil
+	case NameDonchian:
+		return &donchianSpec{}, nil
+	case NameDonchianWidth:
+		return &donchianWidthSpec{}, nil
+	case NameDYMI:
+		return &dymiSpec{}, nil
+	case NameElderRay:
+		return &elderRaySpec{}, nil
+	case NameEMA:
+		return &emaSpec{}, nil
+	case NameER:
+		return &erSpec{}, nil
+	case NameErgodic:
+		return &ergodicSpec{}, nil
+	case NameForceIndex:
+		return &forceIndexSpec{}, nil
+	case NameFRAMA:
+		return &framaSpec{}, nil
+	case NameGap:
+		return &gapSpec{}, nil
+	case NameGator:
+		return &gatorSpec{}, nil
+	case NameGMMA:
+		return &gmmaSpec{}, nil
+	case NameHighest:
+		return &highestSpec{}, nil
+	case NameHilbertPeriod:
+		return &hilbertPeriodSpec{}, nil
+	case NameHMA:
+		return &hmaSpec{}, nil
+	case NameIchimoku:
+		return &ichimokuSpec{}, nil
+	case NameIntradayIntensity:
+		return &intradayIntensitySpec{}, nil
+	case NameKeltner:
+		return &keltnerSpec{}, nil
+	case NameKeltnerWidth:
+		return &keltnerWidthSpec{}, nil
+	case NameKST:
+		return &kstSpec{}, nil
+	case NameKurtosis:
+		return &kurtosisSpec{}, nil
+	case NameLinReg:
+		return &linRegSpec{}, nil
+	case NameLowest:
+		return &lowestSpec{}, nil
+	case NameLSMA:
+		return &lsmaSpec{}, nil
+	case NameMACD:
+		return &macdSpec{}, nil
+	case NameMcGinley:
+		return &mcGinleySpec{}, nil
+	case NameMedianPrice:
+		return &medianPriceSpec{}, nil
+	case NameMFI:
+		return &mfiSpec{}, nil
+	case NameNormalize:
+		return &normalizeSpec{}, nil
+	case NameNVI:
+		return &nviSpec{}, nil
+	case NameOBV:
+		return &obvSpec{}, nil
+	case NameOffset:
+		return &offsetSpec{}, nil
+	case NamePercentB:
+		return &percentBSpec{}, nil
+	case NamePeriodogram:
+		return &periodogramSpec{}, nil
+	case NamePivots:
+		return &pivotsSpec{}, nil
+	case NamePPO:
+		return &ppoSpec{}, nil
+	case NameProjectionBands:
+		return &projectionBandsSpec{}, nil
+	case NameProjectionOscillator:
+		return &projectionOscillatorSpec{}, nil
+	case NamePVI:
+		return &pviSpec{}, nil
+	case NamePVO:
+		return &pvoSpec{}, nil
+	case NameQstick:
+		return &qstickSpec{}, nil
+	case NameRainbow:
+		return &rainbowSpec{}, nil
+	case NameRibbon:
+		return &ribbonSpec{}, nil
+	case NameROC:
+		return &rocSpec{}, nil
+	case NameRSC:
+		return &rscSpec{}, nil
+	case NameRSI:
+		return &rsiSpec{}, nil
+	case NameSavitzkyGolay:
+		return &savitzkyGolaySpec{}, nil
+	case NameSharpe:
+		return &sharpeSpec{}, nil
+	case NameSkew:
+		return &skewSpec{}, nil
+	case NameSMA:
+		return &smaSpec{}, nil
+	case NameSMI:
+		return &smiSpec{}, nil
+	case NameSMMA:
+		return &smmaSpec{}, nil
+	case NameSortino:
+		return &sortinoSpec{}, nil
+	case NameSource:
+		return &sourceSpec{}, nil
+	case NameSRSI:
+		return &srsiSpec{}, nil
+	case NameStoch:
+		return &stochSpec{}, nil
+	case NameStochFull:
+		return &stochFullSpec{}, nil
+	case NameStochOf:
+		return &stochOfSpec{}, nil
+	case NameSuperSmoother:
+		return &superSmootherSpec{}, nil
+	case NameT3:
+		return &t3Spec{}, nil
+	case NameTDSequential:
+		return &tdSequentialSpec{}, nil
+	case NameTEMA:
+		return &temaSpec{}, nil
+	case NameTSI:
+		return &tsiSpec{}, nil
+	case NameTTMSqueeze:
+		return &ttmSqueezeSpec{}, nil
+	case NameTypicalPrice:
+		return &typicalPriceSpec{}, nil
+	case NameUlcer:
+		return &ulcerSpec{}, nil
+	case NameVHF:
+		return &vhfSpec{}, nil
+	case NameVolumeProfile:
+		return &volumeProfileSpec{}, nil
+	case NameVWMA:
+		return &vwmaSpec{}, nil
+	case NameVWMACD:
+		return &vwmacdSpec{}, nil
+	case NameWeightedClose:
+		return &weightedCloseSpec{}, nil
+	case NameWeisWave:
+		return &weisWaveSpec{}, nil
+	case NameWillR:
+		return &willRSpec{}, nil
+	case NameWMA:
+		return &wmaSpec{}, nil
+	case NameZLEMA:
+		return &zlemaSpec{}, nil
+	case NameEMAStream:
+		return &emaStreamSpec{}, nil
+	case NameNVIStream:
+		return &nviStreamSpec{}, nil
+	case NameOBVStream:
+		return &obvStreamSpec{}, nil
+	case NamePVIStream:
+		return &pviStreamSpec{}, nil
+	case NameRSIStream:
+		return &rsiStreamSpec{}, nil
+	case NameSMAStream:
+		return &smaStreamSpec{}, nil
+	case NameStochStream:
+		return &stochStreamSpec{}, nil
+	default:
+		return registeredSpec(name)
+	}
+}
+
+// specOf extracts the spec of the provided indicator.
+func specOf(v interface{}) (spec, error) {
+	s, ok := v.(specifier)
+	if !ok {
+		return registeredSpecOf(v)
+	}
+
+	return s.spec(), nil
+}
+
+// ConfigError is returned when an indicator configuration is invalid,
+// either when the indicator is created or when it is decoded. It wraps the
+// underlying error, so errors.Is and errors.As can still be used to
+// inspect it.
+type ConfigError struct {
+	// Indicator specifies the name of the invalid indicator.
+	Indicator string
+
+	// Field specifies the configuration field that is invalid or that
+	// holds the invalid nested indicator. It is empty when the indicator
+	// as a whole is invalid.
+	Field string
+
+	// Value specifies the offending value of the field. It is nil when
+	// the field holds a nested indicator.
+	Value interface{}
+
+	// Err specifies the underlying error.
+	Err error
+}
+
+// Error returns the path to the invalid field along with its value and
+// the underlying error message, e.g. "sma.length (0): invalid length" or
+// "macd.signal: sma.length (0): invalid length".
+func (e *ConfigError) Error() string {
+	path := e.Indicator
+
+	if e.Field != "" {
+		path += "." + e.Field
+	}
+
+	if e.Value != nil {
+		path += " (" + fmt.Sprint(e.Value) + ")"
+	}
+
+	return path + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *ConfigError) Unwrap() error {
+	return e.Err
+}
+
+// fieldError reports the error of an internally created indicator as the
+// error of the provided indicator's field. The field of the original error
+// is kept when the provided one is empty.
+func fieldError(err error, indicator, field string) error {
+	ce, ok := err.(*ConfigError) //nolint:errorlint // only direct errors are relabeled
+	if !ok {
+		return &ConfigError{Indicator: indicator, Field: field, Err: err}
+	}
+
+	if field == "" {
+		field = ce.Field
+	}
+
+	return &ConfigError{
+		Indicator: indicator,
+		Field:     field,
+		Value:     ce.Value,
+		Err:       ce.Err,
+	}
+}
+
+// build validates the decoded spec and creates the indicator from it.
+// Errors of the spec's nested indicators are reported along with the
+// fields that hold them.
+func build(s spec) (interface{}, error) {
+	rv := reflect.Indirect(reflect.ValueOf(s))
+
+	for i := 0; i < rv.NumField(); i++ {
+		if !rv.Field(i).CanInterface() {
+			continue
+		}
+
+		var n *nested
+
+		field := rv.Type().Field(i).Tag.Get("json")
+
+		switch f := rv.Field(i).Interface().(type) {
+		case nested:
+			n = &f
+		case *nested:
+			n = f
+		case []nested:
+			for j := range f {
+				if f[j].err != nil {
+					n = &f[j]
+					field += "[" + strconv.Itoa(j) + "]"
+
+					break
+				}
+			}
+		}
+
+		if n != nil && n.err != nil {
+			return nil, &ConfigError{
+				Indicator: s.name(),
+				Field:     field,
+				Err:       n.err,
+			}
+		}
+	}
+
+	ind, err := s.build()
+	if err != nil {
+		if ce, ok := err.(*ConfigError); ok && ce.Indicator == s.name() { //nolint:errorlint // only direct errors are kept
+			return nil, err
+		}
+
+		return nil, &ConfigError{Indicator: s.name(), Err: err}
+	}
+
+	return ind, nil
+}
+
+// assign stores the decoded indicator into the value pointed to by v.
+func assign(v, ind interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return ErrInvalidTarget
+	}
+
+	iv := reflect.ValueOf(ind)
+
+	// custom indicators are decoded into the pointers created by their
+	// factories, so they are dereferenced for concrete targets.
+	if !iv.Type().AssignableTo(rv.Elem().Type()) && iv.Kind() == reflect.Ptr && !iv.IsNil() {
+		iv = iv.Elem()
+	}
+
+	if !iv.Type().AssignableTo(rv.Elem().Type()) {
+		return ErrInvalidTarget
+	}
+
+	rv.Elem().Set(iv)
+
+	return nil
+}
+
+// MarshalGob encodes the provided indicator into gob format. The name of
+// the indicator is written before its configuration, so that the
+// indicator can be decoded without knowing its type upfront.
+func MarshalGob(v interface{}) ([]byte, error) {
+	s, err := specOf(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	enc := gob.NewEncoder(&buf)
+
+	if err = enc.Encode(s.name()); err != nil {
+		return nil, err
+	}
+
+	if err = enc.Encode(s); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalGob decodes gob encoded indicator and stores it into the value
+// pointed to by v. The v should either be a pointer to the concrete
+// indicator type or to an interface that the indicator implements.
+func UnmarshalGob(d []byte, v interface{}) error {
+	s, err := decodeGob(d)
+	if err != nil {
+		return err
+	}
+
+	ind, err := build(s)
+	if err != nil {
+		return err
+	}
+
+	return assign(v, ind)
+}
+
+// decodeGob reads the name and configuration of the indicator from the
+// provided gob data.
+func decodeGob(d []byte) (spec, error) {
+	dec := gob.NewDecoder(bytes.NewReader(d))
+
+	var name string
+	if err := dec.Decode(&name); err != nil {
+		return nil, err
+	}
+
+	s, err := newSpec(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = dec.Decode(s); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// MarshalMsgpack encodes the provided indicator into MessagePack format.
+// The indicator is written as an array containing its name followed by
+// its configuration, so that the indicator can be decoded without knowing
+// its type upfront.
+func MarshalMsgpack(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := encodeMsgpack(msgpack.NewEncoder(&buf), v); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalMsgpack decodes MessagePack encoded indicator and stores it into
+// the value pointed to by v. The v should either be a pointer to the
+// concrete indicator type or to an interface that the indicator
+// implements.
+func UnmarshalMsgpack(d []byte, v interface{}) error {
+	s, err := decodeMsgpack(msgpack.NewDecoder(bytes.NewReader(d)))
+	if err != nil {
+		return err
+	}
+
+	ind, err := build(s)
+	if err != nil {
+		return err
+	}
+
+	return assign(v, ind)
+}
+
+// encodeMsgpack writes the name and configuration of the indicator
+// using the provided encoder.
+func encodeMsgpack(enc *msgpack.Encoder, v interface{}) error {
+	s, err := specOf(v)
+	if err != nil {
+		return err
+	}
+
+	if err = enc.EncodeArrayLen(2); err != nil {
+		return err
+	}
+
+	if err = enc.EncodeString(s.name()); err != nil {
+		return err
+	}
+
+	return enc.Encode(s)
+}
+
+// decodeMsgpack reads the name and configuration of the indicator
+// using the provided decoder.
+func decodeMsgpack(dec *msgpack.Decoder) (spec, error) {
+	l, err := dec.DecodeArrayLen()
+	if err != nil {
+		return nil, err
+	}
+
+	if l != 2 {
+		return nil, errors.New("invalid msgpack indicator envelope")
+	}
+
+	name, err := dec.DecodeString()
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := newSpec(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = dec.Decode(s); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// MarshalJSON encodes the provided indicator into JSON format. The
+// indicator is written as an object containing its configuration along
+// with its name field, so that the indicator can be decoded without
+// knowing its type upfront.
+func MarshalJSON(v interface{}) ([]byte, error) {
+	s, err := specOf(v)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj map[string]json.RawMessage
+	if err = json.Unmarshal(d, &obj); err != nil {
+		return nil, err
+	}
+
+	obj["name"], err = json.Marshal(s.name())
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	return json.Marshal(obj)
+}
+
+// UnmarshalJSON decodes JSON encoded indicator and stores it into the
+// value pointed to by v. The v should either be a pointer to the concrete
+// indicator type or to an interface that the indicator implements.
+func UnmarshalJSON(d []byte, v interface{}) error {
+	s, err := decodeJSON(d)
+	if err != nil {
+		return err
+	}
+
+	ind, err := build(s)
+	if err != nil {
+		return err
+	}
+
+	return assign(v, ind)
+}
+
+// decodeJSON reads the name and configuration of the indicator from the
+// provided JSON object.
+func decodeJSON(d []byte) (spec, error) {
+	var obj struct {
+		Name string `json:"name"`
+	}
+
+	if err := json.Unmarshal(d, &obj); err != nil {
+		return nil, err
+	}
+
+	s, err := newSpec(obj.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(d, s); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// nested wraps an indicator that is a part of another indicator's
+// configuration, so that it is encoded together with its name.
+type nested struct {
+	v interface{}
+
+	// err specifies why the decoded indicator could not be created. It
+	// is reported by the indicator that holds the nested one, so that
+	// the invalid field can be determined.
+	err error
+}
+
+// GobEncode encodes the wrapped indicator into gob format.
+func (n nested) GobEncode() ([]byte, error) {
+	return MarshalGob(n.v)
+}
+
+// GobDecode decodes the wrapped indicator from gob format.
+func (n *nested) GobDecode(d []byte) error {
+	s, err := decodeGob(d)
+	if err != nil {
+		return err
+	}
+
+	n.v, n.err = build(s)
+
+	return nil
+}
+
+// EncodeMsgpack encodes the wrapped indicator into MessagePack format.
+func (n nested) EncodeMsgpack(enc *msgpack.Encoder) error {
+	return encodeMsgpack(enc, n.v)
+}
+
+// DecodeMsgpack decodes the wrapped indicator from MessagePack format.
+func (n *nested) DecodeMsgpack(dec *msgpack.Decoder) error {
+	s, err := decodeMsgpack(dec)
+	if err != nil {
+		return err
+	}
+
+	n.v, n.err = build(s)
+
+	return nil
+}
+
+// MarshalJSON encodes the wrapped indicator into JSON format.
+func (n nested) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(n.v)
+}
+
+// UnmarshalJSON decodes the wrapped indicator from JSON format.
+func (n *nested) UnmarshalJSON(d []byte) error {
+	s, err := decodeJSON(d)
+	if err != nil {
+		return err
+	}
+
+	n.v, n.err = build(s)
+
+	return nil
+}
+
+// indicator returns the wrapped value as an Indicator.
+func (n nested) indicator() (Indicator, error) {
+	ind, ok := n.v.(Indicator)
+	if !ok {
+		return nil, ErrInvalidIndicator
+	}
+
+	return ind, nil
+}
+
+// adxSpec is the encodable configuration of ADX.
+type adxSpec struct {
+	Length    int       `json:"length" msgpack:"length"`
+	Smoothing Smoothing `json:"smoothing" msgpack:"smoothing"`
+}
+
+// name returns the name of ADX.
+func (adxSpec) name() string {
+	return NameADX
+}
+
+// build validates the spec and creates ADX from it.
+func (s adxSpec) build() (interface{}, error) {
+	return NewADX(s.Length, s.Smoothing)
+}
+
+// spec returns the encodable configuration of ADX.
+func (adx ADX) spec() spec {
+	return adxSpec{
+		Length:    adx.length,
+		Smoothing: adx.smoothing,
+	}
+}
+
+// MarshalJSON encodes ADX into JSON format along with its name.
+func (adx ADX) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(adx)
+}
+
+// UnmarshalJSON decodes ADX from JSON format.
+func (adx *ADX) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, adx)
+}
+
+// alligatorSpec is the encodable configuration of Alligator.
+type alligatorSpec struct {
+	Jaw   nested `json:"jaw" msgpack:"jaw"`
+	Teeth nested `json:"teeth" msgpack:"teeth"`
+	Lips  nested `json:"lips" msgpack:"lips"`
+}
+
+// name returns the name of Alligator.
+func (alligatorSpec) name() string {
+	return NameAlligator
+}
+
+// build validates the spec and creates Alligator from it.
+func (s alligatorSpec) build() (interface{}, error) {
+	jaw, err := s.Jaw.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	teeth, err := s.Teeth.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	lips, err := s.Lips.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAlligator(jaw, teeth, lips)
+}
+
+// spec returns the encodable configuration of Alligator.
+func (alligator Alligator) spec() spec {
+	return alligatorSpec{
+		Jaw:   nested{v: alligator.jaw},
+		Teeth: nested{v: alligator.teeth},
+		Lips:  nested{v: alligator.lips},
+	}
+}
+
+// MarshalJSON encodes Alligator into JSON format along with its name.
+func (alligator Alligator) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(alligator)
+}
+
+// UnmarshalJSON decodes Alligator from JSON format. Nested indicators are
+// decoded by their names.
+func (alligator *Alligator) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, alligator)
+}
+
+// almaSpec is the encodable configuration of ALMA.
+type almaSpec struct {
+	Length int             `json:"length" msgpack:"length"`
+	Offset decimal.Decimal `json:"offset" msgpack:"offset"`
+	Sigma  decimal.Decimal `json:"sigma" msgpack:"sigma"`
+}
+
+// name returns the name of ALMA.
+func (almaSpec) name() string {
+	return NameALMA
+}
+
+// build validates the spec and creates ALMA from it.
+func (s almaSpec) build() (interface{}, error) {
+	return NewALMA(s.Length, s.Offset, s.Sigma)
+}
+
+// spec returns the encodable configuration of ALMA.
+func (alma ALMA) spec() spec {
+	return almaSpec{
+		Length: alma.length,
+		Offset: alma.offset,
+		Sigma:  alma.sigma,
+	}
+}
+
+// MarshalJSON encodes ALMA into JSON format along with its name.
+func (alma ALMA) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(alma)
+}
+
+// UnmarshalJSON decodes ALMA from JSON format.
+func (alma *ALMA) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, alma)
+}
+
+// apoSpec is the encodable configuration of APO.
+type apoSpec struct {
+	Price Price  `json:"price" msgpack:"price"`
+	Fast  nested `json:"fast" msgpack:"fast"`
+	Slow  nested `json:"slow" msgpack:"slow"`
+}
+
+// name returns the name of APO.
+func (apoSpec) name() string {
+	return NameAPO
+}
+
+// build validates the spec and creates APO from it.
+func (s apoSpec) build() (interface{}, error) {
+	fast, err := s.Fast.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	slow, err := s.Slow.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	macd, err := NewMACD(fast, slow, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	apo := APO{
+		price: s.Price,
+		macd:  macd,
+	}
+
+	if err = apo.validate(); err != nil {
+		return nil, err
+	}
+
+	return apo, nil
+}
+
+// spec returns the encodable configuration of APO.
+func (apo APO) spec() spec {
+	return apoSpec{
+		Price: apo.price,
+		Fast:  nested{v: apo.macd.ma1},
+		Slow:  nested{v: apo.macd.ma2},
+	}
+}
+
+// MarshalJSON encodes APO into JSON format along with its name.
+func (apo APO) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(apo)
+}
+
+// UnmarshalJSON decodes APO from JSON format. Nested indicators are
+// decoded by their names.
+func (apo *APO) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, apo)
+}
+
+// aroonSpec is the encodable configuration of Aroon.
+type aroonSpec struct {
+	Trend  Trend `json:"trend" msgpack:"trend"`
+	Length int   `json:"length" msgpack:"length"`
+}
+
+// name returns the name of Aroon.
+func (aroonSpec) name() string {
+	return NameAroon
+}
+
+// build validates the spec and creates Aroon from it.
+func (s aroonSpec) build() (interface{}, error) {
+	return NewAroon(s.Trend, s.Length)
+}
+
+// spec returns the encodable configuration of Aroon.
+func (aroon Aroon) spec() spec {
+	return aroonSpec{
+		Trend:  aroon.trend,
+		Length: aroon.length,
+	}
+}
+
+// MarshalJSON encodes Aroon into JSON format along with its name.
+func (aroon Aroon) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(aroon)
+}
+
+// UnmarshalJSON decodes Aroon from JSON format.
+func (aroon *Aroon) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, aroon)
+}
+
+// aroonOscSpec is the encodable configuration of AroonOsc.
+type aroonOscSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of AroonOsc.
+func (aroonOscSpec) name() string {
+	return NameAroonOsc
+}
+
+// build validates the spec and creates AroonOsc from it.
+func (s aroonOscSpec) build() (interface{}, error) {
+	return NewAroonOsc(s.Length)
+}
+
+// spec returns the encodable configuration of AroonOsc.
+func (osc AroonOsc) spec() spec {
+	return aroonOscSpec{Length: osc.length}
+}
+
+// MarshalJSON encodes AroonOsc into JSON format along with its name.
+func (osc AroonOsc) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(osc)
+}
+
+// UnmarshalJSON decodes AroonOsc from JSON format.
+func (osc *AroonOsc) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, osc)
+}
+
+// atrSpec is the encodable configuration of ATR.
+type atrSpec struct {
+	Length    int       `json:"length" msgpack:"length"`
+	Smoothing Smoothing `json:"smoothing" msgpack:"smoothing"`
+}
+
+// name returns the name of ATR.
+func (atrSpec) name() string {
+	return NameATR
+}
+
+// build validates the spec and creates ATR from it.
+func (s atrSpec) build() (interface{}, error) {
+	return NewATR(s.Length, s.Smoothing)
+}
+
+// spec returns the encodable configuration of ATR.
+func (atr ATR) spec() spec {
+	return atrSpec{
+		Length:    atr.length,
+		Smoothing: atr.smoothing,
+	}
+}
+
+// MarshalJSON encodes ATR into JSON format along with its name.
+func (atr ATR) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(atr)
+}
+
+// UnmarshalJSON decodes ATR from JSON format.
+func (atr *ATR) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, atr)
+}
+
+// bbSpec is the encodable configuration of BB.
+type bbSpec struct {
+	Percent bool            `json:"percent" msgpack:"percent"`
+	Band    Band            `json:"band" msgpack:"band"`
+	StdDev  decimal.Decimal `json:"std_dev" msgpack:"std_dev"`
+	Length  int             `json:"length" msgpack:"length"`
+}
+
+// name returns the name of BB.
+func (bbSpec) name() string {
+	return NameBB
+}
+
+// build validates the spec and creates BB from it.
+func (s bbSpec) build() (interface{}, error) {
+	return NewBB(s.Percent, s.Band, s.StdDev, s.Length)
+}
+
+// spec returns the encodable configuration of BB.
+func (bb BB) spec() spec {
+	return bbSpec{
+		Percent: bb.percent,
+		Band:    bb.band,
+		StdDev:  bb.stdDev,
+		Length:  bb.sma.length,
+	}
+}
+
+// MarshalJSON encodes BB into JSON format along with its name.
+func (bb BB) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(bb)
+}
+
+// UnmarshalJSON decodes BB from JSON format.
+func (bb *BB) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, bb)
+}
+
+// bbwSpec is the encodable configuration of BBW.
+type bbwSpec struct {
+	StdDev decimal.Decimal `json:"std_dev" msgpack:"std_dev"`
+	Length int             `json:"length" msgpack:"length"`
+}
+
+// name returns the name of BBW.
+func (bbwSpec) name() string {
+	return NameBBW
+}
+
+// build validates the spec and creates BBW from it.
+func (s bbwSpec) build() (interface{}, error) {
+	return NewBBW(s.StdDev, s.Length)
+}
+
+// spec returns the encodable configuration of BBW.
+func (bbw BBW) spec() spec {
+	return bbwSpec{
+		StdDev: bbw.bb.stdDev,
+		Length: bbw.bb.sma.length,
+	}
+}
+
+// MarshalJSON encodes BBW into JSON format along with its name.
+func (bbw BBW) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(bbw)
+}
+
+// UnmarshalJSON decodes BBW from JSON format.
+func (bbw *BBW) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, bbw)
+}
+
+// betaSpec is the encodable configuration of Beta.
+type betaSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of Beta.
+func (betaSpec) name() string {
+	return NameBeta
+}
+
+// build validates the spec and creates Beta from it.
+func (s betaSpec) build() (interface{}, error) {
+	return NewBeta(s.Length)
+}
+
+// spec returns the encodable configuration of Beta.
+func (beta Beta) spec() spec {
+	return betaSpec{
+		Length: beta.length,
+	}
+}
+
+// MarshalJSON encodes Beta into JSON format along with its name.
+func (beta Beta) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(beta)
+}
+
+// UnmarshalJSON decodes Beta from JSON format.
+func (beta *Beta) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, beta)
+}
+
+// bopSpec is the encodable configuration of BOP.
+type bopSpec struct {
+	MA *nested `json:"ma" msgpack:"ma"`
+}
+
+// name returns the name of BOP.
+func (bopSpec) name() string {
+	return NameBOP
+}
+
+// build validates the spec and creates BOP from it.
+func (s bopSpec) build() (interface{}, error) {
+	if s.MA == nil {
+		return NewBOP(nil)
+	}
+
+	ma, err := s.MA.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewBOP(ma)
+}
+
+// spec returns the encodable configuration of BOP.
+func (bop BOP) spec() spec {
+	if bop.ma == nil {
+		return bopSpec{}
+	}
+
+	return bopSpec{
+		MA: &nested{v: bop.ma},
+	}
+}
+
+// MarshalJSON encodes BOP into JSON format along with its name.
+func (bop BOP) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(bop)
+}
+
+// UnmarshalJSON decodes BOP from JSON format. Nested indicators are
+// decoded by their names.
+func (bop *BOP) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, bop)
+}
+
+// calmarSpec is the encodable configuration of Calmar.
+type calmarSpec struct {
+	Length  int `json:"length" msgpack:"length"`
+	Periods int `json:"periods" msgpack:"periods"`
+}
+
+// name returns the name of Calmar.
+func (calmarSpec) name() string {
+	return NameCalmar
+}
+
+// build validates the spec and creates Calmar from it.
+func (s calmarSpec) build() (interface{}, error) {
+	return NewCalmar(s.Length, s.Periods)
+}
+
+// spec returns the encodable configuration of Calmar.
+func (calmar Calmar) spec() spec {
+	return calmarSpec{
+		Length:  calmar.length,
+		Periods: calmar.periods,
+	}
+}
+
+// MarshalJSON encodes Calmar into JSON format along with its name.
+func (calmar Calmar) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(calmar)
+}
+
+// UnmarshalJSON decodes Calmar from JSON format.
+func (calmar *Calmar) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, calmar)
+}
+
+// candleStatsSpec is the encodable configuration of CandleStats.
+type candleStatsSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of CandleStats.
+func (candleStatsSpec) name() string {
+	return NameCandleStats
+}
+
+// build validates the spec and creates CandleStats from it.
+func (s candleStatsSpec) build() (interface{}, error) {
+	return NewCandleStats(s.Length)
+}
+
+// spec returns the encodable configuration of CandleStats.
+func (cs CandleStats) spec() spec {
+	return candleStatsSpec{
+		Length: cs.length,
+	}
+}
+
+// MarshalJSON encodes CandleStats into JSON format along with its name.
+func (cs CandleStats) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(cs)
+}
+
+// UnmarshalJSON decodes CandleStats from JSON format.
+func (cs *CandleStats) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, cs)
+}
+
+// cciSpec is the encodable configuration of CCI.
+type cciSpec struct {
+	MA     nested          `json:"ma" msgpack:"ma"`
+	Factor decimal.Decimal `json:"factor" msgpack:"factor"`
+}
+
+// name returns the name of CCI.
+func (cciSpec) name() string {
+	return NameCCI
+}
+
+// build validates the spec and creates CCI from it.
+func (s cciSpec) build() (interface{}, error) {
+	ma, err := s.MA.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	cci := CCI{
+		ma:     ma,
+		factor: s.Factor,
+	}
+
+	if err = cci.validate(); err != nil {
+		return nil, err
+	}
+
+	return cci, nil
+}
+
+// spec returns the encodable configuration of CCI.
+func (cci CCI) spec() spec {
+	return cciSpec{
+		MA:     nested{v: cci.ma},
+		Factor: cci.factor,
+	}
+}
+
+// MarshalJSON encodes CCI into JSON format along with its name.
+func (cci CCI) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(cci)
+}
+
+// UnmarshalJSON decodes CCI from JSON format. Nested indicators are
+// decoded by their names.
+func (cci *CCI) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, cci)
+}
+
+// cmfSpec is the encodable configuration of CMF.
+type cmfSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of CMF.
+func (cmfSpec) name() string {
+	return NameCMF
+}
+
+// build validates the spec and creates CMF from it.
+func (s cmfSpec) build() (interface{}, error) {
+	return NewCMF(s.Length)
+}
+
+// spec returns the encodable configuration of CMF.
+func (cmf CMF) spec() spec {
+	return cmfSpec{
+		Length: cmf.length,
+	}
+}
+
+// MarshalJSON encodes CMF into JSON format along with its name.
+func (cmf CMF) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(cmf)
+}
+
+// UnmarshalJSON decodes CMF from JSON format.
+func (cmf *CMF) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, cmf)
+}
+
+// cmoSpec is the encodable configuration of CMO.
+type cmoSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of CMO.
+func (cmoSpec) name() string {
+	return NameCMO
+}
+
+// build validates the spec and creates CMO from it.
+func (s cmoSpec) build() (interface{}, error) {
+	return NewCMO(s.Length)
+}
+
+// spec returns the encodable configuration of CMO.
+func (cmo CMO) spec() spec {
+	return cmoSpec{
+		Length: cmo.length,
+	}
+}
+
+// MarshalJSON encodes CMO into JSON format along with its name.
+func (cmo CMO) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(cmo)
+}
+
+// UnmarshalJSON decodes CMO from JSON format.
+func (cmo *CMO) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, cmo)
+}
+
+// correlationSpec is the encodable configuration of Correlation.
+type correlationSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of Correlation.
+func (correlationSpec) name() string {
+	return NameCorrelation
+}
+
+// build validates the spec and creates Correlation from it.
+func (s correlationSpec) build() (interface{}, error) {
+	return NewCorrelation(s.Length)
+}
+
+// spec returns the encodable configuration of Correlation.
+func (corr Correlation) spec() spec {
+	return correlationSpec{
+		Length: corr.length,
+	}
+}
+
+// MarshalJSON encodes Correlation into JSON format along with its name.
+func (corr Correlation) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(corr)
+}
+
+// UnmarshalJSON decodes Correlation from JSON format.
+func (corr *Correlation) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, corr)
+}
+
+// decyclerSpec is the encodable configuration of Decycler.
+type decyclerSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of Decycler.
+func (decyclerSpec) name() string {
+	return NameDecycler
+}
+
+// build validates the spec and creates Decycler from it.
+func (s decyclerSpec) build() (interface{}, error) {
+	return NewDecycler(s.Length)
+}
+
+// spec returns the encodable configuration of Decycler.
+func (dc Decycler) spec() spec {
+	return decyclerSpec{
+		Length: dc.length,
+	}
+}
+
+// MarshalJSON encodes Decycler into JSON format along with its name.
+func (dc Decycler) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(dc)
+}
+
+// UnmarshalJSON decodes Decycler from JSON format.
+func (dc *Decycler) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, dc)
+}
+
+// demaSpec is the encodable configuration of DEMA.
+type demaSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of DEMA.
+func (demaSpec) name() string {
+	return NameDEMA
+}
+
+// build validates the spec and creates DEMA from it.
+func (s demaSpec) build() (interface{}, error) {
+	return NewDEMA(s.Length)
+}
+
+// spec returns the encodable configuration of DEMA.
+func (dema DEMA) spec() spec {
+	return demaSpec{Length: dema.ema.sma.length}
+}
+
+// MarshalJSON encodes DEMA into JSON format along with its name.
+func (dema DEMA) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(dema)
+}
+
+// UnmarshalJSON decodes DEMA from JSON format.
+func (dema *DEMA) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, dema)
+}
+
+// dmiSpec is the encodable configuration of DMI.
+type dmiSpec struct {
+	Trend     Trend     `json:"trend" msgpack:"trend"`
+	Length    int       `json:"length" msgpack:"length"`
+	Smoothing Smoothing `json:"smoothing" msgpack:"smoothing"`
+}
+
+// name returns the name of DMI.
+func (dmiSpec) name() string {
+	return NameDMI
+}
+
+// build validates the spec and creates DMI from it.
+func (s dmiSpec) build() (interface{}, error) {
+	return NewDMI(s.Trend, s.Length, s.Smoothing)
+}
+
+// spec returns the encodable configuration of DMI.
+func (dmi DMI) spec() spec {
+	return dmiSpec{
+		Trend:     dmi.trend,
+		Length:    dmi.length,
+		Smoothing: dmi.smoothing,
+	}
+}
+
+// MarshalJSON encodes DMI into JSON format along with its name.
+func (dmi DMI) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(dmi)
+}
+
+// UnmarshalJSON decodes DMI from JSON format.
+func (dmi *DMI) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, dmi)
+}
+
+// donchianSpec is the encodable configuration of Donchian.
+type donchianSpec struct {
+	Band   Band `json:"band" msgpack:"band"`
+	Length int  `json:"length" msgpack:"length"`
+}
+
+// name returns the name of Donchian.
+func (donchianSpec) name() string {
+	return NameDonchian
+}
+
+// build validates the spec and creates Donchian from it.
+func (s donchianSpec) build() (interface{}, error) {
+	return NewDonchian(s.Band, s.Length)
+}
+
+// spec returns the encodable configuration of Donchian.
+func (donchian Donchian) spec() spec {
+	return donchianSpec{
+		Band:   donchian.band,
+		Length: donchian.length,
+	}
+}
+
+// MarshalJSON encodes Donchian into JSON format along with its name.
+func (donchian Donchian) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(donchian)
+}
+
+// UnmarshalJSON decodes Donchian from JSON format.
+func (donchian *Donchian) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, donchian)
+}
+
+// donchianWidthSpec is the encodable configuration of DonchianWidth.
+type donchianWidthSpec struct {
+	Length    int  `json:"length" msgpack:"length"`
+	Normalize bool `json:"normalize" msgpack:"normalize"`
+}
+
+// name returns the name of DonchianWidth.
+func (donchianWidthSpec) name() string {
+	return NameDonchianWidth
+}
+
+// build validates the spec and creates DonchianWidth from it.
+func (s donchianWidthSpec) build() (interface{}, error) {
+	return NewDonchianWidth(s.Length, s.Normalize)
+}
+
+// spec returns the encodable configuration of DonchianWidth.
+func (dw DonchianWidth) spec() spec {
+	return donchianWidthSpec{
+		Length:    dw.donchian.length,
+		Normalize: dw.normalize,
+	}
+}
+
+// MarshalJSON encodes DonchianWidth into JSON format along with its name.
+func (dw DonchianWidth) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(dw)
+}
+
+// UnmarshalJSON decodes DonchianWidth from JSON format.
+func (dw *DonchianWidth) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, dw)
+}
+
+// dymiSpec is the encodable configuration of DYMI.
+type dymiSpec struct {
+	Length  int `json:"length" msgpack:"length"`
+	Stdev   int `json:"stdev" msgpack:"stdev"`
+	Average int `json:"average" msgpack:"average"`
+	Min     int `json:"min" msgpack:"min"`
+	Max     int `json:"max" msgpack:"max"`
+}
+
+// name returns the name of DYMI.
+func (dymiSpec) name() string {
+	return NameDYMI
+}
+
+// build validates the spec and creates DYMI from it.
+func (s dymiSpec) build() (interface{}, error) {
+	return NewDYMI(s.Length, s.Stdev, s.Average, s.Min, s.Max)
+}
+
+// spec returns the encodable configuration of DYMI.
+func (dymi DYMI) spec() spec {
+	return dymiSpec{
+		Length:  dymi.length,
+		Stdev:   dymi.stdev,
+		Average: dymi.average,
+		Min:     dymi.min,
+		Max:     dymi.max,
+	}
+}
+
+// MarshalJSON encodes DYMI into JSON format along with its name.
+func (dymi DYMI) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(dymi)
+}
+
+// UnmarshalJSON decodes DYMI from JSON format.
+func (dymi *DYMI) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, dymi)
+}
+
+// elderRaySpec is the encodable configuration of ElderRay.
+type elderRaySpec struct {
+	Trend  Trend `json:"trend" msgpack:"trend"`
+	Length int   `json:"length" msgpack:"length"`
+}
+
+// name returns the name of ElderRay.
+func (elderRaySpec) name() string {
+	return NameElderRay
+}
+
+// build validates the spec and creates ElderRay from it.
+func (s elderRaySpec) build() (interface{}, error) {
+	return NewElderRay(s.Trend, s.Length)
+}
+
+// spec returns the encodable configuration of ElderRay.
+func (er ElderRay) spec() spec {
+	return elderRaySpec{
+		Trend:  er.trend,
+		Length: er.ema.sma.length,
+	}
+}
+
+// MarshalJSON encodes ElderRay into JSON format along with its name.
+func (er ElderRay) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(er)
+}
+
+// UnmarshalJSON decodes ElderRay from JSON format.
+func (er *ElderRay) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, er)
+}
+
+// emaSpec is the encodable configuration of EMA.
+type emaSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of EMA.
+func (emaSpec) name() string {
+	return NameEMA
+}
+
+// build validates the spec and creates EMA from it.
+func (s emaSpec) build() (interface{}, error) {
+	return NewEMA(s.Length)
+}
+
+// spec returns the encodable configuration of EMA.
+func (ema EMA) spec() spec {
+	return emaSpec{Length: ema.sma.length}
+}
+
+// MarshalJSON encodes EMA into JSON format along with its name.
+func (ema EMA) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(ema)
+}
+
+// UnmarshalJSON decodes EMA from JSON format.
+func (ema *EMA) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, ema)
+}
+
+// erSpec is the encodable configuration of ER.
+type erSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of ER.
+func (erSpec) name() string {
+	return NameER
+}
+
+// build validates the spec and creates ER from it.
+func (s erSpec) build() (interface{}, error) {
+	return NewER(s.Length)
+}
+
+// spec returns the encodable configuration of ER.
+func (er ER) spec() spec {
+	return erSpec{
+		Length: er.length,
+	}
+}
+
+// MarshalJSON encodes ER into JSON format along with its name.
+func (er ER) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(er)
+}
+
+// UnmarshalJSON decodes ER from JSON format.
+func (er *ER) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, er)
+}
+
+// ergodicSpec is the encodable configuration of Ergodic.
+type ergodicSpec struct {
+	Long   int `json:"long" msgpack:"long"`
+	Short  int `json:"short" msgpack:"short"`
+	Signal int `json:"signal" msgpack:"signal"`
+}
+
+// name returns the name of Ergodic.
+func (ergodicSpec) name() string {
+	return NameErgodic
+}
+
+// build validates the spec and creates Ergodic from it.
+func (s ergodicSpec) build() (interface{}, error) {
+	return NewErgodic(s.Long, s.Short, s.Signal)
+}
+
+// spec returns the encodable configuration of Ergodic.
+func (erg Ergodic) spec() spec {
+	return ergodicSpec{
+		Long:   erg.tsi.long.sma.length,
+		Short:  erg.tsi.short.sma.length,
+		Signal: erg.tsi.signal.sma.length,
+	}
+}
+
+// MarshalJSON encodes Ergodic into JSON format along with its name.
+func (erg Ergodic) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(erg)
+}
+
+// UnmarshalJSON decodes Ergodic from JSON format.
+func (erg *Ergodic) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, erg)
+}
+
+// forceIndexSpec is the encodable configuration of ForceIndex.
+type forceIndexSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of ForceIndex.
+func (forceIndexSpec) name() string {
+	return NameForceIndex
+}
+
+// build validates the spec and creates ForceIndex from it.
+func (s forceIndexSpec) build() (interface{}, error) {
+	return NewForceIndex(s.Length)
+}
+
+// spec returns the encodable configuration of ForceIndex.
+func (fi ForceIndex) spec() spec {
+	return forceIndexSpec{
+		Length: fi.ema.sma.length,
+	}
+}
+
+// MarshalJSON encodes ForceIndex into JSON format along with its name.
+func (fi ForceIndex) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(fi)
+}
+
+// UnmarshalJSON decodes ForceIndex from JSON format.
+func (fi *ForceIndex) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, fi)
+}
+
+// framaSpec is the encodable configuration of FRAMA.
+type framaSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of FRAMA.
+func (framaSpec) name() string {
+	return NameFRAMA
+}
+
+// build validates the spec and creates FRAMA from it.
+func (s framaSpec) build() (interface{}, error) {
+	return NewFRAMA(s.Length)
+}
+
+// spec returns the encodable configuration of FRAMA.
+func (frama FRAMA) spec() spec {
+	return framaSpec{
+		Length: frama.length,
+	}
+}
+
+// MarshalJSON encodes FRAMA into JSON format along with its name.
+func (frama FRAMA) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(frama)
+}
+
+// UnmarshalJSON decodes FRAMA from JSON format.
+func (frama *FRAMA) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, frama)
+}
+
+// gapSpec is the encodable configuration of Gap.
+type gapSpec struct {
+	Threshold decimal.Decimal `json:"threshold" msgpack:"threshold"`
+}
+
+// name returns the name of Gap.
+func (gapSpec) name() string {
+	return NameGap
+}
+
+// build validates the spec and creates Gap from it.
+func (s gapSpec) build() (interface{}, error) {
+	return NewGap(s.Threshold)
+}
+
+// spec returns the encodable configuration of Gap.
+func (gap Gap) spec() spec {
+	return gapSpec{
+		Threshold: gap.threshold,
+	}
+}
+
+// MarshalJSON encodes Gap into JSON format along with its name.
+func (gap Gap) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(gap)
+}
+
+// UnmarshalJSON decodes Gap from JSON format.
+func (gap *Gap) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, gap)
+}
+
+// gatorSpec is the encodable configuration of Gator.
+type gatorSpec struct {
+	Jaw   nested `json:"jaw" msgpack:"jaw"`
+	Teeth nested `json:"teeth" msgpack:"teeth"`
+	Lips  nested `json:"lips" msgpack:"lips"`
+}
+
+// name returns the name of Gator.
+func (gatorSpec) name() string {
+	return NameGator
+}
+
+// build validates the spec and creates Gator from it.
+func (s gatorSpec) build() (interface{}, error) {
+	jaw, err := s.Jaw.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	teeth, err := s.Teeth.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	lips, err := s.Lips.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewGator(jaw, teeth, lips)
+}
+
+// spec returns the encodable configuration of Gator.
+func (gator Gator) spec() spec {
+	return gatorSpec{
+		Jaw:   nested{v: gator.alligator.jaw},
+		Teeth: nested{v: gator.alligator.teeth},
+		Lips:  nested{v: gator.alligator.lips},
+	}
+}
+
+// MarshalJSON encodes Gator into JSON format along with its name.
+func (gator Gator) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(gator)
+}
+
+// UnmarshalJSON decodes Gator from JSON format. Nested indicators are
+// decoded by their names.
+func (gator *Gator) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, gator)
+}
+
+// gmmaSpec is the encodable configuration of GMMA.
+type gmmaSpec struct{}
+
+// name returns the name of GMMA.
+func (gmmaSpec) name() string {
+	return NameGMMA
+}
+
+// build validates the spec and creates GMMA from it.
+func (s gmmaSpec) build() (interface{}, error) {
+	return NewGMMA()
+}
+
+// spec returns the encodable configuration of GMMA.
+func (gmma GMMA) spec() spec {
+	return gmmaSpec{}
+}
+
+// MarshalJSON encodes GMMA into JSON format along with its name.
+func (gmma GMMA) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(gmma)
+}
+
+// UnmarshalJSON decodes GMMA from JSON format.
+func (gmma *GMMA) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, gmma)
+}
+
+// highestSpec is the encodable configuration of Highest.
+type highestSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of Highest.
+func (highestSpec) name() string {
+	return NameHighest
+}
+
+// build validates the spec and creates Highest from it.
+func (s highestSpec) build() (interface{}, error) {
+	return NewHighest(s.Length)
+}
+
+// spec returns the encodable configuration of Highest.
+func (highest Highest) spec() spec {
+	return highestSpec{
+		Length: highest.length,
+	}
+}
+
+// MarshalJSON encodes Highest into JSON format along with its name.
+func (highest Highest) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(highest)
+}
+
+// UnmarshalJSON decodes Highest from JSON format.
+func (highest *Highest) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, highest)
+}
+
+// hilbertPeriodSpec is the encodable configuration of HilbertPeriod.
+type hilbertPeriodSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of HilbertPeriod.
+func (hilbertPeriodSpec) name() string {
+	return NameHilbertPeriod
+}
+
+// build validates the spec and creates HilbertPeriod from it.
+func (s hilbertPeriodSpec) build() (interface{}, error) {
+	return NewHilbertPeriod(s.Length)
+}
+
+// spec returns the encodable configuration of HilbertPeriod.
+func (hp HilbertPeriod) spec() spec {
+	return hilbertPeriodSpec{
+		Length: hp.length,
+	}
+}
+
+// MarshalJSON encodes HilbertPeriod into JSON format along with its name.
+func (hp HilbertPeriod) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(hp)
+}
+
+// UnmarshalJSON decodes HilbertPeriod from JSON format.
+func (hp *HilbertPeriod) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, hp)
+}
+
+// hmaSpec is the encodable configuration of HMA.
+type hmaSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of HMA.
+func (hmaSpec) name() string {
+	return NameHMA
+}
+
+// build validates the spec and creates HMA from it.
+func (s hmaSpec) build() (interface{}, error) {
+	return NewHMA(s.Length)
+}
+
+// spec returns the encodable configuration of HMA.
+func (h HMA) spec() spec {
+	return hmaSpec{Length: h.wma.length}
+}
+
+// MarshalJSON encodes HMA into JSON format along with its name.
+func (h HMA) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(h)
+}
+
+// UnmarshalJSON decodes HMA from JSON format.
+func (h *HMA) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, h)
+}
+
+// ichimokuSpec is the encodable configuration of Ichimoku.
+type ichimokuSpec struct {
+	Tenkan int `json:"tenkan" msgpack:"tenkan"`
+	Kijun  int `json:"kijun" msgpack:"kijun"`
+	Senkou int `json:"senkou" msgpack:"senkou"`
+}
+
+// name returns the name of Ichimoku.
+func (ichimokuSpec) name() string {
+	return NameIchimoku
+}
+
+// build validates the spec and creates Ichimoku from it.
+func (s ichimokuSpec) build() (interface{}, error) {
+	return NewIchimoku(s.Tenkan, s.Kijun, s.Senkou)
+}
+
+// spec returns the encodable configuration of Ichimoku.
+func (ichimoku Ichimoku) spec() spec {
+	return ichimokuSpec{
+		Tenkan: ichimoku.tenkan,
+		Kijun:  ichimoku.kijun,
+		Senkou: ichimoku.senkou,
+	}
+}
+
+// MarshalJSON encodes Ichimoku into JSON format along with its name.
+func (ichimoku Ichimoku) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(ichimoku)
+}
+
+// UnmarshalJSON decodes Ichimoku from JSON format.
+func (ichimoku *Ichimoku) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, ichimoku)
+}
+
+// intradayIntensitySpec is the encodable configuration of
+// IntradayIntensity.
+type intradayIntensitySpec struct {
+	Length  int  `json:"length" msgpack:"length"`
+	Percent bool `json:"percent" msgpack:"percent"`
+}
+
+// name returns the name of IntradayIntensity.
+func (intradayIntensitySpec) name() string {
+	return NameIntradayIntensity
+}
+
+// build validates the spec and creates IntradayIntensity from it.
+func (s intradayIntensitySpec) build() (interface{}, error) {
+	return NewIntradayIntensity(s.Length, s.Percent)
+}
+
+// spec returns the encodable configuration of IntradayIntensity.
+func (ii IntradayIntensity) spec() spec {
+	return intradayIntensitySpec{
+		Length:  ii.length,
+		Percent: ii.percent,
+	}
+}
+
+// MarshalJSON encodes IntradayIntensity into JSON format along with its name.
+func (ii IntradayIntensity) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(ii)
+}
+
+// UnmarshalJSON decodes IntradayIntensity from JSON format.
+func (ii *IntradayIntensity) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, ii)
+}
+
+// keltnerSpec is the encodable configuration of Keltner.
+type keltnerSpec struct {
+	Band       Band            `json:"band" msgpack:"band"`
+	MA         nested          `json:"ma" msgpack:"ma"`
+	Length     int             `json:"length" msgpack:"length"`
+	Multiplier decimal.Decimal `json:"multiplier" msgpack:"multiplier"`
+}
+
+// name returns the name of Keltner.
+func (keltnerSpec) name() string {
+	return NameKeltner
+}
+
+// build validates the spec and creates Keltner from it.
+func (s keltnerSpec) build() (interface{}, error) {
+	ma, err := s.MA.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKeltner(s.Band, ma, s.Length, s.Multiplier)
+}
+
+// spec returns the encodable configuration of Keltner.
+func (keltner Keltner) spec() spec {
+	return keltnerSpec{
+		Band:       keltner.band,
+		MA:         nested{v: keltner.ma},
+		Length:     keltner.atr.length,
+		Multiplier: keltner.multiplier,
+	}
+}
+
+// MarshalJSON encodes Keltner into JSON format along with its name.
+func (keltner Keltner) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(keltner)
+}
+
+// UnmarshalJSON decodes Keltner from JSON format. Nested indicators are
+// decoded by their names.
+func (keltner *Keltner) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, keltner)
+}
+
+// keltnerWidthSpec is the encodable configuration of KeltnerWidth.
+type keltnerWidthSpec struct {
+	Length     int             `json:"length" msgpack:"length"`
+	Multiplier decimal.Decimal `json:"multiplier" msgpack:"multiplier"`
+	Normalize  bool            `json:"normalize" msgpack:"normalize"`
+}
+
+// name returns the name of KeltnerWidth.
+func (keltnerWidthSpec) name() string {
+	return NameKeltnerWidth
+}
+
+// build validates the spec and creates KeltnerWidth from it.
+func (s keltnerWidthSpec) build() (interface{}, error) {
+	return NewKeltnerWidth(s.Length, s.Multiplier, s.Normalize)
+}
+
+// spec returns the encodable configuration of KeltnerWidth.
+func (kw KeltnerWidth) spec() spec {
+	return keltnerWidthSpec{
+		Length:     kw.keltner.atr.length,
+		Multiplier: kw.keltner.multiplier,
+		Normalize:  kw.normalize,
+	}
+}
+
+// MarshalJSON encodes KeltnerWidth into JSON format along with its name.
+func (kw KeltnerWidth) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(kw)
+}
+
+// UnmarshalJSON decodes KeltnerWidth from JSON format.
+func (kw *KeltnerWidth) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, kw)
+}
+
+// kstSpec is the encodable configuration of KST.
+type kstSpec struct {
+	ROCs   [4]int `json:"rocs" msgpack:"rocs"`
+	SMAs   [4]int `json:"smas" msgpack:"smas"`
+	Signal int    `json:"signal" msgpack:"signal"`
+}
+
+// name returns the name of KST.
+func (kstSpec) name() string {
+	return NameKST
+}
+
+// build validates the spec and creates KST from it.
+func (s kstSpec) build() (interface{}, error) {
+	return NewKST(s.ROCs, s.SMAs, s.Signal)
+}
+
+// spec returns the encodable configuration of KST.
+func (kst KST) spec() spec {
+	var s kstSpec
+
+	for i := range kst.roc {
+		s.ROCs[i] = kst.roc[i].length
+		s.SMAs[i] = kst.sma[i].length
+	}
+
+	s.Signal = kst.signal.length
+
+	return s
+}
+
+// MarshalJSON encodes KST into JSON format along with its name.
+func (kst KST) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(kst)
+}
+
+// UnmarshalJSON decodes KST from JSON format.
+func (kst *KST) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, kst)
+}
+
+// kurtosisSpec is the encodable configuration of Kurtosis.
+type kurtosisSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of Kurtosis.
+func (kurtosisSpec) name() string {
+	return NameKurtosis
+}
+
+// build validates the spec and creates Kurtosis from it.
+func (s kurtosisSpec) build() (interface{}, error) {
+	return NewKurtosis(s.Length)
+}
+
+// spec returns the encodable configuration of Kurtosis.
+func (kurt Kurtosis) spec() spec {
+	return kurtosisSpec{
+		Length: kurt.length,
+	}
+}
+
+// MarshalJSON encodes Kurtosis into JSON format along with its name.
+func (kurt Kurtosis) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(kurt)
+}
+
+// UnmarshalJSON decodes Kurtosis from JSON format.
+func (kurt *Kurtosis) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, kurt)
+}
+
+// linRegSpec is the encodable configuration of LinReg.
+type linRegSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of LinReg.
+func (linRegSpec) name() string {
+	return NameLinReg
+}
+
+// build validates the spec and creates LinReg from it.
+func (s linRegSpec) build() (interface{}, error) {
+	return NewLinReg(s.Length)
+}
+
+// spec returns the encodable configuration of LinReg.
+func (lr LinReg) spec() spec {
+	return linRegSpec{
+		Length: lr.length,
+	}
+}
+
+// MarshalJSON encodes LinReg into JSON format along with its name.
+func (lr LinReg) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(lr)
+}
+
+// UnmarshalJSON decodes LinReg from JSON format.
+func (lr *LinReg) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, lr)
+}
+
+// lowestSpec is the encodable configuration of Lowest.
+type lowestSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of Lowest.
+func (lowestSpec) name() string {
+	return NameLowest
+}
+
+// build validates the spec and creates Lowest from it.
+func (s lowestSpec) build() (interface{}, error) {
+	return NewLowest(s.Length)
+}
+
+// spec returns the encodable configuration of Lowest.
+func (lowest Lowest) spec() spec {
+	return lowestSpec{
+		Length: lowest.length,
+	}
+}
+
+// MarshalJSON encodes Lowest into JSON format along with its name.
+func (lowest Lowest) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(lowest)
+}
+
+// UnmarshalJSON decodes Lowest from JSON format.
+func (lowest *Lowest) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, lowest)
+}
+
+// lsmaSpec is the encodable configuration of LSMA.
+type lsmaSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of LSMA.
+func (lsmaSpec) name() string {
+	return NameLSMA
+}
+
+// build validates the spec and creates LSMA from it.
+func (s lsmaSpec) build() (interface{}, error) {
+	return NewLSMA(s.Length)
+}
+
+// spec returns the encodable configuration of LSMA.
+func (lsma LSMA) spec() spec {
+	return lsmaSpec{
+		Length: lsma.length,
+	}
+}
+
+// MarshalJSON encodes LSMA into JSON format along with its name.
+func (lsma LSMA) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(lsma)
+}
+
+// UnmarshalJSON decodes LSMA from JSON format.
+func (lsma *LSMA) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, lsma)
+}
+
+// macdSpec is the encodable configuration of MACD.
+type macdSpec struct {
+	MA1    nested  `json:"ma1" msgpack:"ma1"`
+	MA2    nested  `json:"ma2" msgpack:"ma2"`
+	Signal *nested `json:"signal" msgpack:"signal"`
+}
+
+// name returns the name of MACD.
+func (macdSpec) name() string {
+	return NameMACD
+}
+
+// build validates the spec and creates MACD from it.
+func (s macdSpec) build() (interface{}, error) {
+	ma1, err := s.MA1.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	ma2, err := s.MA2.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Signal == nil {
+		return NewMACD(ma1, ma2, nil)
+	}
+
+	signal, err := s.Signal.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMACD(ma1, ma2, signal)
+}
+
+// spec returns the encodable configuration of MACD.
+func (macd MACD) spec() spec {
+	return macdSpec{
+		MA1:    nested{v: macd.ma1},
+		MA2:    nested{v: macd.ma2},
+		Signal: &nested{v: macd.signal},
+	}
+}
+
+// MarshalJSON encodes MACD into JSON format along with its name.
+func (macd MACD) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(macd)
+}
+
+// UnmarshalJSON decodes MACD from JSON format. Nested indicators are
+// decoded by their names.
+func (macd *MACD) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, macd)
+}
+
+// mcGinleySpec is the encodable configuration of McGinley.
+type mcGinleySpec struct {
+	Length   int             `json:"length" msgpack:"length"`
+	Constant decimal.Decimal `json:"constant" msgpack:"constant"`
+}
+
+// name returns the name of McGinley.
+func (mcGinleySpec) name() string {
+	return NameMcGinley
+}
+
+// build validates the spec and creates McGinley from it.
+func (s mcGinleySpec) build() (interface{}, error) {
+	return NewMcGinley(s.Length, s.Constant)
+}
+
+// spec returns the encodable configuration of McGinley.
+func (mcg McGinley) spec() spec {
+	return mcGinleySpec{
+		Length:   mcg.length,
+		Constant: mcg.constant,
+	}
+}
+
+// MarshalJSON encodes McGinley into JSON format along with its name.
+func (mcg McGinley) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(mcg)
+}
+
+// UnmarshalJSON decodes McGinley from JSON format.
+func (mcg *McGinley) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, mcg)
+}
+
+// medianPriceSpec is the encodable configuration of MedianPrice.
+type medianPriceSpec struct {
+	Indicator *nested `json:"indicator" msgpack:"indicator"`
+}
+
+// name returns the name of MedianPrice.
+func (medianPriceSpec) name() string {
+	return NameMedianPrice
+}
+
+// build validates the spec and creates MedianPrice from it.
+func (s medianPriceSpec) build() (interface{}, error) {
+	if s.Indicator == nil {
+		return NewMedianPrice(nil)
+	}
+
+	ind, err := s.Indicator.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewMedianPrice(ind)
+}
+
+// spec returns the encodable configuration of MedianPrice.
+func (mp MedianPrice) spec() spec {
+	if mp.src.ind == nil {
+		return medianPriceSpec{}
+	}
+
+	return medianPriceSpec{
+		Indicator: &nested{v: mp.src.ind},
+	}
+}
+
+// MarshalJSON encodes MedianPrice into JSON format along with its name.
+func (mp MedianPrice) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(mp)
+}
+
+// UnmarshalJSON decodes MedianPrice from JSON format. Nested indicators are
+// decoded by their names.
+func (mp *MedianPrice) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, mp)
+}
+
+// mfiSpec is the encodable configuration of MFI.
+type mfiSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of MFI.
+func (mfiSpec) name() string {
+	return NameMFI
+}
+
+// build validates the spec and creates MFI from it.
+func (s mfiSpec) build() (interface{}, error) {
+	return NewMFI(s.Length)
+}
+
+// spec returns the encodable configuration of MFI.
+func (mfi MFI) spec() spec {
+	return mfiSpec{
+		Length: mfi.length,
+	}
+}
+
+// MarshalJSON encodes MFI into JSON format along with its name.
+func (mfi MFI) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(mfi)
+}
+
+// UnmarshalJSON decodes MFI from JSON format.
+func (mfi *MFI) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, mfi)
+}
+
+// normalizeSpec is the encodable configuration of Normalize.
+type normalizeSpec struct {
+	Source  nested  `json:"source" msgpack:"source"`
+	Window  int     `json:"window" msgpack:"window"`
+	Scaling Scaling `json:"scaling" msgpack:"scaling"`
+}
+
+// name returns the name of Normalize.
+func (normalizeSpec) name() string {
+	return NameNormalize
+}
+
+// build validates the spec and creates Normalize from it.
+func (s normalizeSpec) build() (interface{}, error) {
+	source, err := s.Source.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewNormalize(source, s.Window, s.Scaling)
+}
+
+// spec returns the encodable configuration of Normalize.
+func (norm Normalize) spec() spec {
+	return normalizeSpec{
+		Source:  nested{v: norm.source},
+		Window:  norm.window,
+		Scaling: norm.scaling,
+	}
+}
+
+// MarshalJSON encodes Normalize into JSON format along with its name.
+func (norm Normalize) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(norm)
+}
+
+// UnmarshalJSON decodes Normalize from JSON format. Nested indicators are
+// decoded by their names.
+func (norm *Normalize) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, norm)
+}
+
+// nviSpec is the encodable configuration of NVI.
+type nviSpec struct {
+	Length int `json:"length" msgpack:"length"`
+	Signal int `json:"signal" msgpack:"signal"`
+}
+
+// name returns the name of NVI.
+func (nviSpec) name() string {
+	return NameNVI
+}
+
+// build validates the spec and creates NVI from it.
+func (s nviSpec) build() (interface{}, error) {
+	return NewNVI(s.Length, s.Signal)
+}
+
+// spec returns the encodable configuration of NVI.
+func (nvi NVI) spec() spec {
+	return nviSpec{
+		Length: nvi.length,
+		Signal: nvi.signal.sma.length,
+	}
+}
+
+// MarshalJSON encodes NVI into JSON format along with its name.
+func (nvi NVI) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(nvi)
+}
+
+// UnmarshalJSON decodes NVI from JSON format.
+func (nvi *NVI) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, nvi)
+}
+
+// obvSpec is the encodable configuration of OBV.
+type obvSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of OBV.
+func (obvSpec) name() string {
+	return NameOBV
+}
+
+// build validates the spec and creates OBV from it.
+func (s obvSpec) build() (interface{}, error) {
+	return NewOBV(s.Length)
+}
+
+// spec returns the encodable configuration of OBV.
+func (obv OBV) spec() spec {
+	return obvSpec{
+		Length: obv.length,
+	}
+}
+
+// MarshalJSON encodes OBV into JSON format along with its name.
+func (obv OBV) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(obv)
+}
+
+// UnmarshalJSON decodes OBV from JSON format.
+func (obv *OBV) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, obv)
+}
+
+// offsetSpec is the encodable configuration of Offset.
+type offsetSpec struct {
+	Source nested `json:"source" msgpack:"source"`
+	Shift  int    `json:"shift" msgpack:"shift"`
+}
+
+// name returns the name of Offset.
+func (offsetSpec) name() string {
+	return NameOffset
+}
+
+// build validates the spec and creates Offset from it.
+func (s offsetSpec) build() (interface{}, error) {
+	source, err := s.Source.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewOffset(source, s.Shift)
+}
+
+// spec returns the encodable configuration of Offset.
+func (offset Offset) spec() spec {
+	return offsetSpec{
+		Source: nested{v: offset.source},
+		Shift:  offset.shift,
+	}
+}
+
+// MarshalJSON encodes Offset into JSON format along with its name.
+func (offset Offset) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(offset)
+}
+
+// UnmarshalJSON decodes Offset from JSON format. Nested indicators are
+// decoded by their names.
+func (offset *Offset) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, offset)
+}
+
+// percentBSpec is the encodable configuration of PercentB.
+type percentBSpec struct {
+	StdDev decimal.Decimal `json:"std_dev" msgpack:"std_dev"`
+	Length int             `json:"length" msgpack:"length"`
+}
+
+// name returns the name of PercentB.
+func (percentBSpec) name() string {
+	return NamePercentB
+}
+
+// build validates the spec and creates PercentB from it.
+func (s percentBSpec) build() (interface{}, error) {
+	return NewPercentB(s.StdDev, s.Length)
+}
+
+// spec returns the encodable configuration of PercentB.
+func (pb PercentB) spec() spec {
+	return percentBSpec{
+		StdDev: pb.bb.stdDev,
+		Length: pb.bb.sma.length,
+	}
+}
+
+// MarshalJSON encodes PercentB into JSON format along with its name.
+func (pb PercentB) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(pb)
+}
+
+// UnmarshalJSON decodes PercentB from JSON format.
+func (pb *PercentB) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, pb)
+}
+
+// periodogramSpec is the encodable configuration of Periodogram.
+type periodogramSpec struct {
+	Min    int `json:"min" msgpack:"min"`
+	Max    int `json:"max" msgpack:"max"`
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of Periodogram.
+func (periodogramSpec) name() string {
+	return NamePeriodogram
+}
+
+// build validates the spec and creates Periodogram from it.
+func (s periodogramSpec) build() (interface{}, error) {
+	return NewPeriodogram(s.Min, s.Max, s.Length)
+}
+
+// spec returns the encodable configuration of Periodogram.
+func (pg Periodogram) spec() spec {
+	return periodogramSpec{
+		Min:    pg.min,
+		Max:    pg.max,
+		Length: pg.length,
+	}
+}
+
+// MarshalJSON encodes Periodogram into JSON format along with its name.
+func (pg Periodogram) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(pg)
+}
+
+// UnmarshalJSON decodes Periodogram from JSON format.
+func (pg *Periodogram) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, pg)
+}
+
+// pivotsSpec is the encodable configuration of Pivots.
+type pivotsSpec struct {
+	Method PivotMethod `json:"method" msgpack:"method"`
+}
+
+// name returns the name of Pivots.
+func (pivotsSpec) name() string {
+	return NamePivots
+}
+
+// build validates the spec and creates Pivots from it.
+func (s pivotsSpec) build() (interface{}, error) {
+	return NewPivots(s.Method)
+}
+
+// spec returns the encodable configuration of Pivots.
+func (pivots Pivots) spec() spec {
+	return pivotsSpec{Method: pivots.method}
+}
+
+// MarshalJSON encodes Pivots into JSON format along with its name.
+func (pivots Pivots) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(pivots)
+}
+
+// UnmarshalJSON decodes Pivots from JSON format.
+func (pivots *Pivots) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, pivots)
+}
+
+// ppoSpec is the encodable configuration of PPO.
+type ppoSpec struct {
+	Fast   nested `json:"fast" msgpack:"fast"`
+	Slow   nested `json:"slow" msgpack:"slow"`
+	Signal nested `json:"signal" msgpack:"signal"`
+}
+
+// name returns the name of PPO.
+func (ppoSpec) name() string {
+	return NamePPO
+}
+
+// build validates the spec and creates PPO from it.
+func (s ppoSpec) build() (interface{}, error) {
+	fast, err := s.Fast.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	slow, err := s.Slow.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	signal, err := s.Signal.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPPO(fast, slow, signal)
+}
+
+// spec returns the encodable configuration of PPO.
+func (ppo PPO) spec() spec {
+	return ppoSpec{
+		Fast:   nested{v: ppo.macd.ma1},
+		Slow:   nested{v: ppo.macd.ma2},
+		Signal: nested{v: ppo.macd.signal},
+	}
+}
+
+// MarshalJSON encodes PPO into JSON format along with its name.
+func (ppo PPO) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(ppo)
+}
+
+// UnmarshalJSON decodes PPO from JSON format. Nested indicators are
+// decoded by their names.
+func (ppo *PPO) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, ppo)
+}
+
+// projectionBandsSpec is the encodable configuration of ProjectionBands.
+type projectionBandsSpec struct {
+	Band   Band `json:"band" msgpack:"band"`
+	Length int  `json:"length" msgpack:"length"`
+}
+
+// name returns the name of ProjectionBands.
+func (projectionBandsSpec) name() string {
+	return NameProjectionBands
+}
+
+// build validates the spec and creates ProjectionBands from it.
+func (s projectionBandsSpec) build() (interface{}, error) {
+	return NewProjectionBands(s.Band, s.Length)
+}
+
+// spec returns the encodable configuration of ProjectionBands.
+func (pb ProjectionBands) spec() spec {
+	return projectionBandsSpec{
+		Band:   pb.band,
+		Length: pb.length,
+	}
+}
+
+// MarshalJSON encodes ProjectionBands into JSON format along with its name.
+func (pb ProjectionBands) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(pb)
+}
+
+// UnmarshalJSON decodes ProjectionBands from JSON format.
+func (pb *ProjectionBands) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, pb)
+}
+
+// projectionOscillatorSpec is the encodable configuration of
+// ProjectionOscillator.
+type projectionOscillatorSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of ProjectionOscillator.
+func (projectionOscillatorSpec) name() string {
+	return NameProjectionOscillator
+}
+
+// build validates the spec and creates ProjectionOscillator from it.
+func (s projectionOscillatorSpec) build() (interface{}, error) {
+	return NewProjectionOscillator(s.Length)
+}
+
+// spec returns the encodable configuration of ProjectionOscillator.
+func (po ProjectionOscillator) spec() spec {
+	return projectionOscillatorSpec{
+		Length: po.pb.length,
+	}
+}
+
+// MarshalJSON encodes ProjectionOscillator into JSON format along with its name.
+func (po ProjectionOscillator) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(po)
+}
+
+// UnmarshalJSON decodes ProjectionOscillator from JSON format.
+func (po *ProjectionOscillator) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, po)
+}
+
+// pviSpec is the encodable configuration of PVI.
+type pviSpec struct {
+	Length int `json:"length" msgpack:"length"`
+	Signal int `json:"signal" msgpack:"signal"`
+}
+
+// name returns the name of PVI.
+func (pviSpec) name() string {
+	return NamePVI
+}
+
+// build validates the spec and creates PVI from it.
+func (s pviSpec) build() (interface{}, error) {
+	return NewPVI(s.Length, s.Signal)
+}
+
+// spec returns the encodable configuration of PVI.
+func (pvi PVI) spec() spec {
+	return pviSpec{
+		Length: pvi.length,
+		Signal: pvi.signal.sma.length,
+	}
+}
+
+// MarshalJSON encodes PVI into JSON format along with its name.
+func (pvi PVI) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(pvi)
+}
+
+// UnmarshalJSON decodes PVI from JSON format.
+func (pvi *PVI) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, pvi)
+}
+
+// pvoSpec is the encodable configuration of PVO.
+type pvoSpec struct {
+	Fast   nested `json:"fast" msgpack:"fast"`
+	Slow   nested `json:"slow" msgpack:"slow"`
+	Signal nested `json:"signal" msgpack:"signal"`
+}
+
+// name returns the name of PVO.
+func (pvoSpec) name() string {
+	return NamePVO
+}
+
+// build validates the spec and creates PVO from it.
+func (s pvoSpec) build() (interface{}, error) {
+	fast, err := s.Fast.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	slow, err := s.Slow.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	signal, err := s.Signal.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPVO(fast, slow, signal)
+}
+
+// spec returns the encodable configuration of PVO.
+func (pvo PVO) spec() spec {
+	return pvoSpec{
+		Fast:   nested{v: pvo.ppo.macd.ma1},
+		Slow:   nested{v: pvo.ppo.macd.ma2},
+		Signal: nested{v: pvo.ppo.macd.signal},
+	}
+}
+
+// MarshalJSON encodes PVO into JSON format along with its name.
+func (pvo PVO) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(pvo)
+}
+
+// UnmarshalJSON decodes PVO from JSON format. Nested indicators are
+// decoded by their names.
+func (pvo *PVO) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, pvo)
+}
+
+// qstickSpec is the encodable configuration of Qstick.
+type qstickSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of Qstick.
+func (qstickSpec) name() string {
+	return NameQstick
+}
+
+// build validates the spec and creates Qstick from it.
+func (s qstickSpec) build() (interface{}, error) {
+	return NewQstick(s.Length)
+}
+
+// spec returns the encodable configuration of Qstick.
+func (qstick Qstick) spec() spec {
+	return qstickSpec{
+		Length: qstick.length,
+	}
+}
+
+// MarshalJSON encodes Qstick into JSON format along with its name.
+func (qstick Qstick) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(qstick)
+}
+
+// UnmarshalJSON decodes Qstick from JSON format.
+func (qstick *Qstick) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, qstick)
+}
+
+// rainbowSpec is the encodable configuration of Rainbow.
+type rainbowSpec struct {
+	Length   int `json:"length" msgpack:"length"`
+	Lookback int `json:"lookback" msgpack:"lookback"`
+}
+
+// name returns the name of Rainbow.
+func (rainbowSpec) name() string {
+	return NameRainbow
+}
+
+// build validates the spec and creates Rainbow from it.
+func (s rainbowSpec) build() (interface{}, error) {
+	return NewRainbow(s.Length, s.Lookback)
+}
+
+// spec returns the encodable configuration of Rainbow.
+func (rainbow Rainbow) spec() spec {
+	return rainbowSpec{
+		Length:   rainbow.length,
+		Lookback: rainbow.lookback,
+	}
+}
+
+// MarshalJSON encodes Rainbow into JSON format along with its name.
+func (rainbow Rainbow) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(rainbow)
+}
+
+// UnmarshalJSON decodes Rainbow from JSON format.
+func (rainbow *Rainbow) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, rainbow)
+}
+
+// ribbonSpec is the encodable configuration of Ribbon.
+type ribbonSpec struct {
+	MAs []nested `json:"mas" msgpack:"mas"`
+}
+
+// name returns the name of Ribbon.
+func (ribbonSpec) name() string {
+	return NameRibbon
+}
+
+// build validates the spec and creates Ribbon from it.
+func (s ribbonSpec) build() (interface{}, error) {
+	mas := make([]Indicator, len(s.MAs))
+
+	for i := range s.MAs {
+		ma, err := s.MAs[i].indicator()
+		if err != nil {
+			return nil, err
+		}
+
+		mas[i] = ma
+	}
+
+	return NewRibbon(mas...)
+}
+
+// spec returns the encodable configuration of Ribbon.
+func (ribbon Ribbon) spec() spec {
+	mas := make([]nested, len(ribbon.mas))
+
+	for i := range ribbon.mas {
+		mas[i] = nested{v: ribbon.mas[i]}
+	}
+
+	return ribbonSpec{
+		MAs: mas,
+	}
+}
+
+// MarshalJSON encodes Ribbon into JSON format along with its name.
+func (ribbon Ribbon) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(ribbon)
+}
+
+// UnmarshalJSON decodes Ribbon from JSON format. Nested indicators are
+// decoded by their names.
+func (ribbon *Ribbon) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, ribbon)
+}
+
+// rocSpec is the encodable configuration of ROC.
+type rocSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of ROC.
+func (rocSpec) name() string {
+	return NameROC
+}
+
+// build validates the spec and creates ROC from it.
+func (s rocSpec) build() (interface{}, error) {
+	return NewROC(s.Length)
+}
+
+// spec returns the encodable configuration of ROC.
+func (roc ROC) spec() spec {
+	return rocSpec{Length: roc.length}
+}
+
+// MarshalJSON encodes ROC into JSON format along with its name.
+func (roc ROC) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(roc)
+}
+
+// UnmarshalJSON decodes ROC from JSON format.
+func (roc *ROC) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, roc)
+}
+
+// rscSpec is the encodable configuration of RSC.
+type rscSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of RSC.
+func (rscSpec) name() string {
+	return NameRSC
+}
+
+// build validates the spec and creates RSC from it.
+func (s rscSpec) build() (interface{}, error) {
+	return NewRSC(s.Length)
+}
+
+// spec returns the encodable configuration of RSC.
+func (rsc RSC) spec() spec {
+	return rscSpec{
+		Length: rsc.length,
+	}
+}
+
+// MarshalJSON encodes RSC into JSON format along with its name.
+func (rsc RSC) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(rsc)
+}
+
+// UnmarshalJSON decodes RSC from JSON format.
+func (rsc *RSC) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, rsc)
+}
+
+// rsiSpec is the encodable configuration of RSI.
+type rsiSpec struct {
+	Length    int       `json:"length" msgpack:"length"`
+	Smoothing Smoothing `json:"smoothing" msgpack:"smoothing"`
+}
+
+// name returns the name of RSI.
+func (rsiSpec) name() string {
+	return NameRSI
+}
+
+// build validates the spec and creates RSI from it.
+func (s rsiSpec) build() (interface{}, error) {
+	smoothing := s.Smoothing
+	if smoothing == 0 {
+		// specs encoded before the smoothing was configurable always
+		// used simple averaging.
+		smoothing = SmoothingSMA
+	}
+
+	return NewRSI(s.Length, smoothing)
+}
+
+// spec returns the encodable configuration of RSI.
+func (rsi RSI) spec() spec {
+	return rsiSpec{
+		Length:    rsi.length,
+		Smoothing: rsi.smoothing,
+	}
+}
+
+// MarshalJSON encodes RSI into JSON format along with its name.
+func (rsi RSI) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(rsi)
+}
+
+// UnmarshalJSON decodes RSI from JSON format.
+func (rsi *RSI) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, rsi)
+}
+
+// savitzkyGolaySpec is the encodable configuration of SavitzkyGolay.
+type savitzkyGolaySpec struct {
+	Length int `json:"length" msgpack:"length"`
+	Order  int `json:"order" msgpack:"order"`
+}
+
+// name returns the name of SavitzkyGolay.
+func (savitzkyGolaySpec) name() string {
+	return NameSavitzkyGolay
+}
+
+// build validates the spec and creates SavitzkyGolay from it.
+func (s savitzkyGolaySpec) build() (interface{}, error) {
+	return NewSavitzkyGolay(s.Length, s.Order)
+}
+
+// spec returns the encodable configuration of SavitzkyGolay.
+func (sg SavitzkyGolay) spec() spec {
+	return savitzkyGolaySpec{
+		Length: sg.length,
+		Order:  sg.order,
+	}
+}
+
+// MarshalJSON encodes SavitzkyGolay into JSON format along with its name.
+func (sg SavitzkyGolay) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(sg)
+}
+
+// UnmarshalJSON decodes SavitzkyGolay from JSON format.
+func (sg *SavitzkyGolay) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, sg)
+}
+
+// sharpeSpec is the encodable configuration of Sharpe.
+type sharpeSpec struct {
+	Length  int `json:"length" msgpack:"length"`
+	Periods int `json:"periods" msgpack:"periods"`
+}
+
+// name returns the name of Sharpe.
+func (sharpeSpec) name() string {
+	return NameSharpe
+}
+
+// build validates the spec and creates Sharpe from it.
+func (s sharpeSpec) build() (interface{}, error) {
+	return NewSharpe(s.Length, s.Periods)
+}
+
+// spec returns the encodable configuration of Sharpe.
+func (sharpe Sharpe) spec() spec {
+	return sharpeSpec{
+		Length:  sharpe.length,
+		Periods: sharpe.periods,
+	}
+}
+
+// MarshalJSON encodes Sharpe into JSON format along with its name.
+func (sharpe Sharpe) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(sharpe)
+}
+
+// UnmarshalJSON decodes Sharpe from JSON format.
+func (sharpe *Sharpe) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, sharpe)
+}
+
+// skewSpec is the encodable configuration of Skew.
+type skewSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of Skew.
+func (skewSpec) name() string {
+	return NameSkew
+}
+
+// build validates the spec and creates Skew from it.
+func (s skewSpec) build() (interface{}, error) {
+	return NewSkew(s.Length)
+}
+
+// spec returns the encodable configuration of Skew.
+func (skew Skew) spec() spec {
+	return skewSpec{
+		Length: skew.length,
+	}
+}
+
+// MarshalJSON encodes Skew into JSON format along with its name.
+func (skew Skew) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(skew)
+}
+
+// UnmarshalJSON decodes Skew from JSON format.
+func (skew *Skew) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, skew)
+}
+
+// smaSpec is the encodable configuration of SMA.
+type smaSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of SMA.
+func (smaSpec) name() string {
+	return NameSMA
+}
+
+// build validates the spec and creates SMA from it.
+func (s smaSpec) build() (interface{}, error) {
+	return NewSMA(s.Length)
+}
+
+// spec returns the encodable configuration of SMA.
+func (sma SMA) spec() spec {
+	return smaSpec{Length: sma.length}
+}
+
+// MarshalJSON encodes SMA into JSON format along with its name.
+func (sma SMA) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(sma)
+}
+
+// UnmarshalJSON decodes SMA from JSON format.
+func (sma *SMA) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, sma)
+}
+
+// smiSpec is the encodable configuration of SMI.
+type smiSpec struct {
+	Length int `json:"length" msgpack:"length"`
+	First  int `json:"first" msgpack:"first"`
+	Second int `json:"second" msgpack:"second"`
+	Signal int `json:"signal" msgpack:"signal"`
+}
+
+// name returns the name of SMI.
+func (smiSpec) name() string {
+	return NameSMI
+}
+
+// build validates the spec and creates SMI from it.
+func (s smiSpec) build() (interface{}, error) {
+	return NewSMI(s.Length, s.First, s.Second, s.Signal)
+}
+
+// spec returns the encodable configuration of SMI.
+func (smi SMI) spec() spec {
+	return smiSpec{
+		Length: smi.length,
+		First:  smi.ema1.sma.length,
+		Second: smi.ema2.sma.length,
+		Signal: smi.signal.sma.length,
+	}
+}
+
+// MarshalJSON encodes SMI into JSON format along with its name.
+func (smi SMI) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(smi)
+}
+
+// UnmarshalJSON decodes SMI from JSON format.
+func (smi *SMI) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, smi)
+}
+
+// smmaSpec is the encodable configuration of SMMA.
+type smmaSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of SMMA.
+func (smmaSpec) name() string {
+	return NameSMMA
+}
+
+// build validates the spec and creates SMMA from it.
+func (s smmaSpec) build() (interface{}, error) {
+	return NewSMMA(s.Length)
+}
+
+// spec returns the encodable configuration of SMMA.
+func (smma SMMA) spec() spec {
+	return smmaSpec{
+		Length: smma.length,
+	}
+}
+
+// MarshalJSON encodes SMMA into JSON format along with its name.
+func (smma SMMA) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(smma)
+}
+
+// UnmarshalJSON decodes SMMA from JSON format.
+func (smma *SMMA) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, smma)
+}
+
+// sortinoSpec is the encodable configuration of Sortino.
+type sortinoSpec struct {
+	Length  int `json:"length" msgpack:"length"`
+	Periods int `json:"periods" msgpack:"periods"`
+}
+
+// name returns the name of Sortino.
+func (sortinoSpec) name() string {
+	return NameSortino
+}
+
+// build validates the spec and creates Sortino from it.
+func (s sortinoSpec) build() (interface{}, error) {
+	return NewSortino(s.Length, s.Periods)
+}
+
+// spec returns the encodable configuration of Sortino.
+func (sortino Sortino) spec() spec {
+	return sortinoSpec{
+		Length:  sortino.length,
+		Periods: sortino.periods,
+	}
+}
+
+// MarshalJSON encodes Sortino into JSON format along with its name.
+func (sortino Sortino) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(sortino)
+}
+
+// UnmarshalJSON decodes Sortino from JSON format.
+func (sortino *Sortino) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, sortino)
+}
+
+// sourceSpec is the encodable configuration of Source.
+type sourceSpec struct {
+	Price     Price   `json:"price" msgpack:"price"`
+	Indicator *nested `json:"indicator" msgpack:"indicator"`
+}
+
+// name returns the name of Source.
+func (sourceSpec) name() string {
+	return NameSource
+}
+
+// build validates the spec and creates Source from it.
+func (s sourceSpec) build() (interface{}, error) {
+	if s.Indicator == nil {
+		return NewSource(s.Price, nil)
+	}
+
+	ind, err := s.Indicator.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSource(s.Price, ind)
+}
+
+// spec returns the encodable configuration of Source.
+func (src Source) spec() spec {
+	if src.ind == nil {
+		return sourceSpec{Price: src.price}
+	}
+
+	return sourceSpec{
+		Price:     src.price,
+		Indicator: &nested{v: src.ind},
+	}
+}
+
+// MarshalJSON encodes Source into JSON format along with its name.
+func (src Source) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(src)
+}
+
+// UnmarshalJSON decodes Source from JSON format. Nested indicators are
+// decoded by their names.
+func (src *Source) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, src)
+}
+
+// srsiSpec is the encodable configuration of SRSI.
+type srsiSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of SRSI.
+func (srsiSpec) name() string {
+	return NameSRSI
+}
+
+// build validates the spec and creates SRSI from it.
+func (s srsiSpec) build() (interface{}, error) {
+	return NewSRSI(s.Length)
+}
+
+// spec returns the encodable configuration of SRSI.
+func (srsi SRSI) spec() spec {
+	return srsiSpec{Length: srsi.rsi.length}
+}
+
+// MarshalJSON encodes SRSI into JSON format along with its name.
+func (srsi SRSI) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(srsi)
+}
+
+// UnmarshalJSON decodes SRSI from JSON format.
+func (srsi *SRSI) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, srsi)
+}
+
+// stochSpec is the encodable configuration of Stoch.
+type stochSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of Stoch.
+func (stochSpec) name() string {
+	return NameStoch
+}
+
+// build validates the spec and creates Stoch from it.
+func (s stochSpec) build() (interface{}, error) {
+	return NewStoch(s.Length)
+}
+
+// spec returns the encodable configuration of Stoch.
+func (stoch Stoch) spec() spec {
+	return stochSpec{Length: stoch.length}
+}
+
+// MarshalJSON encodes Stoch into JSON format along with its name.
+func (stoch Stoch) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(stoch)
+}
+
+// UnmarshalJSON decodes Stoch from JSON format.
+func (stoch *Stoch) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, stoch)
+}
+
+// stochFullSpec is the encodable configuration of StochFull.
+type stochFullSpec struct {
+	Length    int    `json:"length" msgpack:"length"`
+	Smoothing nested `json:"smoothing" msgpack:"smoothing"`
+	Signal    nested `json:"signal" msgpack:"signal"`
+}
+
+// name returns the name of StochFull.
+func (stochFullSpec) name() string {
+	return NameStochFull
+}
+
+// build validates the spec and creates StochFull from it.
+func (s stochFullSpec) build() (interface{}, error) {
+	smoothing, err := s.Smoothing.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	signal, err := s.Signal.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStochFull(s.Length, smoothing, signal)
+}
+
+// spec returns the encodable configuration of StochFull.
+func (sf StochFull) spec() spec {
+	return stochFullSpec{
+		Length:    sf.stoch.length,
+		Smoothing: nested{v: sf.smoothing},
+		Signal:    nested{v: sf.signal},
+	}
+}
+
+// MarshalJSON encodes StochFull into JSON format along with its name.
+func (sf StochFull) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(sf)
+}
+
+// UnmarshalJSON decodes StochFull from JSON format. Nested indicators are
+// decoded by their names.
+func (sf *StochFull) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, sf)
+}
+
+// stochOfSpec is the encodable configuration of StochOf.
+type stochOfSpec struct {
+	Source    nested `json:"source" msgpack:"source"`
+	Length    int    `json:"length" msgpack:"length"`
+	Smoothing int    `json:"smoothing" msgpack:"smoothing"`
+}
+
+// name returns the name of StochOf.
+func (stochOfSpec) name() string {
+	return NameStochOf
+}
+
+// build validates the spec and creates StochOf from it.
+func (s stochOfSpec) build() (interface{}, error) {
+	source, err := s.Source.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStochOf(source, s.Length, s.Smoothing)
+}
+
+// spec returns the encodable configuration of StochOf.
+func (so StochOf) spec() spec {
+	return stochOfSpec{
+		Source:    nested{v: so.source},
+		Length:    so.length,
+		Smoothing: so.smoothing,
+	}
+}
+
+// MarshalJSON encodes StochOf into JSON format along with its name.
+func (so StochOf) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(so)
+}
+
+// UnmarshalJSON decodes StochOf from JSON format. Nested indicators are
+// decoded by their names.
+func (so *StochOf) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, so)
+}
+
+// superSmootherSpec is the encodable configuration of SuperSmoother.
+type superSmootherSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of SuperSmoother.
+func (superSmootherSpec) name() string {
+	return NameSuperSmoother
+}
+
+// build validates the spec and creates SuperSmoother from it.
+func (s superSmootherSpec) build() (interface{}, error) {
+	return NewSuperSmoother(s.Length)
+}
+
+// spec returns the encodable configuration of SuperSmoother.
+func (ss SuperSmoother) spec() spec {
+	return superSmootherSpec{
+		Length: ss.length,
+	}
+}
+
+// MarshalJSON encodes SuperSmoother into JSON format along with its name.
+func (ss SuperSmoother) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(ss)
+}
+
+// UnmarshalJSON decodes SuperSmoother from JSON format.
+func (ss *SuperSmoother) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, ss)
+}
+
+// t3Spec is the encodable configuration of T3.
+type t3Spec struct {
+	Length  int             `json:"length" msgpack:"length"`
+	VFactor decimal.Decimal `json:"vfactor" msgpack:"vfactor"`
+}
+
+// name returns the name of T3.
+func (t3Spec) name() string {
+	return NameT3
+}
+
+// build validates the spec and creates T3 from it.
+func (s t3Spec) build() (interface{}, error) {
+	return NewT3(s.Length, s.VFactor)
+}
+
+// spec returns the encodable configuration of T3.
+func (t3 T3) spec() spec {
+	return t3Spec{
+		Length:  t3.ema.sma.length,
+		VFactor: t3.vfactor,
+	}
+}
+
+// MarshalJSON encodes T3 into JSON format along with its name.
+func (t3 T3) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(t3)
+}
+
+// UnmarshalJSON decodes T3 from JSON format.
+func (t3 *T3) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, t3)
+}
+
+// tdSequentialSpec is the encodable configuration of TDSequential.
+type tdSequentialSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of TDSequential.
+func (tdSequentialSpec) name() string {
+	return NameTDSequential
+}
+
+// build validates the spec and creates TDSequential from it.
+func (s tdSequentialSpec) build() (interface{}, error) {
+	return NewTDSequential(s.Length)
+}
+
+// spec returns the encodable configuration of TDSequential.
+func (td TDSequential) spec() spec {
+	return tdSequentialSpec{
+		Length: td.length,
+	}
+}
+
+// MarshalJSON encodes TDSequential into JSON format along with its name.
+func (td TDSequential) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(td)
+}
+
+// UnmarshalJSON decodes TDSequential from JSON format.
+func (td *TDSequential) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, td)
+}
+
+// temaSpec is the encodable configuration of TEMA.
+type temaSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of TEMA.
+func (temaSpec) name() string {
+	return NameTEMA
+}
+
+// build validates the spec and creates TEMA from it.
+func (s temaSpec) build() (interface{}, error) {
+	return NewTEMA(s.Length)
+}
+
+// spec returns the encodable configuration of TEMA.
+func (tema TEMA) spec() spec {
+	return temaSpec{Length: tema.ema.sma.length}
+}
+
+// MarshalJSON encodes TEMA into JSON format along with its name.
+func (tema TEMA) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(tema)
+}
+
+// UnmarshalJSON decodes TEMA from JSON format.
+func (tema *TEMA) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, tema)
+}
+
+// tsiSpec is the encodable configuration of TSI.
+type tsiSpec struct {
+	Long   int `json:"long" msgpack:"long"`
+	Short  int `json:"short" msgpack:"short"`
+	Signal int `json:"signal" msgpack:"signal"`
+}
+
+// name returns the name of TSI.
+func (tsiSpec) name() string {
+	return NameTSI
+}
+
+// build validates the spec and creates TSI from it.
+func (s tsiSpec) build() (interface{}, error) {
+	return NewTSI(s.Long, s.Short, s.Signal)
+}
+
+// spec returns the encodable configuration of TSI.
+func (tsi TSI) spec() spec {
+	return tsiSpec{
+		Long:   tsi.long.sma.length,
+		Short:  tsi.short.sma.length,
+		Signal: tsi.signal.sma.length,
+	}
+}
+
+// MarshalJSON encodes TSI into JSON format along with its name.
+func (tsi TSI) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(tsi)
+}
+
+// UnmarshalJSON decodes TSI from JSON format.
+func (tsi *TSI) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, tsi)
+}
+
+// ttmSqueezeSpec is the encodable configuration of TTMSqueeze.
+type ttmSqueezeSpec struct {
+	Length int             `json:"length" msgpack:"length"`
+	BB     decimal.Decimal `json:"bb" msgpack:"bb"`
+	KC     decimal.Decimal `json:"kc" msgpack:"kc"`
+}
+
+// name returns the name of TTMSqueeze.
+func (ttmSqueezeSpec) name() string {
+	return NameTTMSqueeze
+}
+
+// build validates the spec and creates TTMSqueeze from it.
+func (s ttmSqueezeSpec) build() (interface{}, error) {
+	return NewTTMSqueeze(s.Length, s.BB, s.KC)
+}
+
+// spec returns the encodable configuration of TTMSqueeze.
+func (ttm TTMSqueeze) spec() spec {
+	return ttmSqueezeSpec{
+		Length: ttm.length,
+		BB:     ttm.bb,
+		KC:     ttm.kc,
+	}
+}
+
+// MarshalJSON encodes TTMSqueeze into JSON format along with its name.
+func (ttm TTMSqueeze) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(ttm)
+}
+
+// UnmarshalJSON decodes TTMSqueeze from JSON format.
+func (ttm *TTMSqueeze) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, ttm)
+}
+
+// typicalPriceSpec is the encodable configuration of TypicalPrice.
+type typicalPriceSpec struct {
+	Indicator *nested `json:"indicator" msgpack:"indicator"`
+}
+
+// name returns the name of TypicalPrice.
+func (typicalPriceSpec) name() string {
+	return NameTypicalPrice
+}
+
+// build validates the spec and creates TypicalPrice from it.
+func (s typicalPriceSpec) build() (interface{}, error) {
+	if s.Indicator == nil {
+		return NewTypicalPrice(nil)
+	}
+
+	ind, err := s.Indicator.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTypicalPrice(ind)
+}
+
+// spec returns the encodable configuration of TypicalPrice.
+func (tp TypicalPrice) spec() spec {
+	if tp.src.ind == nil {
+		return typicalPriceSpec{}
+	}
+
+	return typicalPriceSpec{
+		Indicator: &nested{v: tp.src.ind},
+	}
+}
+
+// MarshalJSON encodes TypicalPrice into JSON format along with its name.
+func (tp TypicalPrice) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(tp)
+}
+
+// UnmarshalJSON decodes TypicalPrice from JSON format. Nested indicators are
+// decoded by their names.
+func (tp *TypicalPrice) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, tp)
+}
+
+// ulcerSpec is the encodable configuration of Ulcer.
+type ulcerSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of Ulcer.
+func (ulcerSpec) name() string {
+	return NameUlcer
+}
+
+// build validates the spec and creates Ulcer from it.
+func (s ulcerSpec) build() (interface{}, error) {
+	return NewUlcer(s.Length)
+}
+
+// spec returns the encodable configuration of Ulcer.
+func (ulcer Ulcer) spec() spec {
+	return ulcerSpec{
+		Length: ulcer.length,
+	}
+}
+
+// MarshalJSON encodes Ulcer into JSON format along with its name.
+func (ulcer Ulcer) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(ulcer)
+}
+
+// UnmarshalJSON decodes Ulcer from JSON format.
+func (ulcer *Ulcer) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, ulcer)
+}
+
+// vhfSpec is the encodable configuration of VHF.
+type vhfSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of VHF.
+func (vhfSpec) name() string {
+	return NameVHF
+}
+
+// build validates the spec and creates VHF from it.
+func (s vhfSpec) build() (interface{}, error) {
+	return NewVHF(s.Length)
+}
+
+// spec returns the encodable configuration of VHF.
+func (vhf VHF) spec() spec {
+	return vhfSpec{
+		Length: vhf.length,
+	}
+}
+
+// MarshalJSON encodes VHF into JSON format along with its name.
+func (vhf VHF) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(vhf)
+}
+
+// UnmarshalJSON decodes VHF from JSON format.
+func (vhf *VHF) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, vhf)
+}
+
+// volumeProfileSpec is the encodable configuration of VolumeProfile.
+type volumeProfileSpec struct {
+	Length int             `json:"length" msgpack:"length"`
+	Bins   int             `json:"bins" msgpack:"bins"`
+	Area   decimal.Decimal `json:"area" msgpack:"area"`
+}
+
+// name returns the name of VolumeProfile.
+func (volumeProfileSpec) name() string {
+	return NameVolumeProfile
+}
+
+// build validates the spec and creates VolumeProfile from it.
+func (s volumeProfileSpec) build() (interface{}, error) {
+	return NewVolumeProfile(s.Length, s.Bins, s.Area)
+}
+
+// spec returns the encodable configuration of VolumeProfile.
+func (vp VolumeProfile) spec() spec {
+	return volumeProfileSpec{
+		Length: vp.length,
+		Bins:   vp.bins,
+		Area:   vp.area,
+	}
+}
+
+// MarshalJSON encodes VolumeProfile into JSON format along with its name.
+func (vp VolumeProfile) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(vp)
+}
+
+// UnmarshalJSON decodes VolumeProfile from JSON format.
+func (vp *VolumeProfile) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, vp)
+}
+
+// vwmaSpec is the encodable configuration of VWMA.
+type vwmaSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of VWMA.
+func (vwmaSpec) name() string {
+	return NameVWMA
+}
+
+// build validates the spec and creates VWMA from it.
+func (s vwmaSpec) build() (interface{}, error) {
+	return NewVWMA(s.Length)
+}
+
+// spec returns the encodable configuration of VWMA.
+func (vwma VWMA) spec() spec {
+	return vwmaSpec{
+		Length: vwma.length,
+	}
+}
+
+// MarshalJSON encodes VWMA into JSON format along with its name.
+func (vwma VWMA) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(vwma)
+}
+
+// UnmarshalJSON decodes VWMA from JSON format.
+func (vwma *VWMA) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, vwma)
+}
+
+// vwmacdSpec is the encodable configuration of VWMACD.
+type vwmacdSpec struct {
+	Fast   int `json:"fast" msgpack:"fast"`
+	Slow   int `json:"slow" msgpack:"slow"`
+	Signal int `json:"signal" msgpack:"signal"`
+}
+
+// name returns the name of VWMACD.
+func (vwmacdSpec) name() string {
+	return NameVWMACD
+}
+
+// build validates the spec and creates VWMACD from it.
+func (s vwmacdSpec) build() (interface{}, error) {
+	return NewVWMACD(s.Fast, s.Slow, s.Signal)
+}
+
+// spec returns the encodable configuration of VWMACD.
+func (vwmacd VWMACD) spec() spec {
+	return vwmacdSpec{
+		Fast:   vwmacd.fast.length,
+		Slow:   vwmacd.slow.length,
+		Signal: vwmacd.signal.sma.length,
+	}
+}
+
+// MarshalJSON encodes VWMACD into JSON format along with its name.
+func (vwmacd VWMACD) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(vwmacd)
+}
+
+// UnmarshalJSON decodes VWMACD from JSON format.
+func (vwmacd *VWMACD) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, vwmacd)
+}
+
+// weightedCloseSpec is the encodable configuration of WeightedClose.
+type weightedCloseSpec struct {
+	Indicator *nested `json:"indicator" msgpack:"indicator"`
+}
+
+// name returns the name of WeightedClose.
+func (weightedCloseSpec) name() string {
+	return NameWeightedClose
+}
+
+// build validates the spec and creates WeightedClose from it.
+func (s weightedCloseSpec) build() (interface{}, error) {
+	if s.Indicator == nil {
+		return NewWeightedClose(nil)
+	}
+
+	ind, err := s.Indicator.indicator()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWeightedClose(ind)
+}
+
+// spec returns the encodable configuration of WeightedClose.
+func (wc WeightedClose) spec() spec {
+	if wc.src.ind == nil {
+		return weightedCloseSpec{}
+	}
+
+	return weightedCloseSpec{
+		Indicator: &nested{v: wc.src.ind},
+	}
+}
+
+// MarshalJSON encodes WeightedClose into JSON format along with its name.
+func (wc WeightedClose) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(wc)
+}
+
+// UnmarshalJSON decodes WeightedClose from JSON format. Nested indicators are
+// decoded by their names.
+func (wc *WeightedClose) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, wc)
+}
+
+// weisWaveSpec is the encodable configuration of WeisWave.
+type weisWaveSpec struct {
+	Length   int             `json:"length" msgpack:"length"`
+	Reversal decimal.Decimal `json:"reversal" msgpack:"reversal"`
+}
+
+// name returns the name of WeisWave.
+func (weisWaveSpec) name() string {
+	return NameWeisWave
+}
+
+// build validates the spec and creates WeisWave from it.
+func (s weisWaveSpec) build() (interface{}, error) {
+	return NewWeisWave(s.Length, s.Reversal)
+}
+
+// spec returns the encodable configuration of WeisWave.
+func (ww WeisWave) spec() spec {
+	return weisWaveSpec{
+		Length:   ww.length,
+		Reversal: ww.reversal,
+	}
+}
+
+// MarshalJSON encodes WeisWave into JSON format along with its name.
+func (ww WeisWave) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(ww)
+}
+
+// UnmarshalJSON decodes WeisWave from JSON format.
+func (ww *WeisWave) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, ww)
+}
+
+// willRSpec is the encodable configuration of WillR.
+type willRSpec struct {
+	Length  int  `json:"length" msgpack:"length"`
+	Rescale bool `json:"rescale" msgpack:"rescale"`
+}
+
+// name returns the name of WillR.
+func (willRSpec) name() string {
+	return NameWillR
+}
+
+// build validates the spec and creates WillR from it.
+func (s willRSpec) build() (interface{}, error) {
+	return NewWillR(s.Length, s.Rescale)
+}
+
+// spec returns the encodable configuration of WillR.
+func (willr WillR) spec() spec {
+	return willRSpec{
+		Length:  willr.length,
+		Rescale: willr.rescale,
+	}
+}
+
+// MarshalJSON encodes WillR into JSON format along with its name.
+func (willr WillR) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(willr)
+}
+
+// UnmarshalJSON decodes WillR from JSON format.
+func (willr *WillR) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, willr)
+}
+
+// wmaSpec is the encodable configuration of WMA.
+type wmaSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of WMA.
+func (wmaSpec) name() string {
+	return NameWMA
+}
+
+// build validates the spec and creates WMA from it.
+func (s wmaSpec) build() (interface{}, error) {
+	return NewWMA(s.Length)
+}
+
+// spec returns the encodable configuration of WMA.
+func (wma WMA) spec() spec {
+	return wmaSpec{Length: wma.length}
+}
+
+// MarshalJSON encodes WMA into JSON format along with its name.
+func (wma WMA) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(wma)
+}
+
+// UnmarshalJSON decodes WMA from JSON format.
+func (wma *WMA) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, wma)
+}
+
+// zlemaSpec is the encodable configuration of ZLEMA.
+type zlemaSpec struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// name returns the name of ZLEMA.
+func (zlemaSpec) name() string {
+	return NameZLEMA
+}
+
+// build validates the spec and creates ZLEMA from it.
+func (s zlemaSpec) build() (interface{}, error) {
+	return NewZLEMA(s.Length)
+}
+
+// spec returns the encodable configuration of ZLEMA.
+func (zlema ZLEMA) spec() spec {
+	return zlemaSpec{Length: zlema.ema.sma.length}
+}
+
+// MarshalJSON encodes ZLEMA into JSON format along with its name.
+func (zlema ZLEMA) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(zlema)
+}
+
+// UnmarshalJSON decodes ZLEMA from JSON format.
+func (zlema *ZLEMA) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, zlema)
+}
+
+// emaStreamSpec is the encodable state snapshot of EMAStream.
+type emaStreamSpec struct {
+	Length int               `json:"length" msgpack:"length"`
+	Seed   []decimal.Decimal `json:"seed" msgpack:"seed"`
+	Value  decimal.Decimal   `json:"value" msgpack:"value"`
+}
+
+// name returns the name of EMAStream.
+func (emaStreamSpec) name() string {
+	return NameEMAStream
+}
+
+// build validates the spec and restores EMAStream from it.
+func (s emaStreamSpec) build() (interface{}, error) {
+	ema, err := NewEMAStream(s.Length)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(s.Seed) > s.Length {
+		return nil, ErrInvalidDataSize
+	}
+
+	for _, d := range s.Seed {
+		ema.Add(d)
+	}
+
+	if ema.Ready() {
+		ema.value = s.Value
+	}
+
+	return ema, nil
+}
+
+// spec returns the encodable state snapshot of EMAStream.
+func (s *EMAStream) spec() spec {
+	return emaStreamSpec{
+		Length: len(s.sma.window.vv),
+		Seed:   s.sma.window.values(),
+		Value:  s.value,
+	}
+}
+
+// MarshalJSON encodes EMAStream into JSON format along with its name.
+func (s *EMAStream) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(s)
+}
+
+// UnmarshalJSON decodes EMAStream from JSON format.
+func (s *EMAStream) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, s)
+}
+
+// nviStreamSpec is the encodable state snapshot of NVIStream.
+type nviStreamSpec struct {
+	Started bool            `json:"started" msgpack:"started"`
+	Prev    Candle          `json:"prev" msgpack:"prev"`
+	Value   decimal.Decimal `json:"value" msgpack:"value"`
+}
+
+// name returns the name of NVIStream.
+func (nviStreamSpec) name() string {
+	return NameNVIStream
+}
+
+// build restores NVIStream from the spec.
+func (s nviStreamSpec) build() (interface{}, error) {
+	return &NVIStream{
+		vi: volumeIndex{
+			started: s.Started,
+			prev:    s.Prev,
+			value:   s.Value,
+		},
+	}, nil
+}
+
+// spec returns the encodable state snapshot of NVIStream.
+func (s *NVIStream) spec() spec {
+	return nviStreamSpec{
+		Started: s.vi.started,
+		Prev:    s.vi.prev,
+		Value:   s.vi.value,
+	}
+}
+
+// MarshalJSON encodes NVIStream into JSON format along with its name.
+func (s *NVIStream) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(s)
+}
+
+// UnmarshalJSON decodes NVIStream from JSON format.
+func (s *NVIStream) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, s)
+}
+
+// obvStreamSpec is the encodable state snapshot of OBVStream.
+type obvStreamSpec struct {
+	Started bool            `json:"started" msgpack:"started"`
+	Prev    decimal.Decimal `json:"prev" msgpack:"prev"`
+	Value   decimal.Decimal `json:"value" msgpack:"value"`
+}
+
+// name returns the name of OBVStream.
+func (obvStreamSpec) name() string {
+	return NameOBVStream
+}
+
+// build restores OBVStream from the spec.
+func (s obvStreamSpec) build() (interface{}, error) {
+	return &OBVStream{
+		started: s.Started,
+		prev:    s.Prev,
+		value:   s.Value,
+	}, nil
+}
+
+// spec returns the encodable state snapshot of OBVStream.
+func (s *OBVStream) spec() spec {
+	return obvStreamSpec{
+		Started: s.started,
+		Prev:    s.prev,
+		Value:   s.value,
+	}
+}
+
+// MarshalJSON encodes OBVStream into JSON format along with its name.
+func (s *OBVStream) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(s)
+}
+
+// UnmarshalJSON decodes OBVStream from JSON format.
+func (s *OBVStream) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, s)
+}
+
+// pviStreamSpec is the encodable state snapshot of PVIStream.
+type pviStreamSpec struct {
+	Started bool            `json:"started" msgpack:"started"`
+	Prev    Candle          `json:"prev" msgpack:"prev"`
+	Value   decimal.Decimal `json:"value" msgpack:"value"`
+}
+
+// name returns the name of PVIStream.
+func (pviStreamSpec) name() string {
+	return NamePVIStream
+}
+
+// build restores PVIStream from the spec.
+func (s pviStreamSpec) build() (interface{}, error) {
+	return &PVIStream{
+		vi: volumeIndex{
+			started: s.Started,
+			prev:    s.Prev,
+			value:   s.Value,
+		},
+	}, nil
+}
+
+// spec returns the encodable state snapshot of PVIStream.
+func (s *PVIStream) spec() spec {
+	return pviStreamSpec{
+		Started: s.vi.started,
+		Prev:    s.vi.prev,
+		Value:   s.vi.value,
+	}
+}
+
+// MarshalJSON encodes PVIStream into JSON format along with its name.
+func (s *PVIStream) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(s)
+}
+
+// UnmarshalJSON decodes PVIStream from JSON format.
+func (s *PVIStream) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, s)
+}
+
+// rsiStreamSpec is the encodable state snapshot of RSIStream.
+type rsiStreamSpec struct {
+	Length    int               `json:"length" msgpack:"length"`
+	Smoothing Smoothing         `json:"smoothing" msgpack:"smoothing"`
+	Count     int               `json:"count" msgpack:"count"`
+	Prev      decimal.Decimal   `json:"prev" msgpack:"prev"`
+	Changes   []decimal.Decimal `json:"changes" msgpack:"changes"`
+	Up        decimal.Decimal   `json:"up" msgpack:"up"`
+	Down      decimal.Decimal   `json:"down" msgpack:"down"`
+	Value     decimal.Decimal   `json:"value" msgpack:"value"`
+}
+
+// name returns the name of RSIStream.
+func (rsiStreamSpec) name() string {
+	return NameRSIStream
+}
+
+// build validates the spec and restores RSIStream from it.
+func (s rsiStreamSpec) build() (interface{}, error) {
+	rsi, err := NewRSIStream(s.Length, s.Smoothing)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Count < 0 || s.Count > rsi.rsi.Count() || len(s.Changes) > len(rsi.changes.vv) {
+		return nil, ErrInvalidDataSize
+	}
+
+	for _, d := range s.Changes {
+		rsi.changes.push(d)
+	}
+
+	rsi.count = s.Count
+	rsi.prev = s.Prev
+	rsi.up = s.Up
+	rsi.down = s.Down
+	rsi.value = s.Value
+
+	return rsi, nil
+}
+
+// spec returns the encodable state snapshot of RSIStream.
+func (s *RSIStream) spec() spec {
+	return rsiStreamSpec{
+		Length:    s.rsi.length,
+		Smoothing: s.rsi.smoothing,
+		Count:     s.count,
+		Prev:      s.prev,
+		Changes:   s.changes.values(),
+		Up:        s.up,
+		Down:      s.down,
+		Value:     s.value,
+	}
+}
+
+// MarshalJSON encodes RSIStream into JSON format along with its name.
+func (s *RSIStream) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(s)
+}
+
+// UnmarshalJSON decodes RSIStream from JSON format.
+func (s *RSIStream) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, s)
+}
+
+// smaStreamSpec is the encodable state snapshot of SMAStream.
+type smaStreamSpec struct {
+	Length int               `json:"length" msgpack:"length"`
+	Window []decimal.Decimal `json:"window" msgpack:"window"`
+}
+
+// name returns the name of SMAStream.
+func (smaStreamSpec) name() string {
+	return NameSMAStream
+}
+
+// build validates the spec and restores SMAStream from it.
+func (s smaStreamSpec) build() (interface{}, error) {
+	sma, err := NewSMAStream(s.Length)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(s.Window) > s.Length {
+		return nil, ErrInvalidDataSize
+	}
+
+	for _, d := range s.Window {
+		sma.Add(d)
+	}
+
+	return sma, nil
+}
+
+// spec returns the encodable state snapshot of SMAStream.
+func (s *SMAStream) spec() spec {
+	return smaStreamSpec{
+		Length: len(s.window.vv),
+		Window: s.window.values(),
+	}
+}
+
+// MarshalJSON encodes SMAStream into JSON format along with its name.
+func (s *SMAStream) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(s)
+}
+
+// UnmarshalJSON decodes SMAStream from JSON format.
+func (s *SMAStream) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, s)
+}
+
+// extremeSnapshot is the encodable state of a single extreme deque.
+type extremeSnapshot struct {
+	Idx    []int             `json:"idx" msgpack:"idx"`
+	Values []decimal.Decimal `json:"values" msgpack:"values"`
+}
+
+// restore validates the snapshot and copies it into the provided deque.
+func (es extremeSnapshot) restore(e *extreme, count int) error {
+	if len(es.Idx) != len(es.Values) || len(es.Idx) > e.length {
+		return ErrInvalidDataSize
+	}
+
+	for i, idx := range es.Idx {
+		if idx < count-e.length || idx >= count || (i > 0 && idx <= es.Idx[i-1]) {
+			return ErrInvalidDataSize
+		}
+	}
+
+	e.count = count
+	e.idx = append([]int(nil), es.Idx...)
+	e.vv = append([]decimal.Decimal(nil), es.Values...)
+
+	return nil
+}
+
+// stochStreamSpec is the encodable state snapshot of StochStream.
+type stochStreamSpec struct {
+	Length int             `json:"length" msgpack:"length"`
+	Count  int             `json:"count" msgpack:"count"`
+	High   extremeSnapshot `json:"high" msgpack:"high"`
+	Low    extremeSnapshot `json:"low" msgpack:"low"`
+	Value  decimal.Decimal `json:"value" msgpack:"value"`
+}
+
+// name returns the name of StochStream.
+func (stochStreamSpec) name() string {
+	return NameStochStream
+}
+
+// build validates the spec and restores StochStream from it.
+func (s stochStreamSpec) build() (interface{}, error) {
+	stoch, err := NewStochStream(s.Length)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.High.restore(&stoch.high, s.Count); err != nil {
+		return nil, err
+	}
+
+	if err = s.Low.restore(&stoch.low, s.Count); err != nil {
+		return nil, err
+	}
+
+	stoch.value = s.Value
+
+	return stoch, nil
+}
+
+// spec returns the encodable state snapshot of StochStream.
+func (s *StochStream) spec() spec {
+	return stochStreamSpec{
+		Length: s.high.length,
+		Count:  s.high.count,
+		High: extremeSnapshot{
+			Idx:    s.high.idx,
+			Values: s.high.vv,
+		},
+		Low: extremeSnapshot{
+			Idx:    s.low.idx,
+			Values: s.low.vv,
+		},
+		Value: s.value,
+	}
+}
+
+// MarshalJSON encodes StochStream into JSON format along with its name.
+func (s *StochStream) MarshalJSON() ([]byte, error) {
+	return MarshalJSON(s)
+}
+
+// UnmarshalJSON decodes StochStream from JSON format.
+func (s *StochStream) UnmarshalJSON(d []byte) error {
+	return UnmarshalJSON(d, s)
+}
@@ -0,0 +1,1012 @@
+package indc
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// encodingIndicators returns a set of valid indicators that are used to
+// check encoding round trips.
+func encodingIndicators(t *testing.T) map[string]interface{} {
+	t.Helper()
+
+	must := func(ind Indicator, err error) Indicator {
+		require.NoError(t, err)
+		return ind
+	}
+
+	mustCandle := func(ind CandleIndicator, err error) CandleIndicator {
+		require.NoError(t, err)
+		return ind
+	}
+
+	mustPair := func(ind PairIndicator, err error) PairIndicator {
+		require.NoError(t, err)
+		return ind
+	}
+
+	alligator, err := NewAlligator(
+		must(NewOffset(must(NewSMMA(13)), 8)),
+		must(NewOffset(must(NewSMMA(8)), 5)),
+		must(NewOffset(must(NewSMMA(5)), 3)),
+	)
+	require.NoError(t, err)
+
+	gator, err := NewGator(must(NewSMMA(5)), must(NewSMA(3)), must(NewEMA(2)))
+	require.NoError(t, err)
+
+	ichimoku, err := NewIchimoku(9, 26, 52)
+	require.NoError(t, err)
+
+	pivots, err := NewPivots(PivotCamarilla)
+	require.NoError(t, err)
+
+	vp, err := NewVolumeProfile(20, 10, decimal.RequireFromString("0.68"))
+	require.NoError(t, err)
+
+	return map[string]interface{}{
+		NameADX:               mustCandle(NewADX(5, SmoothingWilder)),
+		NameAlligator:         alligator,
+		NameALMA:              must(NewALMA(5, decimal.RequireFromString("0.85"), decimal.NewFromInt(6))),
+		NameAPO:               must(NewAPO(MATypeEMA, PriceHL2, 3, 5)),
+		NameAroon:             must(NewAroon(TrendUp, 5)),
+		NameAroonOsc:          must(NewAroonOsc(5)),
+		NameATR:               mustCandle(NewATR(5, SmoothingEMA)),
+		NameBB:                must(NewBB(true, BandLower, decimal.RequireFromString("2.5"), 5)),
+		NameBBW:               must(NewBBW(decimal.NewFromInt(2), 5)),
+		NameBeta:              mustPair(NewBeta(5)),
+		NameBOP:               mustCandle(NewBOP(must(NewSMA(5)))),
+		NameCalmar:            must(NewCalmar(5, 252)),
+		NameCandleStats:       mustCandle(NewCandleStats(5)),
+		NameCCI:               must(NewCCI(MATypeEMA, 5, decimal.RequireFromString("0.02"))),
+		NameCMF:               mustCandle(NewCMF(5)),
+		NameCMO:               must(NewCMO(5)),
+		NameCorrelation:       mustPair(NewCorrelation(5)),
+		NameDecycler:          must(NewDecycler(5)),
+		NameDEMA:              must(NewDEMA(5)),
+		NameDMI:               mustCandle(NewDMI(TrendDown, 5, SmoothingWilder)),
+		NameDonchian:          must(NewDonchian(BandUpper, 5)),
+		NameDonchianWidth:     mustCandle(NewDonchianWidth(5, true)),
+		NameDYMI:              must(NewDYMI(14, 5, 10, 5, 30)),
+		NameElderRay:          mustCandle(NewElderRay(TrendDown, 5)),
+		NameEMA:               must(NewEMA(5)),
+		NameER:                must(NewER(5)),
+		NameErgodic:           must(NewErgodic(5, 3, 2)),
+		NameForceIndex:        mustCandle(NewForceIndex(5)),
+		NameFRAMA:             must(NewFRAMA(6)),
+		NameGap:               mustCandle(NewGap(decimal.NewFromInt(2))),
+		NameGator:             gator,
+		NameGMMA:              must(NewGMMA()),
+		NameHighest:           must(NewHighest(5)),
+		NameHilbertPeriod:     must(NewHilbertPeriod(10)),
+		NameHMA:               must(NewHMA(5)),
+		NameIchimoku:          ichimoku,
+		NameIntradayIntensity: mustCandle(NewIntradayIntensity(5, true)),
+		NameKeltner:           mustCandle(NewKeltner(BandLower, must(NewSMA(4)), 5, decimal.Zero)),
+		NameKeltnerWidth:      mustCandle(NewKeltnerWidth(5, decimal.Zero, true)),
+		NameKST:               must(NewKST([4]int{2, 3, 4, 5}, [4]int{2, 2, 2, 3}, 3)),
+		NameKurtosis:          must(NewKurtosis(5)),
+		NameLinReg:            must(NewLinReg(5)),
+		NameLowest:            must(NewLowest(5)),
+		NameLSMA:              must(NewLSMA(5)),
+		NameMACD:              must(NewMACD(must(NewEMA(3)), must(NewSMA(5)), must(NewSMA(2)))),
+		NameMcGinley:          must(NewMcGinley(5, decimal.Zero)),
+		NameMedianPrice:       mustCandle(NewMedianPrice(must(NewEMA(3)))),
+		NameMFI:               mustCandle(NewMFI(5)),
+		NameNormalize: must(NewNormalize(
+			must(NewRSI(5, SmoothingSMA)), 10, ScalingPercentRank,
+		)),
+		NameNVI:                  mustCandle(NewNVI(5, 3)),
+		NameOBV:                  mustCandle(NewOBV(5)),
+		NameOffset:               must(NewOffset(must(NewWMA(3)), 2)),
+		NamePercentB:             must(NewPercentB(decimal.NewFromInt(2), 5)),
+		NamePeriodogram:          must(NewPeriodogram(3, 10, 12)),
+		NamePivots:               pivots,
+		NamePPO:                  must(NewPPO(must(NewEMA(3)), must(NewSMA(5)), must(NewEMA(2)))),
+		NameProjectionBands:      mustCandle(NewProjectionBands(BandUpper, 5)),
+		NameProjectionOscillator: mustCandle(NewProjectionOscillator(5)),
+		NamePVI:                  mustCandle(NewPVI(5, 3)),
+		NamePVO:                  mustCandle(NewPVO(must(NewEMA(3)), must(NewSMA(5)), must(NewEMA(2)))),
+		NameQstick:               mustCandle(NewQstick(5)),
+		NameRainbow:              must(NewRainbow(2, 10)),
+		NameRibbon:               must(NewRibbon(SMA{valid: true, length: 2}, EMA{valid: true, sma: SMA{valid: true, length: 3}})),
+		NameROC:                  must(NewROC(5)),
+		NameRSC:                  mustPair(NewRSC(5)),
+		NameRSI:                  must(NewRSI(5, SmoothingWilder)),
+		NameSavitzkyGolay:        must(NewSavitzkyGolay(5, 2)),
+		NameSharpe:               must(NewSharpe(5, 252)),
+		NameSkew:                 must(NewSkew(5)),
+		NameSMA:                  must(NewSMA(5)),
+		NameSMI:                  mustCandle(NewSMI(5, 3, 3, 4)),
+		NameSMMA:                 must(NewSMMA(4)),
+		NameSortino:              must(NewSortino(5, 252)),
+		NameSource:               mustCandle(NewSource(PriceHLC3, must(NewEMA(3)))),
+		NameSRSI:                 must(NewSRSI(5)),
+		NameStoch:                must(NewStoch(5)),
+		NameStochFull:            must(NewStochFull(5, must(NewSMA(3)), must(NewEMA(3)))),
+		NameStochOf:              must(NewStochOf(must(NewRSI(5, SmoothingSMA)), 5, 3)),
+		NameSuperSmoother:        must(NewSuperSmoother(5)),
+		NameT3:                   must(NewT3(5, decimal.RequireFromString("0.5"))),
+		NameTDSequential:         mustCandle(NewTDSequential(20)),
+		NameTEMA:                 must(NewTEMA(5)),
+		NameTSI:                  must(NewTSI(5, 3, 2)),
+		NameTTMSqueeze:           mustCandle(NewTTMSqueeze(5, decimal.Zero, decimal.Zero)),
+		NameTypicalPrice:         mustCandle(NewTypicalPrice(must(NewEMA(3)))),
+		NameUlcer:                must(NewUlcer(5)),
+		NameVHF:                  must(NewVHF(5)),
+		NameVolumeProfile:        vp,
+		NameVWMA:                 mustCandle(NewVWMA(5)),
+		NameVWMACD:               mustCandle(NewVWMACD(3, 5, 2)),
+		NameWeightedClose:        mustCandle(NewWeightedClose(must(NewEMA(3)))),
+		NameWeisWave:             mustCandle(NewWeisWave(5, decimal.NewFromInt(1))),
+		NameWillR:                mustCandle(NewWillR(5, true)),
+		NameWMA:                  must(NewWMA(5)),
+		NameZLEMA:                must(NewZLEMA(5)),
+	}
+}
+
+func Test_newSpec(t *testing.T) {
+	_, err := newSpec("test")
+	assert.Equal(t, ErrUnknownIndicator, err)
+
+	for name := range encodingIndicators(t) {
+		s, err := newSpec(name)
+		assert.NoError(t, err)
+		assert.Equal(t, name, s.name())
+	}
+}
+
+func Test_assign(t *testing.T) {
+	cc := map[string]struct {
+		Target interface{}
+		Error  error
+	}{
+		"Target is not a pointer": {
+			Target: SMA{},
+			Error:  ErrInvalidTarget,
+		},
+		"Target is nil": {
+			Target: (*SMA)(nil),
+			Error:  ErrInvalidTarget,
+		},
+		"Target has incompatible type": {
+			Target: &EMA{},
+			Error:  ErrInvalidTarget,
+		},
+		"Successfully assigned to concrete type": {
+			Target: &SMA{},
+		},
+		"Successfully assigned to interface": {
+			Target: new(Indicator),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, assign(c.Target, SMA{valid: true, length: 1}))
+		})
+	}
+}
+
+func Test_ConfigError(t *testing.T) {
+	err := &ConfigError{
+		Indicator: NameMACD,
+		Field:     "signal",
+		Err: &ConfigError{
+			Indicator: NameSMA,
+			Field:     "length",
+			Value:     0,
+			Err:       ErrInvalidLength,
+		},
+	}
+
+	assert.Equal(t, "macd.signal: sma.length (0): invalid length", err.Error())
+	assert.ErrorIs(t, err, ErrInvalidLength)
+
+	var cerr *ConfigError
+	require.ErrorAs(t, err.Unwrap(), &cerr)
+	assert.Equal(t, NameSMA, cerr.Indicator)
+	assert.Equal(t, "length", cerr.Field)
+	assert.Equal(t, 0, cerr.Value)
+
+	err = &ConfigError{Indicator: NameGMMA, Err: ErrInvalidIndicator}
+	assert.Equal(t, "gmma: invalid indicator", err.Error())
+
+	_, verr := NewALMA(9, decimal.RequireFromString("1.5"), decimal.NewFromInt(6))
+	assert.EqualError(t, verr, "alma.offset (1.5): invalid offset")
+}
+
+func Test_fieldError(t *testing.T) {
+	err := fieldError(
+		&ConfigError{Indicator: NameSMA, Field: "length", Value: 0, Err: ErrInvalidLength},
+		NameEMA,
+		"",
+	)
+	assert.Equal(t, &ConfigError{
+		Indicator: NameEMA,
+		Field:     "length",
+		Value:     0,
+		Err:       ErrInvalidLength,
+	}, err)
+
+	err = fieldError(
+		&ConfigError{Indicator: NameEMA, Field: "length", Value: 0, Err: ErrInvalidLength},
+		NameSMI,
+		"first",
+	)
+	assert.Equal(t, &ConfigError{
+		Indicator: NameSMI,
+		Field:     "first",
+		Value:     0,
+		Err:       ErrInvalidLength,
+	}, err)
+
+	err = fieldError(ErrInvalidMA, NameCCI, "length")
+	assert.Equal(t, &ConfigError{
+		Indicator: NameCCI,
+		Field:     "length",
+		Err:       ErrInvalidMA,
+	}, err)
+}
+
+func Test_MarshalGob(t *testing.T) {
+	_, err := MarshalGob(1)
+	assert.Equal(t, ErrUnknownIndicator, err)
+
+	for cn, ind := range encodingIndicators(t) {
+		ind := ind
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := MarshalGob(ind)
+			require.NoError(t, err)
+
+			var res interface{}
+			require.NoError(t, UnmarshalGob(d, &res))
+			assert.Equal(t, ind, res)
+		})
+	}
+}
+
+func Test_UnmarshalGob(t *testing.T) {
+	marshal := func(vv ...interface{}) []byte {
+		var buf bytes.Buffer
+
+		enc := gob.NewEncoder(&buf)
+
+		for _, v := range vv {
+			require.NoError(t, enc.Encode(v))
+		}
+
+		return buf.Bytes()
+	}
+
+	sma, err := NewSMA(2)
+	require.NoError(t, err)
+
+	cc := map[string]struct {
+		Data   []byte
+		Target interface{}
+		Result interface{}
+		Error  error
+	}{
+		"Invalid name data": {
+			Data:   []byte("test"),
+			Target: new(Indicator),
+			Error:  assert.AnError,
+		},
+		"Unknown indicator name": {
+			Data:   marshal("test", smaSpec{Length: 1}),
+			Target: new(Indicator),
+			Error:  ErrUnknownIndicator,
+		},
+		"Invalid configuration data": {
+			Data:   marshal(NameSMA, "test"),
+			Target: new(Indicator),
+			Error:  assert.AnError,
+		},
+		"Invalid configuration": {
+			Data:   marshal(NameSMA, smaSpec{}),
+			Target: new(Indicator),
+			Error: &ConfigError{
+				Indicator: NameSMA,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid nested indicator": {
+			Data: marshal(NameCCI, struct {
+				MA []byte
+			}{
+				MA: []byte("test"),
+			}),
+			Target: new(Indicator),
+			Error:  assert.AnError,
+		},
+		"Invalid nested indicator configuration": {
+			Data:   marshal(NameCCI, cciSpec{MA: nested{v: SMA{}}}),
+			Target: new(Indicator),
+			Error: &ConfigError{
+				Indicator: NameCCI,
+				Field:     "ma",
+				Err: &ConfigError{
+					Indicator: NameSMA,
+					Field:     "length",
+					Value:     0,
+					Err:       ErrInvalidLength,
+				},
+			},
+		},
+		"Missing nested indicator": {
+			Data: marshal(NameCCI, struct {
+				Factor decimal.Decimal
+			}{
+				Factor: _one,
+			}),
+			Target: new(Indicator),
+			Error:  &ConfigError{Indicator: NameCCI, Err: ErrInvalidIndicator},
+		},
+		"Invalid target": {
+			Data:   marshal(NameSMA, smaSpec{Length: 2}),
+			Target: &EMA{},
+			Error:  ErrInvalidTarget,
+		},
+		"Successfully decoded": {
+			Data:   marshal(NameSMA, smaSpec{Length: 2}),
+			Target: &SMA{},
+			Result: &sma,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			err := UnmarshalGob(c.Data, c.Target)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, c.Target)
+		})
+	}
+}
+
+func Test_MarshalMsgpack(t *testing.T) {
+	_, err := MarshalMsgpack(1)
+	assert.Equal(t, ErrUnknownIndicator, err)
+
+	_, err = MarshalMsgpack(CCI{})
+	assert.Equal(t, ErrUnknownIndicator, err)
+
+	for cn, ind := range encodingIndicators(t) {
+		ind := ind
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := MarshalMsgpack(ind)
+			require.NoError(t, err)
+
+			var res interface{}
+			require.NoError(t, UnmarshalMsgpack(d, &res))
+			assert.Equal(t, ind, res)
+		})
+	}
+}
+
+func Test_UnmarshalMsgpack(t *testing.T) {
+	marshal := func(vv ...interface{}) []byte {
+		d, err := msgpack.Marshal(vv)
+		require.NoError(t, err)
+
+		return d
+	}
+
+	sma, err := NewSMA(2)
+	require.NoError(t, err)
+
+	cc := map[string]struct {
+		Data   []byte
+		Target interface{}
+		Result interface{}
+		Error  error
+	}{
+		"Invalid envelope data": {
+			Data:   []byte("test"),
+			Target: new(Indicator),
+			Error:  assert.AnError,
+		},
+		"Invalid envelope length": {
+			Data:   marshal(NameSMA),
+			Target: new(Indicator),
+			Error:  assert.AnError,
+		},
+		"Invalid name": {
+			Data:   marshal(1, smaSpec{Length: 1}),
+			Target: new(Indicator),
+			Error:  assert.AnError,
+		},
+		"Unknown indicator name": {
+			Data:   marshal("test", smaSpec{Length: 1}),
+			Target: new(Indicator),
+			Error:  ErrUnknownIndicator,
+		},
+		"Invalid configuration data": {
+			Data:   marshal(NameSMA, "test"),
+			Target: new(Indicator),
+			Error:  assert.AnError,
+		},
+		"Invalid configuration": {
+			Data:   marshal(NameSMA, smaSpec{}),
+			Target: new(Indicator),
+			Error: &ConfigError{
+				Indicator: NameSMA,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid nested indicator": {
+			Data:   marshal(NameCCI, map[string]interface{}{"ma": []interface{}{"test"}}),
+			Target: new(Indicator),
+			Error:  assert.AnError,
+		},
+		"Invalid nested indicator configuration": {
+			Data: marshal(NameCCI, map[string]interface{}{
+				"ma": []interface{}{NameSMA, smaSpec{}},
+			}),
+			Target: new(Indicator),
+			Error: &ConfigError{
+				Indicator: NameCCI,
+				Field:     "ma",
+				Err: &ConfigError{
+					Indicator: NameSMA,
+					Field:     "length",
+					Value:     0,
+					Err:       ErrInvalidLength,
+				},
+			},
+		},
+		"Invalid target": {
+			Data:   marshal(NameSMA, smaSpec{Length: 2}),
+			Target: &EMA{},
+			Error:  ErrInvalidTarget,
+		},
+		"Successfully decoded": {
+			Data:   marshal(NameSMA, smaSpec{Length: 2}),
+			Target: &SMA{},
+			Result: &sma,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			err := UnmarshalMsgpack(c.Data, c.Target)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, c.Target)
+		})
+	}
+}
+
+func Test_MarshalJSON(t *testing.T) {
+	_, err := MarshalJSON(1)
+	assert.Equal(t, ErrUnknownIndicator, err)
+
+	_, err = MarshalJSON(CCI{})
+	assert.ErrorIs(t, err, ErrUnknownIndicator)
+
+	sma, err := NewSMA(2)
+	require.NoError(t, err)
+
+	d, err := MarshalJSON(sma)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"sma","length":2}`, string(d))
+
+	for cn, ind := range encodingIndicators(t) {
+		ind := ind
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := MarshalJSON(ind)
+			require.NoError(t, err)
+
+			var res interface{}
+			require.NoError(t, UnmarshalJSON(d, &res))
+			assert.Equal(t, ind, res)
+		})
+	}
+}
+
+func Test_UnmarshalJSON(t *testing.T) {
+	sma, err := NewSMA(2)
+	require.NoError(t, err)
+
+	cc := map[string]struct {
+		Data   string
+		Target interface{}
+		Result interface{}
+		Error  error
+	}{
+		"Invalid data": {
+			Data:   `test`,
+			Target: new(Indicator),
+			Error:  assert.AnError,
+		},
+		"Invalid name": {
+			Data:   `{"name":1,"length":1}`,
+			Target: new(Indicator),
+			Error:  assert.AnError,
+		},
+		"Unknown indicator name": {
+			Data:   `{"name":"test","length":1}`,
+			Target: new(Indicator),
+			Error:  ErrUnknownIndicator,
+		},
+		"Invalid configuration data": {
+			Data:   `{"name":"sma","length":"test"}`,
+			Target: new(Indicator),
+			Error:  assert.AnError,
+		},
+		"Invalid configuration": {
+			Data:   `{"name":"sma"}`,
+			Target: new(Indicator),
+			Error: &ConfigError{
+				Indicator: NameSMA,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid nested indicator": {
+			Data:   `{"name":"cci","ma":{"name":"test"}}`,
+			Target: new(Indicator),
+			Error:  assert.AnError,
+		},
+		"Invalid nested indicator configuration": {
+			Data: `{"name":"macd","ma1":{"name":"ema","length":12},` +
+				`"ma2":{"name":"ema","length":26},"signal":{"name":"sma","length":0}}`,
+			Target: new(Indicator),
+			Error: &ConfigError{
+				Indicator: NameMACD,
+				Field:     "signal",
+				Err: &ConfigError{
+					Indicator: NameSMA,
+					Field:     "length",
+					Value:     0,
+					Err:       ErrInvalidLength,
+				},
+			},
+		},
+		"Invalid nested indicator configuration in a list": {
+			Data:   `{"name":"ribbon","mas":[{"name":"sma","length":2},{"name":"sma","length":0}]}`,
+			Target: new(Indicator),
+			Error: &ConfigError{
+				Indicator: NameRibbon,
+				Field:     "mas[1]",
+				Err: &ConfigError{
+					Indicator: NameSMA,
+					Field:     "length",
+					Value:     0,
+					Err:       ErrInvalidLength,
+				},
+			},
+		},
+		"Missing nested indicator": {
+			Data:   `{"name":"cci","factor":"1"}`,
+			Target: new(Indicator),
+			Error:  &ConfigError{Indicator: NameCCI, Err: ErrInvalidIndicator},
+		},
+		"Invalid target": {
+			Data:   `{"name":"sma","length":2}`,
+			Target: &EMA{},
+			Error:  ErrInvalidTarget,
+		},
+		"Successfully decoded": {
+			Data:   `{"name":"sma","length":2}`,
+			Target: &SMA{},
+			Result: &sma,
+		},
+		"Successfully decoded composite indicator": {
+			Data:   `{"name":"cci","ma":{"name":"sma","length":2},"factor":"0.015"}`,
+			Target: &CCI{},
+			Result: &CCI{
+				valid:  true,
+				ma:     sma,
+				factor: decimal.RequireFromString("0.015"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			err := UnmarshalJSON([]byte(c.Data), c.Target)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, c.Target)
+		})
+	}
+}
+
+func Test_indicatorJSON(t *testing.T) {
+	for cn, ind := range encodingIndicators(t) {
+		ind := ind
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			require.Implements(t, (*json.Marshaler)(nil), ind)
+
+			d, err := json.Marshal(ind)
+			require.NoError(t, err)
+
+			res := reflect.New(reflect.TypeOf(ind))
+			require.Implements(t, (*json.Unmarshaler)(nil), res.Interface())
+			require.NoError(t, json.Unmarshal(d, res.Interface()))
+			assert.Equal(t, ind, res.Elem().Interface())
+		})
+	}
+
+	sma, err := NewSMA(2)
+	require.NoError(t, err)
+
+	type config struct {
+		MA SMA `json:"ma"`
+	}
+
+	d, err := json.Marshal(config{MA: sma})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"ma":{"name":"sma","length":2}}`, string(d))
+
+	var cfg config
+	require.NoError(t, json.Unmarshal(d, &cfg))
+	assert.Equal(t, sma, cfg.MA)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"ma":{"name":"sma"}}`), &cfg))
+
+	stream, err := NewSMAStream(2)
+	require.NoError(t, err)
+	stream.Add(decimal.NewFromInt(1))
+
+	d, err = json.Marshal(stream)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"sma_stream","length":2,"window":["1"]}`, string(d))
+
+	var restored SMAStream
+	require.NoError(t, json.Unmarshal(d, &restored))
+	assert.Equal(t, "2", restored.Add(decimal.NewFromInt(3)).String())
+
+	var macd MACD
+	assert.Error(t, json.Unmarshal([]byte(`{"name":"sma","length":2}`), &macd))
+
+	d = []byte(`{"name":"macd","ma1":{"name":"ema","length":12},` +
+		`"ma2":{"name":"ema","length":26},"signal":{"name":"sma","length":9}}`)
+	require.NoError(t, json.Unmarshal(d, &macd))
+
+	ema12, err := NewEMA(12)
+	require.NoError(t, err)
+
+	ema26, err := NewEMA(26)
+	require.NoError(t, err)
+
+	sma9, err := NewSMA(9)
+	require.NoError(t, err)
+
+	exp, err := NewMACD(ema12, ema26, sma9)
+	require.NoError(t, err)
+	assert.Equal(t, exp, macd)
+}
+
+func Test_nested_indicator(t *testing.T) {
+	_, err := nested{v: 1}.indicator()
+	assert.Equal(t, ErrInvalidIndicator, err)
+
+	ind, err := nested{v: SMA{}}.indicator()
+	assert.NoError(t, err)
+	assert.Equal(t, SMA{}, ind)
+}
+
+func Test_optionalNestedSpecs(t *testing.T) {
+	bop, err := NewBOP(nil)
+	require.NoError(t, err)
+
+	mp, err := NewMedianPrice(nil)
+	require.NoError(t, err)
+
+	tp, err := NewTypicalPrice(nil)
+	require.NoError(t, err)
+
+	src, err := NewSource(PriceOpen, nil)
+	require.NoError(t, err)
+
+	wc, err := NewWeightedClose(nil)
+	require.NoError(t, err)
+
+	for cn, ind := range map[string]interface{}{
+		NameBOP:           bop,
+		NameMedianPrice:   mp,
+		NameSource:        src,
+		NameTypicalPrice:  tp,
+		NameWeightedClose: wc,
+	} {
+		ind := ind
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := MarshalGob(ind)
+			require.NoError(t, err)
+
+			var res interface{}
+			require.NoError(t, UnmarshalGob(d, &res))
+			assert.Equal(t, ind, res)
+
+			d, err = MarshalMsgpack(ind)
+			require.NoError(t, err)
+
+			res = nil
+			require.NoError(t, UnmarshalMsgpack(d, &res))
+			assert.Equal(t, ind, res)
+		})
+	}
+
+	for _, s := range []spec{
+		bopSpec{MA: &nested{v: 1}},
+		medianPriceSpec{Indicator: &nested{v: 1}},
+		sourceSpec{Price: PriceOpen, Indicator: &nested{v: 1}},
+		typicalPriceSpec{Indicator: &nested{v: 1}},
+		weightedCloseSpec{Indicator: &nested{v: 1}},
+	} {
+		_, err := s.build()
+		assert.Equal(t, ErrInvalidIndicator, err)
+	}
+}
+
+func Test_rsiSpec_build(t *testing.T) {
+	ind, err := rsiSpec{Length: 5}.build()
+	require.NoError(t, err)
+	assert.Equal(t, RSI{valid: true, length: 5, smoothing: SmoothingSMA}, ind)
+
+	ind, err = rsiSpec{Length: 5, Smoothing: SmoothingWilder}.build()
+	require.NoError(t, err)
+	assert.Equal(t, RSI{valid: true, length: 5, smoothing: SmoothingWilder}, ind)
+}
+
+func Test_streamSnapshots(t *testing.T) {
+	formats := map[string]struct {
+		Marshal   func(interface{}) ([]byte, error)
+		Unmarshal func([]byte, interface{}) error
+	}{
+		"Gob":     {Marshal: MarshalGob, Unmarshal: UnmarshalGob},
+		"Msgpack": {Marshal: MarshalMsgpack, Unmarshal: UnmarshalMsgpack},
+		"JSON":    {Marshal: MarshalJSON, Unmarshal: UnmarshalJSON},
+	}
+
+	streams := map[string]func() Stream{
+		"SMAStream": func() Stream {
+			s, err := NewSMAStream(3)
+			require.NoError(t, err)
+			return s
+		},
+		"EMAStream": func() Stream {
+			s, err := NewEMAStream(3)
+			require.NoError(t, err)
+			return s
+		},
+		"RSIStream with SmoothingSMA": func() Stream {
+			s, err := NewRSIStream(3, SmoothingSMA)
+			require.NoError(t, err)
+			return s
+		},
+		"RSIStream with SmoothingWilder": func() Stream {
+			s, err := NewRSIStream(3, SmoothingWilder)
+			require.NoError(t, err)
+			return s
+		},
+		"StochStream": func() Stream {
+			s, err := NewStochStream(3)
+			require.NoError(t, err)
+			return s
+		},
+	}
+
+	candleStreams := map[string]func() CandleStream{
+		"NVIStream": func() CandleStream { return &NVIStream{} },
+		"OBVStream": func() CandleStream { return &OBVStream{} },
+		"PVIStream": func() CandleStream { return &PVIStream{} },
+	}
+
+	dd := series(3, 5, 4, 8, 6, 7, 2, 9, 9, 1)
+	cc := testCandles(t)
+
+	for fn, f := range formats {
+		f := f
+
+		for cn, newStream := range streams {
+			newStream := newStream
+
+			t.Run(fn+" "+cn, func(t *testing.T) {
+				t.Parallel()
+
+				for split := 0; split <= len(dd); split++ {
+					exp, stream := newStream(), newStream()
+
+					for _, d := range dd[:split] {
+						exp.Add(d)
+						stream.Add(d)
+					}
+
+					b, err := f.Marshal(stream)
+					require.NoError(t, err)
+
+					var res Stream
+					require.NoError(t, f.Unmarshal(b, &res))
+					assert.Equal(t, exp.Ready(), res.Ready())
+					assert.Equal(t, exp.Value().String(), res.Value().String())
+
+					for _, d := range dd[split:] {
+						assert.Equal(t, exp.Add(d).String(), res.Add(d).String())
+					}
+				}
+			})
+		}
+
+		for cn, newStream := range candleStreams {
+			newStream := newStream
+
+			t.Run(fn+" "+cn, func(t *testing.T) {
+				t.Parallel()
+
+				for split := 0; split <= len(cc); split++ {
+					exp, stream := newStream(), newStream()
+
+					for _, c := range cc[:split] {
+						exp.Add(c)
+						stream.Add(c)
+					}
+
+					b, err := f.Marshal(stream)
+					require.NoError(t, err)
+
+					var res CandleStream
+					require.NoError(t, f.Unmarshal(b, &res))
+					assert.Equal(t, exp.Ready(), res.Ready())
+					assert.Equal(t, exp.Value().String(), res.Value().String())
+
+					for _, c := range cc[split:] {
+						assert.Equal(t, exp.Add(c).String(), res.Add(c).String())
+					}
+				}
+			})
+		}
+	}
+}
+
+func Test_streamSpecs_build(t *testing.T) {
+	cc := map[string]struct {
+		Spec  spec
+		Error error
+	}{
+		"Invalid SMAStream length": {
+			Spec: smaStreamSpec{},
+			Error: &ConfigError{
+				Indicator: NameSMAStream,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Too many SMAStream data points": {
+			Spec:  smaStreamSpec{Length: 1, Window: series(1, 2)},
+			Error: ErrInvalidDataSize,
+		},
+		"Invalid EMAStream length": {
+			Spec: emaStreamSpec{},
+			Error: &ConfigError{
+				Indicator: NameEMAStream,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Too many EMAStream data points": {
+			Spec:  emaStreamSpec{Length: 1, Seed: series(1, 2)},
+			Error: ErrInvalidDataSize,
+		},
+		"Invalid RSIStream length": {
+			Spec: rsiStreamSpec{Smoothing: SmoothingSMA},
+			Error: &ConfigError{
+				Indicator: NameRSIStream,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid RSIStream count": {
+			Spec:  rsiStreamSpec{Length: 2, Smoothing: SmoothingSMA, Count: 4},
+			Error: ErrInvalidDataSize,
+		},
+		"Too many RSIStream changes": {
+			Spec:  rsiStreamSpec{Length: 2, Smoothing: SmoothingWilder, Changes: series(1)},
+			Error: ErrInvalidDataSize,
+		},
+		"Invalid StochStream length": {
+			Spec: stochStreamSpec{},
+			Error: &ConfigError{
+				Indicator: NameStochStream,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Mismatched StochStream deque": {
+			Spec: stochStreamSpec{
+				Length: 2,
+				Count:  1,
+				High:   extremeSnapshot{Idx: []int{0}},
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Out of window StochStream deque": {
+			Spec: stochStreamSpec{
+				Length: 2,
+				Count:  3,
+				High:   extremeSnapshot{Idx: []int{2}, Values: series(1)},
+				Low:    extremeSnapshot{Idx: []int{0}, Values: series(1)},
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Unordered StochStream deque": {
+			Spec: stochStreamSpec{
+				Length: 2,
+				Count:  2,
+				High:   extremeSnapshot{Idx: []int{1, 0}, Values: series(1, 1)},
+			},
+			Error: ErrInvalidDataSize,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := c.Spec.build()
+			assertEqualError(t, c.Error, err)
+		})
+	}
+}
@@ -5,856 +5,10680 @@ package indc
 import (
 	"errors"
 	"math"
+	"strconv"
 
 	"github.com/shopspring/decimal"
 )
 
-// Aroon holds all the necessary information needed to calculate Aroon.
+// ADX holds all the necessary information needed to calculate average
+// directional index.
 // The zero value is not usable.
-type Aroon struct {
-	// valid specifies whether Aroon paremeters were validated.
+type ADX struct {
+	// valid specifies whether ADX paremeters were validated.
 	valid bool
 
-	// trend specifies which Aroon trend to use during the
-	// calculation process.
-	trend Trend
-
-	// length specifies how many data points should be used
-	// during the calculations.
+	// length specifies how many directional movement and directional
+	// index values should be used during the calculations.
 	length int
+
+	// smoothing specifies how directional movement and directional
+	// index values should be averaged.
+	smoothing Smoothing
 }
 
-// NewAroon validates provided configuration options and
-// creates new Aroon indicator instance.
-func NewAroon(trend Trend, length int) (Aroon, error) {
-	aroon := Aroon{
-		trend:  trend,
-		length: length,
+// ADXLines holds both directional index lines and ADX calculated by ADX.
+type ADXLines struct {
+	// Plus specifies the positive directional index (+DI).
+	Plus decimal.Decimal `json:"plus"`
+
+	// Minus specifies the negative directional index (-DI).
+	Minus decimal.Decimal `json:"minus"`
+
+	// ADX specifies the smoothed average directional index.
+	ADX decimal.Decimal `json:"adx"`
+}
+
+// NewADX validates provided configuration options and
+// creates new ADX indicator instance.
+func NewADX(length int, smoothing Smoothing) (ADX, error) {
+	adx := ADX{
+		length:    length,
+		smoothing: smoothing,
 	}
 
-	if err := aroon.validate(); err != nil {
-		return Aroon{}, err
+	if err := adx.validate(); err != nil {
+		return ADX{}, err
 	}
 
-	return aroon, nil
+	return adx, nil
 }
 
 // validate checks whether the indicator has valid configuration properties.
-func (aroon *Aroon) validate() error {
-	if err := aroon.trend.Validate(); err != nil {
-		return err
+func (adx *ADX) validate() error {
+	if adx.length < 1 {
+		return &ConfigError{
+			Indicator: NameADX,
+			Field:     "length",
+			Value:     adx.length,
+			Err:       ErrInvalidLength,
+		}
 	}
 
-	if aroon.length < 1 {
-		return ErrInvalidLength
+	if err := adx.smoothing.Validate(); err != nil {
+		return &ConfigError{
+			Indicator: NameADX,
+			Field:     "smoothing",
+			Value:     adx.smoothing,
+			Err:       err,
+		}
 	}
 
-	aroon.valid = true
+	adx.valid = true
 
 	return nil
 }
 
-// Calc calculates Aroon from the provided data points slice.
+// CalcCandles calculates ADX from the provided candles slice.
+func (adx ADX) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	ll, err := adx.CalcLines(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return ll.ADX, nil
+}
+
+// CalcLines calculates both directional index lines and ADX from the
+// provided candles slice.
 // Calculation is based on formula provided by investopedia.
-// https://www.investopedia.com/terms/a/aroon.asp.
-// All credits are due to Tushar Chande who developed Aroon indicator.
-func (aroon Aroon) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
-	if !aroon.valid {
-		return decimal.Zero, ErrInvalidIndicator
+// https://www.investopedia.com/terms/a/adx.asp.
+// All credits are due to J. Welles Wilder Jr. who developed ADX indicator.
+func (adx ADX) CalcLines(cc []Candle) (ADXLines, error) {
+	if !adx.valid {
+		return ADXLines{}, ErrInvalidIndicator
 	}
 
-	if len(dd) != aroon.Count() {
-		return decimal.Zero, ErrInvalidDataSize
+	if len(cc) != adx.Count() {
+		return ADXLines{}, ErrInvalidDataSize
 	}
 
-	res := dd[0]
-	prd := decimal.Zero
+	var (
+		n           = adx.smoothing.count(adx.length)
+		dx          = make([]decimal.Decimal, n)
+		plus, minus decimal.Decimal
+	)
 
-	refresh := func(val decimal.Decimal) bool {
-		fn := res.LessThanOrEqual
-		if aroon.trend == TrendDown {
-			fn = res.GreaterThanOrEqual
+	for i := range dx {
+		var err error
+
+		plus, minus, err = directionalIndexes(cc[i:i+n+1], adx.length, adx.smoothing)
+		if err != nil {
+			// unlikely to happen
+			return ADXLines{}, err
 		}
 
-		return fn(val)
+		sum := plus.Add(minus)
+		if sum.Equal(decimal.Zero) {
+			dx[i] = decimal.Zero
+			continue
+		}
+
+		dx[i] = plus.Sub(minus).Abs().Div(sum).Mul(_hundred)
 	}
 
-	for i := 0; i < len(dd); i++ {
-		if refresh(dd[i]) {
-			res = dd[i]
-			prd = decimal.NewFromInt(int64(aroon.length - i - 1))
-		}
+	res, err := adx.smoothing.calc(dx, adx.length)
+	if err != nil {
+		// unlikely to happen
+		return ADXLines{}, err
 	}
 
-	return decimal.NewFromInt(int64(aroon.length)).Sub(prd).
-		Mul(_hundred).Div(decimal.NewFromInt(int64(aroon.length))), nil
+	return ADXLines{
+		Plus:  plus,
+		Minus: minus,
+		ADX:   res,
+	}, nil
 }
 
-// Count determines the total amount of data points needed for Aroon
+// Count determines the total amount of candles needed for ADX
 // calculation.
-func (aroon Aroon) Count() int {
-	return aroon.length
+func (adx ADX) Count() int {
+	return adx.smoothing.count(adx.length) * 2
 }
 
-// BB holds all the necessary information needed to calculate Bollinger Bands.
+// Describe returns structured information about ADX and its output.
+func (adx ADX) Describe() Description {
+	return Description{
+		Name:    NameADX,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}
+}
+
+// Alligator holds all the necessary information needed to calculate
+// Williams Alligator lines.
 // The zero value is not usable.
-type BB struct {
-	// valid specifies whether BB paremeters were validated.
+type Alligator struct {
+	// valid specifies whether Alligator paremeters were validated.
 	valid bool
 
-	// percent specifies whether returned number should be in units (if false)
-	// or percent (true).
-	percent bool
-
-	// band specifies which bollinger band to calculate.
-	band Band
+	// jaw specifies the indicator of the slowest line.
+	jaw Indicator
 
-	// stdDev specifies how to adjust standard deviation.
-	stdDev decimal.Decimal
+	// teeth specifies the indicator of the middle line.
+	teeth Indicator
 
-	// sma specifies SMA indicator configuration.
-	sma SMA
+	// lips specifies the indicator of the fastest line.
+	lips Indicator
 }
 
-// NewBB validates provided configuration options and creates
-// new BB indicator.
-func NewBB(percent bool, band Band, stdDev decimal.Decimal, length int) (BB, error) {
-	sma, err := NewSMA(length)
-	if err != nil {
-		return BB{}, err
-	}
+// AlligatorLines holds all lines calculated by Alligator.
+type AlligatorLines struct {
+	// Jaw specifies the slowest line.
+	Jaw decimal.Decimal `json:"jaw"`
 
-	bb := BB{
-		percent: percent,
-		band:    band,
-		stdDev:  stdDev,
-		sma:     sma,
+	// Teeth specifies the middle line.
+	Teeth decimal.Decimal `json:"teeth"`
+
+	// Lips specifies the fastest line.
+	Lips decimal.Decimal `json:"lips"`
+}
+
+// NewAlligator validates provided configuration options and
+// creates new Alligator indicator instance. Every line is calculated
+// from the median prices of the candles.
+// Commonly used lines are SMMA 13 shifted by 8, SMMA 8 shifted by 5 and
+// SMMA 5 shifted by 3, which can be created with NewOffset.
+func NewAlligator(jaw, teeth, lips Indicator) (Alligator, error) {
+	alligator := Alligator{
+		jaw:   jaw,
+		teeth: teeth,
+		lips:  lips,
 	}
 
-	if err := bb.validate(); err != nil {
-		return BB{}, err
+	if err := alligator.validate(); err != nil {
+		return Alligator{}, err
 	}
 
-	return bb, nil
+	return alligator, nil
 }
 
 // validate checks whether the indicator has valid configuration properties.
-func (bb *BB) validate() error {
-	if err := bb.band.Validate(); err != nil {
-		return err
+func (alligator *Alligator) validate() error {
+	if alligator.jaw == nil {
+		return &ConfigError{
+			Indicator: NameAlligator,
+			Field:     "jaw",
+			Err:       ErrInvalidIndicator,
+		}
 	}
 
-	if bb.percent && bb.band == BandWidth {
-		return errors.New("invalid bb configuration")
+	if alligator.teeth == nil {
+		return &ConfigError{
+			Indicator: NameAlligator,
+			Field:     "teeth",
+			Err:       ErrInvalidIndicator,
+		}
 	}
 
-	bb.valid = true
+	if alligator.lips == nil {
+		return &ConfigError{
+			Indicator: NameAlligator,
+			Field:     "lips",
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	alligator.valid = true
 
 	return nil
 }
 
-// Calc calculates BB from the provided data points slice.
-// Calculation is based on formula provided by investopedia.
-// https://www.investopedia.com/terms/b/bollingerbands.asp.
-// All credits are due to John Bollinger who developed BB indicator.
-func (bb BB) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
-	if !bb.valid {
-		return decimal.Zero, ErrInvalidIndicator
+// CalcLines calculates Alligator lines from the provided candles slice.
+// Calculation is based on formula provided by tradingview.
+// https://www.tradingview.com/support/solutions/43000592305-williams-alligator/.
+// All credits are due to Bill Williams who developed Alligator indicator.
+func (alligator Alligator) CalcLines(cc []Candle) (AlligatorLines, error) {
+	if !alligator.valid {
+		return AlligatorLines{}, ErrInvalidIndicator
 	}
 
-	if len(dd) != bb.Count() {
-		return decimal.Zero, ErrInvalidDataSize
+	if len(cc) != alligator.Count() {
+		return AlligatorLines{}, ErrInvalidDataSize
 	}
 
-	res, err := bb.sma.Calc(dd)
+	dd := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		dd[i] = medianPrice(cc[i])
+	}
+
+	jaw, err := alligator.jaw.Calc(dd[len(dd)-alligator.jaw.Count():])
 	if err != nil {
-		// unlikely to happen
-		return decimal.Zero, err
+		return AlligatorLines{}, err
 	}
 
-	sdev := sdev(dd).Mul(bb.stdDev)
+	teeth, err := alligator.teeth.Calc(dd[len(dd)-alligator.teeth.Count():])
+	if err != nil {
+		return AlligatorLines{}, err
+	}
 
-	switch bb.band {
-	case BandUpper:
-		if bb.percent {
-			return res.Add(sdev).Div(res).Sub(_one).Mul(_hundred), nil
-		}
+	lips, err := alligator.lips.Calc(dd[len(dd)-alligator.lips.Count():])
+	if err != nil {
+		return AlligatorLines{}, err
+	}
 
-		return res.Add(sdev), nil
-	case BandLower:
-		if bb.percent {
-			return res.Sub(sdev).Div(res).Sub(_one).Mul(_hundred), nil
-		}
+	return AlligatorLines{
+		Jaw:   jaw,
+		Teeth: teeth,
+		Lips:  lips,
+	}, nil
+}
 
-		return res.Sub(sdev), nil
-	default: // BB is validated, only BandWidth is left.
-		return res.Add(sdev).Sub(res.Sub(sdev)).Div(res).Mul(_hundred), nil
+// Count determines the total amount of candles needed for Alligator
+// calculation.
+func (alligator Alligator) Count() int {
+	res := alligator.jaw.Count()
+
+	if c := alligator.teeth.Count(); c > res {
+		res = c
+	}
+
+	if c := alligator.lips.Count(); c > res {
+		res = c
 	}
+
+	return res
 }
 
-// Count determines the total amount of data points needed for BB
-// calculation.
-func (bb BB) Count() int {
-	return bb.sma.Count()
+// Describe returns structured information about Alligator and its output.
+func (alligator Alligator) Describe() Description {
+	return Description{
+		Name:    NameAlligator,
+		Input:   InputCandle,
+		Overlay: true,
+	}
 }
 
-// CCI holds all the necessary information needed to calculate commodity
-// channel index.
+// ALMA holds all the necessary information needed to calculate Arnaud
+// Legoux moving average.
 // The zero value is not usable.
-type CCI struct {
-	// valid specifies whether CCI paremeters were validated.
+type ALMA struct {
+	// valid specifies whether ALMA paremeters were validated.
 	valid bool
 
-	// ma specifies moving average indicator configuration.
-	ma Indicator
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
 
-	// factor is used to scale CCI to provide more readable numbers.
-	// default is 0.015f.
-	factor decimal.Decimal
+	// offset specifies where the peak of the gaussian weights is
+	// located, from 0 (the oldest data point) to 1 (the newest one).
+	offset decimal.Decimal
+
+	// sigma specifies the sharpness of the gaussian weights.
+	sigma decimal.Decimal
 }
 
-// NewCCI validates provided configuration options and creates
-// new CCI indicator.
-// If provided factor is zero, default value is going to be used (0.015f).
-func NewCCI(mat MAType, length int, factor decimal.Decimal) (CCI, error) {
-	if factor.Equal(decimal.Zero) {
-		factor = decimal.RequireFromString("0.015")
+// NewALMA validates provided configuration options and
+// creates new ALMA indicator instance.
+// Commonly used values are 9, 0.85 and 6.
+func NewALMA(length int, offset, sigma decimal.Decimal) (ALMA, error) {
+	alma := ALMA{
+		length: length,
+		offset: offset,
+		sigma:  sigma,
 	}
 
-	ma, err := mat.Initialize(length)
-	if err != nil {
-		return CCI{}, err
+	if err := alma.validate(); err != nil {
+		return ALMA{}, err
 	}
 
-	cci := CCI{
-		ma:     ma,
-		factor: factor,
-	}
+	return alma, nil
+}
 
-	if err := cci.validate(); err != nil {
-		return CCI{}, err
+// validate checks whether the indicator has valid configuration properties.
+func (alma *ALMA) validate() error {
+	if alma.length < 1 {
+		return &ConfigError{
+			Indicator: NameALMA,
+			Field:     "length",
+			Value:     alma.length,
+			Err:       ErrInvalidLength,
+		}
 	}
 
-	return cci, nil
-}
+	if alma.offset.LessThan(decimal.Zero) || alma.offset.GreaterThan(_one) {
+		return &ConfigError{
+			Indicator: NameALMA,
+			Field:     "offset",
+			Value:     alma.offset,
+			Err:       errors.New("invalid offset"),
+		}
+	}
 
-// validate checks whether the indicator has valid configuration properties.
-func (cci *CCI) validate() error {
-	if cci.factor.LessThanOrEqual(decimal.Zero) {
-		return errors.New("invalid factor")
+	if alma.sigma.LessThanOrEqual(decimal.Zero) {
+		return &ConfigError{
+			Indicator: NameALMA,
+			Field:     "sigma",
+			Value:     alma.sigma,
+			Err:       errors.New("invalid sigma"),
+		}
 	}
 
-	cci.valid = true
+	alma.valid = true
 
 	return nil
 }
 
-// Calc calculates CCI from the provided data points slice.
-// Calculation is based on formula provided by investopedia.
-// https://www.investopedia.com/terms/c/commoditychannelindex.asp.
-// All credits are due to Donald Lambert who developed CCI indicator.
-func (cci CCI) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
-	if !cci.valid {
+// Calc calculates ALMA from the provided data points slice.
+// Calculation is based on formula provided by tradingview.
+// https://www.tradingview.com/pine-script-reference/v5/#fun_ta.alma.
+// All credits are due to Arnaud Legoux and Dimitrios Kouzis-Loukas who
+// developed ALMA indicator.
+func (alma ALMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !alma.valid {
 		return decimal.Zero, ErrInvalidIndicator
 	}
 
-	if len(dd) != cci.Count() {
+	if len(dd) != alma.Count() {
 		return decimal.Zero, ErrInvalidDataSize
 	}
 
-	res, err := cci.ma.Calc(dd)
-	if err != nil {
-		return decimal.Zero, err
-	}
+	ww := alma.weights()
+	res := decimal.Zero
+	norm := decimal.Zero
 
-	dnm := cci.factor.Mul(mdev(dd))
+	for i := range dd {
+		w := decimal.NewFromFloat(ww[i])
+		res = res.Add(dd[i].Mul(w))
+		norm = norm.Add(w)
+	}
 
-	if dnm.Equal(decimal.Zero) {
+	if norm.Equal(decimal.Zero) {
 		return decimal.Zero, nil
 	}
 
-	return dd[len(dd)-1].Sub(res).Div(dnm), nil
+	return res.Div(norm), nil
 }
 
-// Count determines the total amount of data points needed for CCI
+// weights calculates the gaussian weights of the data points, starting
+// with the oldest one.
+func (alma ALMA) weights() []float64 {
+	offset, _ := alma.offset.Float64()
+	sigma, _ := alma.sigma.Float64()
+
+	m := offset * float64(alma.length-1)
+	s := float64(alma.length) / sigma
+	ww := make([]float64, alma.length)
+
+	for i := range ww {
+		ww[i] = math.Exp(-math.Pow(float64(i)-m, 2) / (2 * s * s))
+	}
+
+	return ww
+}
+
+// Count determines the total amount of data points needed for ALMA
 // calculation.
-func (cci CCI) Count() int {
-	return cci.ma.Count()
+func (alma ALMA) Count() int {
+	return alma.length
 }
 
-// DEMA holds all the necessary information needed to calculate
-// double exponential moving average.
+// Describe returns structured information about ALMA and its output.
+func (alma ALMA) Describe() Description {
+	return Description{
+		Name:    NameALMA,
+		Input:   InputClose,
+		Overlay: true,
+	}
+}
+
+// APO holds all the necessary information needed to calculate absolute
+// price oscillator.
 // The zero value is not usable.
-type DEMA struct {
-	// valid specifies whether DEMA paremeters were validated.
+type APO struct {
+	// valid specifies whether APO paremeters were validated.
 	valid bool
 
-	// ema specifies what ema should be used for dema calculations.
-	ema EMA
-}
-
-// NewDEMA validates provided configuration options and creates
-// new DEMA indicator.
-func NewDEMA(length int) (DEMA, error) {
-	ema, err := NewEMA(length)
-	if err != nil {
-		return DEMA{}, err
-	}
+	// price specifies which price of candles should be used as the
+	// source.
+	price Price
 
-	return DEMA{
-		valid: true,
-		ema:   ema,
-	}, nil
+	// macd specifies the difference between the fast and slow moving
+	// averages.
+	macd MACD
 }
 
-// Calc calculates DEMA from the provided data points slice.
-// Calculation is based on formula provided by investopedia.
-// https://www.investopedia.com/terms/d/double-exponential-moving-average.asp.
-// All credits are due to Patrick Mulloy who developed DEMA indicator.
-func (dema DEMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
-	if !dema.valid {
-		return decimal.Zero, ErrInvalidIndicator
+// NewAPO validates provided configuration options and
+// creates new APO indicator instance. Both moving averages are
+// created from the provided moving average type.
+// If provided price is zero, default value is going to be used (close).
+// Commonly used values are 12 and 26.
+func NewAPO(mat MAType, price Price, fast, slow int) (APO, error) {
+	if price == 0 {
+		price = PriceClose
 	}
 
-	if len(dd) != dema.Count() {
-		return decimal.Zero, ErrInvalidDataSize
+	if fast >= slow {
+		return APO{}, &ConfigError{
+			Indicator: NameAPO,
+			Field:     "fast",
+			Value:     fast,
+			Err:       ErrInvalidLength,
+		}
 	}
 
-	pres := make([]decimal.Decimal, dema.ema.sma.length)
+	ma1, err := mat.Initialize(fast)
+	if err != nil {
+		return APO{}, fieldError(err, NameAPO, "fast")
+	}
 
-	var err error
+	ma2, err := mat.Initialize(slow)
+	if err != nil {
+		return APO{}, fieldError(err, NameAPO, "slow")
+	}
 
-	pres[0], err = dema.ema.sma.Calc(dd[:dema.ema.sma.length])
+	macd, err := NewMACD(ma1, ma2, nil)
 	if err != nil {
 		// unlikely to happen
-		return decimal.Zero, err
+		return APO{}, err
 	}
 
-	for i := dema.ema.sma.length; i < len(dd); i++ {
-		pres[i-dema.ema.sma.length+1], err = dema.ema.CalcNext(pres[i-dema.ema.sma.length], dd[i])
+	apo := APO{
+		price: price,
+		macd:  macd,
+	}
+
+	if err := apo.validate(); err != nil {
+		return APO{}, err
+	}
+
+	return apo, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (apo *APO) validate() error {
+	if err := apo.price.Validate(); err != nil {
+		return &ConfigError{
+			Indicator: NameAPO,
+			Field:     "price",
+			Value:     apo.price,
+			Err:       err,
+		}
+	}
+
+	if !apo.macd.valid {
+		return &ConfigError{
+			Indicator: NameAPO,
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	apo.valid = true
+
+	return nil
+}
+
+// Calc calculates APO from the provided data points slice, which are
+// used as the source as is.
+// APO is calculated the same way as MACD line, by subtracting the slow
+// moving average from the fast one.
+func (apo APO) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !apo.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != apo.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	return apo.macd.line(dd)
+}
+
+// CalcCandles calculates APO from the provided candles slice using the
+// selected price of every candle as the source.
+func (apo APO) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !apo.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != apo.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	return calcPrices(cc, apo.price.of, apo)
+}
+
+// Count determines the total amount of data points needed for APO
+// calculation.
+func (apo APO) Count() int {
+	return apo.macd.lineCount()
+}
+
+// Describe returns structured information about APO and its output.
+func (apo APO) Describe() Description {
+	return Description{
+		Name:  NameAPO,
+		Input: InputClose,
+	}
+}
+
+// Aroon holds all the necessary information needed to calculate Aroon.
+// The zero value is not usable.
+type Aroon struct {
+	// valid specifies whether Aroon paremeters were validated.
+	valid bool
+
+	// trend specifies which Aroon trend to use during the
+	// calculation process.
+	trend Trend
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewAroon validates provided configuration options and
+// creates new Aroon indicator instance.
+func NewAroon(trend Trend, length int) (Aroon, error) {
+	aroon := Aroon{
+		trend:  trend,
+		length: length,
+	}
+
+	if err := aroon.validate(); err != nil {
+		return Aroon{}, err
+	}
+
+	return aroon, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (aroon *Aroon) validate() error {
+	if err := aroon.trend.Validate(); err != nil {
+		return &ConfigError{
+			Indicator: NameAroon,
+			Field:     "trend",
+			Value:     aroon.trend,
+			Err:       err,
+		}
+	}
+
+	if aroon.length < 1 {
+		return &ConfigError{
+			Indicator: NameAroon,
+			Field:     "length",
+			Value:     aroon.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	aroon.valid = true
+
+	return nil
+}
+
+// Calc calculates Aroon from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/a/aroon.asp.
+// All credits are due to Tushar Chande who developed Aroon indicator.
+func (aroon Aroon) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !aroon.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != aroon.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	res := dd[0]
+	prd := decimal.Zero
+
+	refresh := func(val decimal.Decimal) bool {
+		fn := res.LessThanOrEqual
+		if aroon.trend == TrendDown {
+			fn = res.GreaterThanOrEqual
+		}
+
+		return fn(val)
+	}
+
+	for i := 0; i < len(dd); i++ {
+		if refresh(dd[i]) {
+			res = dd[i]
+			prd = decimal.NewFromInt(int64(aroon.length - i - 1))
+		}
+	}
+
+	return decimal.NewFromInt(int64(aroon.length)).Sub(prd).
+		Mul(_hundred).Div(decimal.NewFromInt(int64(aroon.length))), nil
+}
+
+// Count determines the total amount of data points needed for Aroon
+// calculation.
+func (aroon Aroon) Count() int {
+	return aroon.length
+}
+
+// Describe returns structured information about Aroon and its output.
+func (aroon Aroon) Describe() Description {
+	return Description{
+		Name:    NameAroon,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}
+}
+
+// AroonOsc holds all the necessary information needed to calculate
+// aroon oscillator.
+// The zero value is not usable.
+type AroonOsc struct {
+	// valid specifies whether AroonOsc paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// AroonOscLines holds all values calculated by AroonOsc.
+type AroonOscLines struct {
+	// Up specifies the value of Aroon with TrendUp.
+	Up decimal.Decimal `json:"up"`
+
+	// Down specifies the value of Aroon with TrendDown.
+	Down decimal.Decimal `json:"down"`
+
+	// Oscillator specifies the difference between up and down values.
+	Oscillator decimal.Decimal `json:"oscillator"`
+}
+
+// NewAroonOsc validates provided configuration options and
+// creates new AroonOsc indicator instance.
+func NewAroonOsc(length int) (AroonOsc, error) {
+	osc := AroonOsc{length: length}
+
+	if err := osc.validate(); err != nil {
+		return AroonOsc{}, err
+	}
+
+	return osc, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (osc *AroonOsc) validate() error {
+	if osc.length < 1 {
+		return &ConfigError{
+			Indicator: NameAroonOsc,
+			Field:     "length",
+			Value:     osc.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	osc.valid = true
+
+	return nil
+}
+
+// Calc calculates AroonOsc from the provided data points slice.
+// The result is the difference between Aroon up and Aroon down values.
+// Use CalcLines to get both Aroon values as well.
+func (osc AroonOsc) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	lines, err := osc.CalcLines(dd)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return lines.Oscillator, nil
+}
+
+// CalcLines calculates Aroon up, Aroon down and their difference from
+// the provided data points slice. Both trends are measured during a
+// single pass over the data points.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/a/aroonoscillator.asp.
+func (osc AroonOsc) CalcLines(dd []decimal.Decimal) (AroonOscLines, error) {
+	if !osc.valid {
+		return AroonOscLines{}, ErrInvalidIndicator
+	}
+
+	if len(dd) != osc.Count() {
+		return AroonOscLines{}, ErrInvalidDataSize
+	}
+
+	high, low := dd[0], dd[0]
+	highIdx, lowIdx := 0, 0
+
+	for i := 1; i < len(dd); i++ {
+		if dd[i].GreaterThanOrEqual(high) {
+			high = dd[i]
+			highIdx = i
+		}
+
+		if dd[i].LessThanOrEqual(low) {
+			low = dd[i]
+			lowIdx = i
+		}
+	}
+
+	length := decimal.NewFromInt(int64(osc.length))
+
+	value := func(idx int) decimal.Decimal {
+		return decimal.NewFromInt(int64(idx + 1)).Mul(_hundred).Div(length)
+	}
+
+	up, down := value(highIdx), value(lowIdx)
+
+	return AroonOscLines{
+		Up:         up,
+		Down:       down,
+		Oscillator: up.Sub(down),
+	}, nil
+}
+
+// Count determines the total amount of data points needed for AroonOsc
+// calculation.
+func (osc AroonOsc) Count() int {
+	return osc.length
+}
+
+// Describe returns structured information about AroonOsc and its output.
+func (osc AroonOsc) Describe() Description {
+	return Description{
+		Name:    NameAroonOsc,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     _hundred.Neg(),
+		Max:     _hundred,
+	}
+}
+
+// ATR holds all the necessary information needed to calculate average
+// true range.
+// The zero value is not usable.
+type ATR struct {
+	// valid specifies whether ATR paremeters were validated.
+	valid bool
+
+	// length specifies how many true range values should be used
+	// during the calculations.
+	length int
+
+	// smoothing specifies how true range values should be averaged.
+	smoothing Smoothing
+}
+
+// NewATR validates provided configuration options and
+// creates new ATR indicator instance.
+func NewATR(length int, smoothing Smoothing) (ATR, error) {
+	atr := ATR{
+		length:    length,
+		smoothing: smoothing,
+	}
+
+	if err := atr.validate(); err != nil {
+		return ATR{}, err
+	}
+
+	return atr, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (atr *ATR) validate() error {
+	if atr.length < 1 {
+		return &ConfigError{
+			Indicator: NameATR,
+			Field:     "length",
+			Value:     atr.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if err := atr.smoothing.Validate(); err != nil {
+		return &ConfigError{
+			Indicator: NameATR,
+			Field:     "smoothing",
+			Value:     atr.smoothing,
+			Err:       err,
+		}
+	}
+
+	atr.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates ATR from the provided candles slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/a/atr.asp.
+// All credits are due to J. Welles Wilder Jr. who developed ATR indicator.
+func (atr ATR) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !atr.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != atr.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	return atr.smoothing.calc(trueRanges(cc), atr.length)
+}
+
+// Count determines the total amount of candles needed for ATR
+// calculation.
+func (atr ATR) Count() int {
+	return atr.smoothing.count(atr.length) + 1
+}
+
+// Describe returns structured information about ATR and its output.
+func (atr ATR) Describe() Description {
+	return Description{
+		Name:  NameATR,
+		Input: InputCandle,
+	}
+}
+
+// BB holds all the necessary information needed to calculate Bollinger Bands.
+// The zero value is not usable.
+type BB struct {
+	// valid specifies whether BB paremeters were validated.
+	valid bool
+
+	// percent specifies whether returned number should be in units (if false)
+	// or percent (true).
+	percent bool
+
+	// band specifies which bollinger band to calculate.
+	band Band
+
+	// stdDev specifies how to adjust standard deviation.
+	stdDev decimal.Decimal
+
+	// sma specifies SMA indicator configuration.
+	sma SMA
+}
+
+// NewBB validates provided configuration options and creates
+// new BB indicator.
+func NewBB(percent bool, band Band, stdDev decimal.Decimal, length int) (BB, error) {
+	sma, err := NewSMA(length)
+	if err != nil {
+		return BB{}, fieldError(err, NameBB, "length")
+	}
+
+	bb := BB{
+		percent: percent,
+		band:    band,
+		stdDev:  stdDev,
+		sma:     sma,
+	}
+
+	if err := bb.validate(); err != nil {
+		return BB{}, err
+	}
+
+	return bb, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (bb *BB) validate() error {
+	if err := bb.band.Validate(); err != nil {
+		return &ConfigError{
+			Indicator: NameBB,
+			Field:     "band",
+			Value:     bb.band,
+			Err:       err,
+		}
+	}
+
+	if bb.percent && bb.band == BandWidth {
+		return &ConfigError{
+			Indicator: NameBB,
+			Field:     "band",
+			Value:     bb.band,
+			Err:       errors.New("invalid bb configuration"),
+		}
+	}
+
+	bb.valid = true
+
+	return nil
+}
+
+// Calc calculates BB from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/b/bollingerbands.asp.
+// All credits are due to John Bollinger who developed BB indicator.
+func (bb BB) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !bb.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != bb.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	upper, middle, lower, err := bb.bands(dd)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	switch bb.band {
+	case BandUpper:
+		if bb.percent {
+			return upper.Div(middle).Sub(_one).Mul(_hundred), nil
+		}
+
+		return upper, nil
+	case BandLower:
+		if bb.percent {
+			return lower.Div(middle).Sub(_one).Mul(_hundred), nil
+		}
+
+		return lower, nil
+	default: // BB is validated, only BandWidth is left.
+		return bandWidth(upper, middle, lower).Mul(_hundred), nil
+	}
+}
+
+// bands calculates the upper, middle and lower bands from the provided
+// data points slice.
+func (bb BB) bands(dd []decimal.Decimal) (upper, middle, lower decimal.Decimal, err error) {
+	middle, err = bb.sma.Calc(dd)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, decimal.Zero, err
+	}
+
+	dev := sdev(dd).Mul(bb.stdDev)
+
+	return middle.Add(dev), middle, middle.Sub(dev), nil
+}
+
+// Count determines the total amount of data points needed for BB
+// calculation.
+func (bb BB) Count() int {
+	return bb.sma.Count()
+}
+
+// Describe returns structured information about BB and its output.
+func (bb BB) Describe() Description {
+	if bb.percent || bb.band == BandWidth {
+		return Description{
+			Name:  NameBB,
+			Input: InputClose,
+		}
+	}
+
+	desc := bb.sma.Describe()
+	desc.Name = NameBB
+
+	return desc
+}
+
+// BBW holds all the necessary information needed to calculate Bollinger
+// Bandwidth.
+// The zero value is not usable.
+type BBW struct {
+	// valid specifies whether BBW paremeters were validated.
+	valid bool
+
+	// bb specifies the Bollinger Bands configuration.
+	bb BB
+}
+
+// NewBBW validates provided configuration options and
+// creates new BBW indicator instance.
+func NewBBW(stdDev decimal.Decimal, length int) (BBW, error) {
+	bb, err := NewBB(false, BandWidth, stdDev, length)
+	if err != nil {
+		return BBW{}, fieldError(err, NameBBW, "")
+	}
+
+	return BBW{
+		valid: true,
+		bb:    bb,
+	}, nil
+}
+
+// Calc calculates BBW from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/b/bollinger-bands.asp.
+// All credits are due to John Bollinger who developed Bandwidth indicator.
+func (bbw BBW) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !bbw.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != bbw.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	upper, middle, lower, err := bbw.bb.bands(dd)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	return bandWidth(upper, middle, lower), nil
+}
+
+// Count determines the total amount of data points needed for BBW
+// calculation.
+func (bbw BBW) Count() int {
+	return bbw.bb.Count()
+}
+
+// Describe returns structured information about BBW and its output.
+func (bbw BBW) Describe() Description {
+	return Description{
+		Name:  NameBBW,
+		Input: InputClose,
+	}
+}
+
+// Beta holds all the necessary information needed to calculate rolling
+// beta of an asset against its benchmark.
+// The zero value is not usable.
+type Beta struct {
+	// valid specifies whether Beta paremeters were validated.
+	valid bool
+
+	// length specifies how many returns of every series should be used
+	// during the calculations.
+	length int
+}
+
+// NewBeta validates provided configuration options and
+// creates new Beta indicator instance.
+func NewBeta(length int) (Beta, error) {
+	beta := Beta{length: length}
+
+	if err := beta.validate(); err != nil {
+		return Beta{}, err
+	}
+
+	return beta, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (beta *Beta) validate() error {
+	if beta.length < 2 {
+		return &ConfigError{
+			Indicator: NameBeta,
+			Field:     "length",
+			Value:     beta.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	beta.valid = true
+
+	return nil
+}
+
+// CalcPair calculates Beta from the provided asset and benchmark data
+// points slices. The result is the covariance of the asset's and
+// benchmark's returns divided by the variance of the benchmark's returns.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/b/beta.asp.
+func (beta Beta) CalcPair(dd, bb []decimal.Decimal) (decimal.Decimal, error) {
+	if !beta.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != beta.Count() || len(bb) != beta.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	ra, rb := returns(dd), returns(bb)
+
+	v := covariance(rb, rb)
+	if v.Equal(decimal.Zero) {
+		return decimal.Zero, nil
+	}
+
+	return covariance(ra, rb).Div(v), nil
+}
+
+// Count determines the total amount of data points of every series needed
+// for Beta calculation.
+func (beta Beta) Count() int {
+	return beta.length + 1
+}
+
+// Describe returns structured information about Beta and its output.
+func (beta Beta) Describe() Description {
+	return Description{
+		Name:  NameBeta,
+		Input: InputPair,
+	}
+}
+
+// BOP holds all the necessary information needed to calculate balance of
+// power.
+// The zero value is not usable.
+type BOP struct {
+	// valid specifies whether BOP paremeters were validated.
+	valid bool
+
+	// ma specifies the optional moving average that is used to smooth
+	// the raw values. Nil means that no smoothing is applied.
+	ma Indicator
+}
+
+// NewBOP validates provided configuration options and
+// creates new BOP indicator instance.
+// If provided moving average is nil, raw balance of power of the newest
+// candle is calculated.
+func NewBOP(ma Indicator) (BOP, error) {
+	bop := BOP{
+		ma: ma,
+	}
+
+	if err := bop.validate(); err != nil {
+		// unlikely to happen
+		return BOP{}, err
+	}
+
+	return bop, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (bop *BOP) validate() error {
+	bop.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates BOP from the provided candles slice.
+// Calculation is based on formula provided by tradingview.
+// https://www.tradingview.com/support/solutions/43000589100-balance-of-power-bop/.
+// All credits are due to Igor Livshin who developed BOP indicator.
+func (bop BOP) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !bop.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != bop.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	dd := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		rng := cc[i].High.Sub(cc[i].Low)
+		if rng.Equal(decimal.Zero) {
+			dd[i] = decimal.Zero
+			continue
+		}
+
+		dd[i] = cc[i].Close.Sub(cc[i].Open).Div(rng)
+	}
+
+	if bop.ma == nil {
+		return dd[0], nil
+	}
+
+	return bop.ma.Calc(dd)
+}
+
+// Count determines the total amount of candles needed for BOP
+// calculation.
+func (bop BOP) Count() int {
+	if bop.ma == nil {
+		return 1
+	}
+
+	return bop.ma.Count()
+}
+
+// Describe returns structured information about BOP and its output.
+func (bop BOP) Describe() Description {
+	return Description{
+		Name:    NameBOP,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     _one.Neg(),
+		Max:     _one,
+	}
+}
+
+// Calmar holds all the necessary information needed to calculate rolling
+// Calmar ratio.
+// The zero value is not usable.
+type Calmar struct {
+	// valid specifies whether Calmar paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+
+	// periods specifies how many data points make up a year. Zero means
+	// that the return is not annualized.
+	periods int
+}
+
+// NewCalmar validates provided configuration options and
+// creates new Calmar indicator instance.
+// Commonly used amount of periods is 252 for daily data points.
+func NewCalmar(length, periods int) (Calmar, error) {
+	calmar := Calmar{
+		length:  length,
+		periods: periods,
+	}
+
+	if err := calmar.validate(); err != nil {
+		return Calmar{}, err
+	}
+
+	return calmar, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (calmar *Calmar) validate() error {
+	if calmar.length < 2 {
+		return &ConfigError{
+			Indicator: NameCalmar,
+			Field:     "length",
+			Value:     calmar.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if calmar.periods < 0 {
+		return &ConfigError{
+			Indicator: NameCalmar,
+			Field:     "periods",
+			Value:     calmar.periods,
+			Err:       errors.New("invalid periods"),
+		}
+	}
+
+	calmar.valid = true
+
+	return nil
+}
+
+// Calc calculates Calmar from the provided data points slice. The return
+// of the window is divided by its maximum drawdown. ErrOverflow is
+// returned when the annualized return cannot be represented.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/c/calmarratio.asp.
+// All credits are due to Terry W. Young who developed Calmar ratio.
+func (calmar Calmar) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !calmar.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != calmar.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	mdd := maxDrawdown(dd)
+	if mdd.Equal(decimal.Zero) || dd[0].LessThanOrEqual(decimal.Zero) {
+		return decimal.Zero, nil
+	}
+
+	ret, err := annualReturn(dd[len(dd)-1].Div(dd[0]), len(dd)-1, calmar.periods)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return ret.Div(mdd), nil
+}
+
+// Count determines the total amount of data points needed for Calmar
+// calculation.
+func (calmar Calmar) Count() int {
+	return calmar.length
+}
+
+// Describe returns structured information about Calmar and its output.
+func (calmar Calmar) Describe() Description {
+	return Description{
+		Name:  NameCalmar,
+		Input: InputClose,
+	}
+}
+
+// CandleStats holds all the necessary information needed to calculate
+// rolling average candle body and range statistics.
+// The zero value is not usable.
+type CandleStats struct {
+	// valid specifies whether CandleStats paremeters were validated.
+	valid bool
+
+	// length specifies how many candles should be used
+	// during the calculations.
+	length int
+}
+
+// CandleStatsLines holds all values calculated by CandleStats.
+type CandleStatsLines struct {
+	// Body specifies the average absolute distance between the open and
+	// close prices.
+	Body decimal.Decimal `json:"body"`
+
+	// Range specifies the average distance between the high and low
+	// prices.
+	Range decimal.Decimal `json:"range"`
+
+	// Ratio specifies the average body divided by the average range.
+	Ratio decimal.Decimal `json:"ratio"`
+}
+
+// NewCandleStats validates provided configuration options and
+// creates new CandleStats indicator instance.
+func NewCandleStats(length int) (CandleStats, error) {
+	cs := CandleStats{length: length}
+
+	if err := cs.validate(); err != nil {
+		return CandleStats{}, err
+	}
+
+	return cs, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (cs *CandleStats) validate() error {
+	if cs.length < 1 {
+		return &ConfigError{
+			Indicator: NameCandleStats,
+			Field:     "length",
+			Value:     cs.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	cs.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates CandleStats body to range ratio from the
+// provided candles slice.
+func (cs CandleStats) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	ll, err := cs.CalcLines(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return ll.Ratio, nil
+}
+
+// CalcLines calculates CandleStats average body, average range and their
+// ratio from the provided candles slice.
+func (cs CandleStats) CalcLines(cc []Candle) (CandleStatsLines, error) {
+	if !cs.valid {
+		return CandleStatsLines{}, ErrInvalidIndicator
+	}
+
+	if len(cc) != cs.Count() {
+		return CandleStatsLines{}, ErrInvalidDataSize
+	}
+
+	body := make([]decimal.Decimal, len(cc))
+	rng := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		body[i] = cc[i].Close.Sub(cc[i].Open).Abs()
+		rng[i] = cc[i].High.Sub(cc[i].Low)
+	}
+
+	ll := CandleStatsLines{
+		Body:  avg(body),
+		Range: avg(rng),
+		Ratio: decimal.Zero,
+	}
+
+	if !ll.Range.Equal(decimal.Zero) {
+		ll.Ratio = ll.Body.Div(ll.Range)
+	}
+
+	return ll, nil
+}
+
+// Count determines the total amount of candles needed for CandleStats
+// calculation.
+func (cs CandleStats) Count() int {
+	return cs.length
+}
+
+// Describe returns structured information about CandleStats and its
+// output.
+func (cs CandleStats) Describe() Description {
+	return Description{
+		Name:    NameCandleStats,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _one,
+	}
+}
+
+// CCI holds all the necessary information needed to calculate commodity
+// channel index.
+// The zero value is not usable.
+type CCI struct {
+	// valid specifies whether CCI paremeters were validated.
+	valid bool
+
+	// ma specifies moving average indicator configuration.
+	ma Indicator
+
+	// factor is used to scale CCI to provide more readable numbers.
+	// default is 0.015f.
+	factor decimal.Decimal
+}
+
+// NewCCI validates provided configuration options and creates
+// new CCI indicator.
+// If provided factor is zero, default value is going to be used (0.015f).
+func NewCCI(mat MAType, length int, factor decimal.Decimal) (CCI, error) {
+	if factor.Equal(decimal.Zero) {
+		factor = decimal.RequireFromString("0.015")
+	}
+
+	ma, err := mat.Initialize(length)
+	if err != nil {
+		return CCI{}, fieldError(err, NameCCI, "length")
+	}
+
+	cci := CCI{
+		ma:     ma,
+		factor: factor,
+	}
+
+	if err := cci.validate(); err != nil {
+		return CCI{}, err
+	}
+
+	return cci, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (cci *CCI) validate() error {
+	if cci.factor.LessThanOrEqual(decimal.Zero) {
+		return &ConfigError{
+			Indicator: NameCCI,
+			Field:     "factor",
+			Value:     cci.factor,
+			Err:       errors.New("invalid factor"),
+		}
+	}
+
+	cci.valid = true
+
+	return nil
+}
+
+// Calc calculates CCI from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/c/commoditychannelindex.asp.
+// All credits are due to Donald Lambert who developed CCI indicator.
+func (cci CCI) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !cci.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != cci.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	res, err := cci.ma.Calc(dd)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	dnm := cci.factor.Mul(mdev(dd))
+
+	if dnm.Equal(decimal.Zero) {
+		return decimal.Zero, nil
+	}
+
+	return dd[len(dd)-1].Sub(res).Div(dnm), nil
+}
+
+// CalcCandles calculates CCI from the typical prices of the provided
+// candles slice.
+func (cci CCI) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !cci.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	dd := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		dd[i] = typicalPrice(cc[i])
+	}
+
+	return cci.Calc(dd)
+}
+
+// Count determines the total amount of data points needed for CCI
+// calculation.
+func (cci CCI) Count() int {
+	return cci.ma.Count()
+}
+
+// Describe returns structured information about CCI and its output.
+func (cci CCI) Describe() Description {
+	return Description{
+		Name:  NameCCI,
+		Input: InputClose,
+	}
+}
+
+// CMF holds all the necessary information needed to calculate
+// Chaikin money flow.
+// The zero value is not usable.
+type CMF struct {
+	// valid specifies whether CMF paremeters were validated.
+	valid bool
+
+	// length specifies how many candles should be used
+	// during the calculations.
+	length int
+}
+
+// NewCMF validates provided configuration options and
+// creates new CMF indicator instance.
+func NewCMF(length int) (CMF, error) {
+	cmf := CMF{length: length}
+
+	if err := cmf.validate(); err != nil {
+		return CMF{}, err
+	}
+
+	return cmf, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (cmf *CMF) validate() error {
+	if cmf.length < 1 {
+		return &ConfigError{
+			Indicator: NameCMF,
+			Field:     "length",
+			Value:     cmf.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	cmf.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates CMF from the provided candles slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/ask/answers/071414/whats-difference-between-chaikin-money-flow-cmf-and-money-flow-index-mfi.asp.
+// All credits are due to Marc Chaikin who developed CMF indicator.
+func (cmf CMF) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !cmf.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != cmf.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	sum := decimal.Zero
+	vol := decimal.Zero
+
+	for i := range cc {
+		sum = sum.Add(moneyFlowMultiplier(cc[i]).Mul(cc[i].Volume))
+		vol = vol.Add(cc[i].Volume)
+	}
+
+	if vol.Equal(decimal.Zero) {
+		return decimal.Zero, nil
+	}
+
+	return sum.Div(vol), nil
+}
+
+// Count determines the total amount of candles needed for CMF
+// calculation.
+func (cmf CMF) Count() int {
+	return cmf.length
+}
+
+// Describe returns structured information about CMF and its output.
+func (cmf CMF) Describe() Description {
+	return Description{
+		Name:    NameCMF,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     _one.Neg(),
+		Max:     _one,
+	}
+}
+
+// CMO holds all the necessary information needed to calculate
+// Chande momentum oscillator.
+// The zero value is not usable.
+type CMO struct {
+	// valid specifies whether CMO paremeters were validated.
+	valid bool
+
+	// length specifies how many price changes should be used
+	// during the calculations.
+	length int
+}
+
+// NewCMO validates provided configuration options and
+// creates new CMO indicator instance.
+func NewCMO(length int) (CMO, error) {
+	cmo := CMO{length: length}
+
+	if err := cmo.validate(); err != nil {
+		return CMO{}, err
+	}
+
+	return cmo, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (cmo *CMO) validate() error {
+	if cmo.length < 1 {
+		return &ConfigError{
+			Indicator: NameCMO,
+			Field:     "length",
+			Value:     cmo.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	cmo.valid = true
+
+	return nil
+}
+
+// Calc calculates CMO from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/c/chandemomentumoscillator.asp.
+// All credits are due to Tushar Chande who developed CMO indicator.
+func (cmo CMO) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !cmo.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != cmo.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	up, down := gains(dd)
+
+	sum := up.Add(down)
+	if sum.Equal(decimal.Zero) {
+		return decimal.Zero, nil
+	}
+
+	return up.Sub(down).Div(sum).Mul(_hundred), nil
+}
+
+// Count determines the total amount of data points needed for CMO
+// calculation.
+func (cmo CMO) Count() int {
+	return cmo.length + 1
+}
+
+// Describe returns structured information about CMO and its output.
+func (cmo CMO) Describe() Description {
+	return Description{
+		Name:    NameCMO,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     _hundred.Neg(),
+		Max:     _hundred,
+	}
+}
+
+// Correlation holds all the necessary information needed to calculate
+// rolling Pearson correlation coefficient of two series.
+// The zero value is not usable.
+type Correlation struct {
+	// valid specifies whether Correlation paremeters were validated.
+	valid bool
+
+	// length specifies how many data points of every series should be
+	// used during the calculations.
+	length int
+}
+
+// NewCorrelation validates provided configuration options and
+// creates new Correlation indicator instance.
+func NewCorrelation(length int) (Correlation, error) {
+	corr := Correlation{length: length}
+
+	if err := corr.validate(); err != nil {
+		return Correlation{}, err
+	}
+
+	return corr, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (corr *Correlation) validate() error {
+	if corr.length < 2 {
+		return &ConfigError{
+			Indicator: NameCorrelation,
+			Field:     "length",
+			Value:     corr.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	corr.valid = true
+
+	return nil
+}
+
+// CalcPair calculates Correlation from the provided data points slices.
+// Series without any variance produce zero.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/c/correlationcoefficient.asp.
+func (corr Correlation) CalcPair(dd, bb []decimal.Decimal) (decimal.Decimal, error) {
+	if !corr.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != corr.Count() || len(bb) != corr.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	return correlation(dd, bb), nil
+}
+
+// Count determines the total amount of data points of every series needed
+// for Correlation calculation.
+func (corr Correlation) Count() int {
+	return corr.length
+}
+
+// Describe returns structured information about Correlation and its
+// output.
+func (corr Correlation) Describe() Description {
+	return Description{
+		Name:    NameCorrelation,
+		Input:   InputPair,
+		Bounded: true,
+		Min:     _one.Neg(),
+		Max:     _one,
+	}
+}
+
+// Decycler holds all the necessary information needed to calculate
+// Ehlers' decycler, which removes the cycle components shorter than the
+// cutoff period by subtracting a two-pole high-pass filter.
+// The zero value is not usable.
+type Decycler struct {
+	// valid specifies whether Decycler paremeters were validated.
+	valid bool
+
+	// length specifies the cutoff period of the filter.
+	length int
+}
+
+// NewDecycler validates provided configuration options and
+// creates new Decycler indicator instance.
+func NewDecycler(length int) (Decycler, error) {
+	dc := Decycler{length: length}
+
+	if err := dc.validate(); err != nil {
+		return Decycler{}, err
+	}
+
+	return dc, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (dc *Decycler) validate() error {
+	if dc.length < 3 {
+		return &ConfigError{
+			Indicator: NameDecycler,
+			Field:     "length",
+			Value:     dc.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	dc.valid = true
+
+	return nil
+}
+
+// Calc calculates Decycler from the provided data points slice.
+// The high-pass filter is seeded with zeros and warmed up over the data
+// points.
+// Calculation is based on formula provided by John F. Ehlers in the
+// Cycle Analytics for Traders book.
+func (dc Decycler) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !dc.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != dc.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	w := math.Sqrt2 * math.Pi / float64(dc.length)
+	alpha := decimal.NewFromFloat((math.Cos(w) + math.Sin(w) - 1) / math.Cos(w))
+	rest := _one.Sub(alpha)
+
+	k1 := _one.Sub(alpha.Div(decimal.NewFromInt(2))).Pow(decimal.NewFromInt(2))
+	k2 := rest.Mul(decimal.NewFromInt(2))
+	k3 := rest.Mul(rest)
+
+	prev2, prev1 := decimal.Zero, decimal.Zero
+
+	for i := 2; i < len(dd); i++ {
+		hp := k1.Mul(dd[i].Sub(dd[i-1].Mul(decimal.NewFromInt(2))).Add(dd[i-2])).
+			Add(k2.Mul(prev1)).
+			Sub(k3.Mul(prev2))
+
+		prev2, prev1 = prev1, hp
+	}
+
+	return dd[len(dd)-1].Sub(prev1), nil
+}
+
+// Count determines the total amount of data points needed for Decycler
+// calculation.
+func (dc Decycler) Count() int {
+	return dc.length*2 - 1
+}
+
+// Describe returns structured information about Decycler and its output.
+func (dc Decycler) Describe() Description {
+	return Description{
+		Name:    NameDecycler,
+		Input:   InputClose,
+		Overlay: true,
+	}
+}
+
+// DEMA holds all the necessary information needed to calculate
+// double exponential moving average.
+// The zero value is not usable.
+type DEMA struct {
+	// valid specifies whether DEMA paremeters were validated.
+	valid bool
+
+	// ema specifies what ema should be used for dema calculations.
+	ema EMA
+}
+
+// NewDEMA validates provided configuration options and creates
+// new DEMA indicator.
+func NewDEMA(length int) (DEMA, error) {
+	ema, err := NewEMA(length)
+	if err != nil {
+		return DEMA{}, fieldError(err, NameDEMA, "length")
+	}
+
+	return DEMA{
+		valid: true,
+		ema:   ema,
+	}, nil
+}
+
+// Calc calculates DEMA from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/d/double-exponential-moving-average.asp.
+// All credits are due to Patrick Mulloy who developed DEMA indicator.
+func (dema DEMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !dema.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != dema.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	pres := make([]decimal.Decimal, dema.ema.sma.length)
+
+	var err error
+
+	pres[0], err = dema.ema.sma.Calc(dd[:dema.ema.sma.length])
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	for i := dema.ema.sma.length; i < len(dd); i++ {
+		pres[i-dema.ema.sma.length+1], err = dema.ema.CalcNext(pres[i-dema.ema.sma.length], dd[i])
+		if err != nil {
+			// unlikely to happen
+			return decimal.Zero, err
+		}
+	}
+
+	res := pres[0]
+
+	for i := 0; i < len(pres); i++ {
+		res, err = dema.ema.CalcNext(res, pres[i])
+		if err != nil {
+			// unlikely to happen
+			return decimal.Zero, err
+		}
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of data points needed for DEMA
+// calculation.
+func (dema DEMA) Count() int {
+	return dema.ema.Count()
+}
+
+// Describe returns structured information about DEMA and its output.
+func (dema DEMA) Describe() Description {
+	return Description{
+		Name:    NameDEMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.Zero,
+	}
+}
+
+// DMI holds all the necessary information needed to calculate directional
+// movement index lines (+DI and -DI).
+// The zero value is not usable.
+type DMI struct {
+	// valid specifies whether DMI paremeters were validated.
+	valid bool
+
+	// trend specifies which directional index line (TrendUp for +DI,
+	// TrendDown for -DI) is returned by CalcCandles.
+	trend Trend
+
+	// length specifies how many directional movement values should be
+	// used during the calculations.
+	length int
+
+	// smoothing specifies how directional movement values should be
+	// averaged.
+	smoothing Smoothing
+}
+
+// DMILines holds both directional index lines calculated by DMI.
+type DMILines struct {
+	// Plus specifies the positive directional index (+DI).
+	Plus decimal.Decimal `json:"plus"`
+
+	// Minus specifies the negative directional index (-DI).
+	Minus decimal.Decimal `json:"minus"`
+}
+
+// NewDMI validates provided configuration options and
+// creates new DMI indicator instance.
+func NewDMI(trend Trend, length int, smoothing Smoothing) (DMI, error) {
+	dmi := DMI{
+		trend:     trend,
+		length:    length,
+		smoothing: smoothing,
+	}
+
+	if err := dmi.validate(); err != nil {
+		return DMI{}, err
+	}
+
+	return dmi, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (dmi *DMI) validate() error {
+	if err := dmi.trend.Validate(); err != nil {
+		return &ConfigError{
+			Indicator: NameDMI,
+			Field:     "trend",
+			Value:     dmi.trend,
+			Err:       err,
+		}
+	}
+
+	if dmi.length < 1 {
+		return &ConfigError{
+			Indicator: NameDMI,
+			Field:     "length",
+			Value:     dmi.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if err := dmi.smoothing.Validate(); err != nil {
+		return &ConfigError{
+			Indicator: NameDMI,
+			Field:     "smoothing",
+			Value:     dmi.smoothing,
+			Err:       err,
+		}
+	}
+
+	dmi.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates the directional index line selected by the
+// trend from the provided candles slice.
+func (dmi DMI) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	ll, err := dmi.CalcLines(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	if dmi.trend == TrendDown {
+		return ll.Minus, nil
+	}
+
+	return ll.Plus, nil
+}
+
+// CalcLines calculates both directional index lines from the provided
+// candles slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/d/dmi.asp.
+// All credits are due to J. Welles Wilder Jr. who developed DMI indicator.
+func (dmi DMI) CalcLines(cc []Candle) (DMILines, error) {
+	if !dmi.valid {
+		return DMILines{}, ErrInvalidIndicator
+	}
+
+	if len(cc) != dmi.Count() {
+		return DMILines{}, ErrInvalidDataSize
+	}
+
+	plus, minus, err := directionalIndexes(cc, dmi.length, dmi.smoothing)
+	if err != nil {
+		// unlikely to happen
+		return DMILines{}, err
+	}
+
+	return DMILines{
+		Plus:  plus,
+		Minus: minus,
+	}, nil
+}
+
+// Count determines the total amount of candles needed for DMI
+// calculation.
+func (dmi DMI) Count() int {
+	return dmi.smoothing.count(dmi.length) + 1
+}
+
+// Describe returns structured information about DMI and its output.
+func (dmi DMI) Describe() Description {
+	return Description{
+		Name:    NameDMI,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}
+}
+
+// Donchian holds all the necessary information needed to calculate
+// Donchian channels.
+// The zero value is not usable.
+type Donchian struct {
+	// valid specifies whether Donchian paremeters were validated.
+	valid bool
+
+	// band specifies which Donchian channel band to calculate.
+	band Band
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// DonchianLines holds all lines calculated by Donchian.
+type DonchianLines struct {
+	// Upper specifies the highest high of the channel.
+	Upper decimal.Decimal `json:"upper"`
+
+	// Middle specifies the midline between the upper and lower bands.
+	Middle decimal.Decimal `json:"middle"`
+
+	// Lower specifies the lowest low of the channel.
+	Lower decimal.Decimal `json:"lower"`
+}
+
+// NewDonchian validates provided configuration options and
+// creates new Donchian indicator instance.
+// Commonly used length is 20.
+func NewDonchian(band Band, length int) (Donchian, error) {
+	donchian := Donchian{
+		band:   band,
+		length: length,
+	}
+
+	if err := donchian.validate(); err != nil {
+		return Donchian{}, err
+	}
+
+	return donchian, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (donchian *Donchian) validate() error {
+	if err := donchian.band.Validate(); err != nil {
+		return &ConfigError{
+			Indicator: NameDonchian,
+			Field:     "band",
+			Value:     donchian.band,
+			Err:       err,
+		}
+	}
+
+	if donchian.length < 1 {
+		return &ConfigError{
+			Indicator: NameDonchian,
+			Field:     "length",
+			Value:     donchian.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	donchian.valid = true
+
+	return nil
+}
+
+// Calc calculates the Donchian channel band selected by the band from
+// the provided data points slice. Every data point is used as both the
+// high and the low price. BandWidth returns the distance between the
+// upper and lower bands as the percentage of the middle line, just like
+// BB does.
+func (donchian Donchian) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !donchian.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != donchian.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	high := decimal.Max(dd[0], dd[1:]...)
+	low := decimal.Min(dd[0], dd[1:]...)
+
+	return donchian.value(donchian.lines(high, low)), nil
+}
+
+// CalcCandles calculates the Donchian channel band selected by the band
+// from the provided candles slice. BandWidth returns the distance between
+// the upper and lower bands as the percentage of the middle line, just
+// like BB does.
+func (donchian Donchian) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	ll, err := donchian.CalcLines(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return donchian.value(ll), nil
+}
+
+// CalcLines calculates Donchian channel lines from the provided candles
+// slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/d/donchianchannels.asp.
+// All credits are due to Richard Donchian who developed Donchian
+// channels.
+func (donchian Donchian) CalcLines(cc []Candle) (DonchianLines, error) {
+	if !donchian.valid {
+		return DonchianLines{}, ErrInvalidIndicator
+	}
+
+	if len(cc) != donchian.Count() {
+		return DonchianLines{}, ErrInvalidDataSize
+	}
+
+	return donchian.lines(extremes(cc)), nil
+}
+
+// lines creates Donchian channel lines from the highest and lowest
+// prices.
+func (donchian Donchian) lines(high, low decimal.Decimal) DonchianLines {
+	return DonchianLines{
+		Upper:  high,
+		Middle: high.Add(low).Div(decimal.NewFromInt(2)),
+		Lower:  low,
+	}
+}
+
+// value returns the line selected by the band.
+func (donchian Donchian) value(ll DonchianLines) decimal.Decimal {
+	switch donchian.band {
+	case BandUpper:
+		return ll.Upper
+	case BandLower:
+		return ll.Lower
+	default: // Donchian is validated, only BandWidth is left.
+		return bandWidth(ll.Upper, ll.Middle, ll.Lower).Mul(_hundred)
+	}
+}
+
+// Count determines the total amount of data points needed for Donchian
+// calculation.
+func (donchian Donchian) Count() int {
+	return donchian.length
+}
+
+// Describe returns structured information about Donchian and its output.
+func (donchian Donchian) Describe() Description {
+	return Description{
+		Name:    NameDonchian,
+		Input:   InputClose,
+		Overlay: donchian.band != BandWidth,
+	}
+}
+
+// DonchianWidth holds all the necessary information needed to calculate
+// Donchian channel width.
+// The zero value is not usable.
+type DonchianWidth struct {
+	// valid specifies whether DonchianWidth paremeters were validated.
+	valid bool
+
+	// normalize specifies whether the width should be divided by the
+	// middle line of the channel.
+	normalize bool
+
+	// donchian specifies the channel which width is calculated.
+	donchian Donchian
+}
+
+// NewDonchianWidth validates provided configuration options and
+// creates new DonchianWidth indicator instance.
+func NewDonchianWidth(length int, normalize bool) (DonchianWidth, error) {
+	donchian, err := NewDonchian(BandWidth, length)
+	if err != nil {
+		return DonchianWidth{}, fieldError(err, NameDonchianWidth, "length")
+	}
+
+	dw := DonchianWidth{
+		normalize: normalize,
+		donchian:  donchian,
+	}
+
+	if err := dw.validate(); err != nil {
+		// unlikely to happen
+		return DonchianWidth{}, err
+	}
+
+	return dw, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (dw *DonchianWidth) validate() error {
+	if !dw.donchian.valid {
+		return &ConfigError{
+			Indicator: NameDonchianWidth,
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	dw.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates DonchianWidth from the provided candles slice.
+// The width is the distance between the upper and lower Donchian bands,
+// optionally divided by the middle line, just like BBW does.
+// Calculation is based on formula provided by stockcharts.
+// https://school.stockcharts.com/doku.php?id=technical_indicators:price_channels.
+// All credits are due to Richard Donchian who developed Donchian
+// channels.
+func (dw DonchianWidth) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !dw.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	ll, err := dw.donchian.CalcLines(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	if !dw.normalize {
+		return ll.Upper.Sub(ll.Lower), nil
+	}
+
+	return bandWidth(ll.Upper, ll.Middle, ll.Lower), nil
+}
+
+// Count determines the total amount of candles needed for DonchianWidth
+// calculation.
+func (dw DonchianWidth) Count() int {
+	return dw.donchian.Count()
+}
+
+// Describe returns structured information about DonchianWidth and its
+// output.
+func (dw DonchianWidth) Describe() Description {
+	return Description{
+		Name:  NameDonchianWidth,
+		Input: InputCandle,
+	}
+}
+
+// DYMI holds all the necessary information needed to calculate dynamic
+// momentum index.
+// The zero value is not usable.
+type DYMI struct {
+	// valid specifies whether DYMI paremeters were validated.
+	valid bool
+
+	// length specifies the base RSI length that is adjusted by the
+	// volatility.
+	length int
+
+	// stdev specifies how many data points should be used to calculate
+	// a single standard deviation value.
+	stdev int
+
+	// average specifies how many standard deviation values should be
+	// averaged.
+	average int
+
+	// min specifies the lowest allowed RSI length.
+	min int
+
+	// max specifies the highest allowed RSI length.
+	max int
+}
+
+// NewDYMI validates provided configuration options and
+// creates new DYMI indicator instance.
+// Commonly used configuration is 14 base length, 5 standard deviation
+// length, 10 average length and 5-30 RSI length bounds.
+func NewDYMI(length, stdev, average, min, max int) (DYMI, error) {
+	dymi := DYMI{
+		length:  length,
+		stdev:   stdev,
+		average: average,
+		min:     min,
+		max:     max,
+	}
+
+	if err := dymi.validate(); err != nil {
+		return DYMI{}, err
+	}
+
+	return dymi, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (dymi *DYMI) validate() error {
+	if dymi.length < 1 {
+		return &ConfigError{
+			Indicator: NameDYMI,
+			Field:     "length",
+			Value:     dymi.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if dymi.stdev < 2 {
+		return &ConfigError{
+			Indicator: NameDYMI,
+			Field:     "stdev",
+			Value:     dymi.stdev,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if dymi.average < 1 {
+		return &ConfigError{
+			Indicator: NameDYMI,
+			Field:     "average",
+			Value:     dymi.average,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if dymi.min < 2 {
+		return &ConfigError{
+			Indicator: NameDYMI,
+			Field:     "min",
+			Value:     dymi.min,
+			Err:       errors.New("invalid bounds"),
+		}
+	}
+
+	if dymi.max < dymi.min {
+		return &ConfigError{
+			Indicator: NameDYMI,
+			Field:     "max",
+			Value:     dymi.max,
+			Err:       errors.New("invalid bounds"),
+		}
+	}
+
+	dymi.valid = true
+
+	return nil
+}
+
+// Calc calculates DYMI from the provided data points slice. The base
+// length is divided by the ratio of the newest standard deviation to
+// the average of the standard deviations, the result is kept within the
+// bounds and used as the RSI length.
+// Calculation is based on formula provided by Tushar Chande and Stanley
+// Kroll in The New Technical Trader book.
+// All credits are due to Tushar Chande who developed DYMI indicator.
+func (dymi DYMI) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !dymi.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != dymi.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	sds := make([]decimal.Decimal, dymi.average)
+	offset := len(dd) - dymi.stdev - dymi.average + 1
+
+	for i := range sds {
+		sds[i] = sdev(dd[offset+i : offset+i+dymi.stdev])
+	}
+
+	length := dymi.max
+
+	if curr := sds[len(sds)-1]; !curr.Equal(decimal.Zero) {
+		length = int(decimal.NewFromInt(int64(dymi.length)).Mul(avg(sds)).
+			Div(curr).Floor().IntPart())
+	}
+
+	if length < dymi.min {
+		length = dymi.min
+	}
+
+	if length > dymi.max {
+		length = dymi.max
+	}
+
+	rsi := RSI{
+		valid:     true,
+		length:    length,
+		smoothing: SmoothingSMA,
+	}
+
+	return rsi.Calc(dd[len(dd)-length:])
+}
+
+// Count determines the total amount of data points needed for DYMI
+// calculation.
+func (dymi DYMI) Count() int {
+	if n := dymi.stdev + dymi.average - 1; n > dymi.max {
+		return n
+	}
+
+	return dymi.max
+}
+
+// Describe returns structured information about DYMI and its output.
+func (dymi DYMI) Describe() Description {
+	return Description{
+		Name:    NameDYMI,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}
+}
+
+// ElderRay holds all the necessary information needed to calculate Elder
+// Ray bull and bear power.
+// The zero value is not usable.
+type ElderRay struct {
+	// valid specifies whether ElderRay paremeters were validated.
+	valid bool
+
+	// trend specifies which power line (TrendUp for bull power,
+	// TrendDown for bear power) is returned by CalcCandles.
+	trend Trend
+
+	// ema specifies what ema should be used for elder ray calculations.
+	ema EMA
+}
+
+// ElderRayLines holds both power lines calculated by ElderRay.
+type ElderRayLines struct {
+	// Bull specifies the bull power, i.e. the difference between the
+	// newest high price and EMA.
+	Bull decimal.Decimal `json:"bull"`
+
+	// Bear specifies the bear power, i.e. the difference between the
+	// newest low price and EMA.
+	Bear decimal.Decimal `json:"bear"`
+}
+
+// NewElderRay validates provided configuration options and
+// creates new ElderRay indicator instance.
+func NewElderRay(trend Trend, length int) (ElderRay, error) {
+	ema, err := NewEMA(length)
+	if err != nil {
+		return ElderRay{}, fieldError(err, NameElderRay, "length")
+	}
+
+	er := ElderRay{
+		trend: trend,
+		ema:   ema,
+	}
+
+	if err := er.validate(); err != nil {
+		return ElderRay{}, err
+	}
+
+	return er, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (er *ElderRay) validate() error {
+	if err := er.trend.Validate(); err != nil {
+		return &ConfigError{
+			Indicator: NameElderRay,
+			Field:     "trend",
+			Value:     er.trend,
+			Err:       err,
+		}
+	}
+
+	er.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates the power line selected by the trend from the
+// provided candles slice.
+func (er ElderRay) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	ll, err := er.CalcLines(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	if er.trend == TrendDown {
+		return ll.Bear, nil
+	}
+
+	return ll.Bull, nil
+}
+
+// CalcLines calculates both power lines from the provided candles slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/e/elderray.asp.
+// All credits are due to Alexander Elder who developed ElderRay indicator.
+func (er ElderRay) CalcLines(cc []Candle) (ElderRayLines, error) {
+	if !er.valid {
+		return ElderRayLines{}, ErrInvalidIndicator
+	}
+
+	if len(cc) != er.Count() {
+		return ElderRayLines{}, ErrInvalidDataSize
+	}
+
+	dd := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		dd[i] = cc[i].Close
+	}
+
+	ema, err := er.ema.Calc(dd)
+	if err != nil {
+		// unlikely to happen
+		return ElderRayLines{}, err
+	}
+
+	last := cc[len(cc)-1]
+
+	return ElderRayLines{
+		Bull: last.High.Sub(ema),
+		Bear: last.Low.Sub(ema),
+	}, nil
+}
+
+// Count determines the total amount of candles needed for ElderRay
+// calculation.
+func (er ElderRay) Count() int {
+	return er.ema.Count()
+}
+
+// Describe returns structured information about ElderRay and its output.
+func (er ElderRay) Describe() Description {
+	return Description{
+		Name:  NameElderRay,
+		Input: InputCandle,
+	}
+}
+
+// EMA holds all the necessary information needed to calculate exponential
+// moving average.
+// The zero value is not usable.
+type EMA struct {
+	// valid specifies whether DEMA paremeters were validated.
+	valid bool
+
+	// sma specifies what sma should be used for ema calculations.
+	sma SMA
+}
+
+// NewEMA validates provided configuration options and
+// creates new EMA indicator.
+func NewEMA(length int) (EMA, error) {
+	sma, err := NewSMA(length)
+	if err != nil {
+		return EMA{}, fieldError(err, NameEMA, "length")
+	}
+
+	return EMA{
+		valid: true,
+		sma:   sma,
+	}, nil
+}
+
+// Calc calculates EMA from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/e/ema.asp.
+func (ema EMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !ema.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != ema.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	res, err := ema.sma.Calc(dd[:ema.sma.length])
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	for i := ema.sma.length; i < len(dd); i++ {
+		res, err = ema.CalcNext(res, dd[i])
+		if err != nil {
+			// unlikely to happen
+			return decimal.Zero, err
+		}
+	}
+
+	return res, nil
+}
+
+// CalcNext calculates sequential EMA by using previous EMA.
+func (ema EMA) CalcNext(lres, dec decimal.Decimal) (decimal.Decimal, error) {
+	if !ema.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	mtp := ema.multiplier()
+
+	return dec.Mul(mtp).Add(lres.Mul(decimal.NewFromInt(1).Sub(mtp))), nil
+}
+
+// series calculates EMA of every data point, starting from the one at
+// the length position, which is calculated as the SMA of the preceding
+// data points.
+func (ema EMA) series(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !ema.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < ema.sma.length {
+		return nil, ErrInvalidDataSize
+	}
+
+	res := make([]decimal.Decimal, len(dd)-ema.sma.length+1)
+
+	var err error
+
+	res[0], err = ema.sma.Calc(dd[:ema.sma.length])
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	for i := 1; i < len(res); i++ {
+		res[i], err = ema.CalcNext(res[i-1], dd[ema.sma.length+i-1])
+		if err != nil {
+			// unlikely to happen
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// multiplier calculates EMA multiplier.
+func (ema EMA) multiplier() decimal.Decimal {
+	return decimal.NewFromInt(2).Div(decimal.NewFromInt(int64(ema.sma.length) + 1))
+}
+
+// Count determines the total amount of data points needed for EMA
+// calculation.
+func (ema EMA) Count() int {
+	return ema.sma.length*2 - 1
+}
+
+// Describe returns structured information about EMA and its output.
+func (ema EMA) Describe() Description {
+	return Description{
+		Name:    NameEMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.NewFromInt(int64(ema.sma.length - 1)).Div(decimal.NewFromInt(2)),
+	}
+}
+
+// ER holds all the necessary information needed to calculate
+// Kaufman efficiency ratio.
+// The zero value is not usable.
+type ER struct {
+	// valid specifies whether ER paremeters were validated.
+	valid bool
+
+	// length specifies how many price changes should be used
+	// during the calculations.
+	length int
+}
+
+// NewER validates provided configuration options and
+// creates new ER indicator instance.
+func NewER(length int) (ER, error) {
+	er := ER{length: length}
+
+	if err := er.validate(); err != nil {
+		return ER{}, err
+	}
+
+	return er, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (er *ER) validate() error {
+	if er.length < 1 {
+		return &ConfigError{
+			Indicator: NameER,
+			Field:     "length",
+			Value:     er.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	er.valid = true
+
+	return nil
+}
+
+// Calc calculates ER from the provided data points slice.
+// The result is the net price change divided by the sum of absolute
+// price changes, ranging from 0 (noise) to 1 (strong trend).
+// Calculation is based on formula provided by fidelity.
+// https://www.fidelity.com/learning-center/trading-investing/technical-analysis/technical-indicator-guide/kama.
+// All credits are due to Perry Kaufman who developed ER indicator.
+func (er ER) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !er.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != er.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	vol := decimal.Zero
+
+	for i := 1; i < len(dd); i++ {
+		vol = vol.Add(dd[i].Sub(dd[i-1]).Abs())
+	}
+
+	if vol.Equal(decimal.Zero) {
+		return decimal.Zero, nil
+	}
+
+	return dd[len(dd)-1].Sub(dd[0]).Abs().Div(vol), nil
+}
+
+// Count determines the total amount of data points needed for ER
+// calculation.
+func (er ER) Count() int {
+	return er.length + 1
+}
+
+// Describe returns structured information about ER and its output.
+func (er ER) Describe() Description {
+	return Description{
+		Name:    NameER,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _one,
+	}
+}
+
+// Ergodic holds all the necessary information needed to calculate
+// SMI ergodic oscillator.
+// The zero value is not usable.
+type Ergodic struct {
+	// valid specifies whether Ergodic paremeters were validated.
+	valid bool
+
+	// tsi specifies the true strength index the oscillator is derived
+	// from.
+	tsi TSI
+}
+
+// ErgodicLines holds all lines calculated by Ergodic.
+type ErgodicLines struct {
+	// Ergodic specifies the SMI ergodic indicator line.
+	Ergodic decimal.Decimal `json:"ergodic"`
+
+	// Signal specifies the moving average of the ergodic line.
+	Signal decimal.Decimal `json:"signal"`
+
+	// Oscillator specifies the difference between the ergodic and
+	// signal lines.
+	Oscillator decimal.Decimal `json:"oscillator"`
+}
+
+// NewErgodic validates provided configuration options and
+// creates new Ergodic indicator instance.
+// Commonly used values are 20, 5 and 5.
+func NewErgodic(long, short, signal int) (Ergodic, error) {
+	tsi, err := NewTSI(long, short, signal)
+	if err != nil {
+		return Ergodic{}, fieldError(err, NameErgodic, "")
+	}
+
+	erg := Ergodic{
+		tsi: tsi,
+	}
+
+	if err := erg.validate(); err != nil {
+		// unlikely to happen
+		return Ergodic{}, err
+	}
+
+	return erg, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (erg *Ergodic) validate() error {
+	if !erg.tsi.valid {
+		return &ConfigError{
+			Indicator: NameErgodic,
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	erg.valid = true
+
+	return nil
+}
+
+// Calc calculates Ergodic oscillator line from the provided data points
+// slice.
+// Calculation is based on formula provided by William Blau in the
+// Momentum, Direction, and Divergence book.
+// All credits are due to William Blau who developed Ergodic indicator.
+func (erg Ergodic) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	ll, err := erg.CalcLines(dd)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return ll.Oscillator, nil
+}
+
+// CalcLines calculates Ergodic, signal and oscillator lines from the
+// provided data points slice.
+func (erg Ergodic) CalcLines(dd []decimal.Decimal) (ErgodicLines, error) {
+	if !erg.valid {
+		return ErgodicLines{}, ErrInvalidIndicator
+	}
+
+	ll, err := erg.tsi.CalcLines(dd)
+	if err != nil {
+		return ErgodicLines{}, err
+	}
+
+	return ErgodicLines{
+		Ergodic:    ll.TSI,
+		Signal:     ll.Signal,
+		Oscillator: ll.TSI.Sub(ll.Signal),
+	}, nil
+}
+
+// Count determines the total amount of data points needed for Ergodic
+// calculation.
+func (erg Ergodic) Count() int {
+	return erg.tsi.Count()
+}
+
+// Describe returns structured information about Ergodic and its output.
+func (erg Ergodic) Describe() Description {
+	return Description{
+		Name:  NameErgodic,
+		Input: InputClose,
+	}
+}
+
+// ForceIndex holds all the necessary information needed to calculate
+// force index.
+// The zero value is not usable.
+type ForceIndex struct {
+	// valid specifies whether ForceIndex paremeters were validated.
+	valid bool
+
+	// ema specifies what ema should be used to smooth the raw force
+	// index values.
+	ema EMA
+}
+
+// NewForceIndex validates provided configuration options and
+// creates new ForceIndex indicator instance.
+// Length of 1 means that the raw force index of the newest candle is
+// calculated.
+// Commonly used length is 13.
+func NewForceIndex(length int) (ForceIndex, error) {
+	ema, err := NewEMA(length)
+	if err != nil {
+		return ForceIndex{}, fieldError(err, NameForceIndex, "length")
+	}
+
+	fi := ForceIndex{
+		ema: ema,
+	}
+
+	if err := fi.validate(); err != nil {
+		// unlikely to happen
+		return ForceIndex{}, err
+	}
+
+	return fi, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (fi *ForceIndex) validate() error {
+	if !fi.ema.valid {
+		return &ConfigError{
+			Indicator: NameForceIndex,
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	fi.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates ForceIndex from the provided candles slice. The
+// raw force index of every candle is its close price change multiplied by
+// its volume.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/f/force-index.asp.
+// All credits are due to Alexander Elder who developed ForceIndex
+// indicator.
+func (fi ForceIndex) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !fi.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != fi.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	ff := make([]decimal.Decimal, len(cc)-1)
+
+	for i := range ff {
+		ff[i] = cc[i+1].Close.Sub(cc[i].Close).Mul(cc[i+1].Volume)
+	}
+
+	return fi.ema.Calc(ff)
+}
+
+// Count determines the total amount of candles needed for ForceIndex
+// calculation.
+func (fi ForceIndex) Count() int {
+	return fi.ema.Count() + 1
+}
+
+// Describe returns structured information about ForceIndex and its
+// output.
+func (fi ForceIndex) Describe() Description {
+	return Description{
+		Name:  NameForceIndex,
+		Input: InputCandle,
+	}
+}
+
+// FRAMA holds all the necessary information needed to calculate
+// Ehlers' fractal adaptive moving average.
+// The zero value is not usable.
+type FRAMA struct {
+	// valid specifies whether FRAMA paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used to
+	// estimate the fractal dimension. It must be even.
+	length int
+}
+
+// NewFRAMA validates provided configuration options and
+// creates new FRAMA indicator instance.
+func NewFRAMA(length int) (FRAMA, error) {
+	frama := FRAMA{length: length}
+
+	if err := frama.validate(); err != nil {
+		return FRAMA{}, err
+	}
+
+	return frama, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (frama *FRAMA) validate() error {
+	if frama.length < 4 || frama.length%2 != 0 {
+		return &ConfigError{
+			Indicator: NameFRAMA,
+			Field:     "length",
+			Value:     frama.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	frama.valid = true
+
+	return nil
+}
+
+// Calc calculates FRAMA from the provided data points slice.
+// The average is seeded with the SMA of the oldest data points and
+// adapted over the rest of them.
+// Calculation is based on formula provided by John F. Ehlers in the
+// FRAMA - Fractal Adaptive Moving Average article.
+func (frama FRAMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !frama.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != frama.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	res := avg(dd[:frama.length])
+
+	for i := frama.length; i < len(dd); i++ {
+		alpha := frama.alpha(dd[i-frama.length+1 : i+1])
+		res = alpha.Mul(dd[i]).Add(_one.Sub(alpha).Mul(res))
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of data points needed for FRAMA
+// calculation.
+func (frama FRAMA) Count() int {
+	return frama.length*2 - 1
+}
+
+// Describe returns structured information about FRAMA and its output.
+func (frama FRAMA) Describe() Description {
+	return Description{
+		Name:    NameFRAMA,
+		Input:   InputClose,
+		Overlay: true,
+	}
+}
+
+// alpha calculates the smoothing factor from the fractal dimension of
+// the provided window.
+func (frama FRAMA) alpha(dd []decimal.Decimal) decimal.Decimal {
+	half := len(dd) / 2
+
+	spread := func(dd []decimal.Decimal) float64 {
+		v, _ := decimal.Max(dd[0], dd[1:]...).Sub(decimal.Min(dd[0], dd[1:]...)).Float64()
+		return v
+	}
+
+	n1 := spread(dd[:half]) / float64(half)
+	n2 := spread(dd[half:]) / float64(half)
+	n3 := spread(dd) / float64(len(dd))
+
+	if n1+n2 == 0 || n3 == 0 {
+		return _one
+	}
+
+	dim := (math.Log(n1+n2) - math.Log(n3)) / math.Ln2
+	alpha := math.Min(math.Max(math.Exp(-4.6*(dim-1)), 0.01), 1)
+
+	return decimal.NewFromFloat(alpha)
+}
+
+// Gap holds all the necessary information needed to calculate opening
+// gap.
+// The zero value is not usable.
+type Gap struct {
+	// valid specifies whether Gap paremeters were validated.
+	valid bool
+
+	// threshold specifies the absolute gap percentage that must be
+	// exceeded for the gap to be flagged.
+	threshold decimal.Decimal
+}
+
+// GapLines holds all values calculated by Gap.
+type GapLines struct {
+	// Percent specifies the distance between the open price and the
+	// previous close price, in percent of the previous close price.
+	Percent decimal.Decimal `json:"percent"`
+
+	// Flagged specifies whether the absolute gap percentage is above
+	// the threshold.
+	Flagged bool `json:"flagged"`
+}
+
+// NewGap validates provided configuration options and
+// creates new Gap indicator instance.
+func NewGap(threshold decimal.Decimal) (Gap, error) {
+	gap := Gap{threshold: threshold}
+
+	if err := gap.validate(); err != nil {
+		return Gap{}, err
+	}
+
+	return gap, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (gap *Gap) validate() error {
+	if gap.threshold.LessThan(decimal.Zero) {
+		return &ConfigError{
+			Indicator: NameGap,
+			Field:     "threshold",
+			Value:     gap.threshold,
+			Err:       errors.New("invalid threshold"),
+		}
+	}
+
+	gap.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates Gap percentage from the provided candles slice.
+func (gap Gap) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	ll, err := gap.CalcLines(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return ll.Percent, nil
+}
+
+// CalcLines calculates Gap percentage and whether it exceeds the
+// threshold from the provided candles slice. The first candle is only
+// used as the previous candle of the second one.
+func (gap Gap) CalcLines(cc []Candle) (GapLines, error) {
+	if !gap.valid {
+		return GapLines{}, ErrInvalidIndicator
+	}
+
+	if len(cc) != gap.Count() {
+		return GapLines{}, ErrInvalidDataSize
+	}
+
+	if cc[0].Close.Equal(decimal.Zero) {
+		return GapLines{
+			Percent: decimal.Zero,
+		}, nil
+	}
+
+	pct := cc[1].Open.Sub(cc[0].Close).Div(cc[0].Close).Mul(_hundred)
+
+	return GapLines{
+		Percent: pct,
+		Flagged: pct.Abs().GreaterThan(gap.threshold),
+	}, nil
+}
+
+// Count determines the total amount of candles needed for Gap
+// calculation.
+func (gap Gap) Count() int {
+	return 2
+}
+
+// Describe returns structured information about Gap and its output.
+func (gap Gap) Describe() Description {
+	return Description{
+		Name:  NameGap,
+		Input: InputCandle,
+	}
+}
+
+// Gator holds all the necessary information needed to calculate
+// Gator Oscillator.
+// The zero value is not usable.
+type Gator struct {
+	// valid specifies whether Gator paremeters were validated.
+	valid bool
+
+	// alligator specifies the lines that the oscillator is derived from.
+	alligator Alligator
+}
+
+// GatorLines holds all lines calculated by Gator.
+type GatorLines struct {
+	// Upper specifies the absolute difference between the jaw and
+	// teeth lines.
+	Upper decimal.Decimal `json:"upper"`
+
+	// Lower specifies the negated absolute difference between the
+	// teeth and lips lines.
+	Lower decimal.Decimal `json:"lower"`
+}
+
+// NewGator validates provided configuration options and
+// creates new Gator indicator instance. The lines are the same as the
+// ones used by NewAlligator.
+func NewGator(jaw, teeth, lips Indicator) (Gator, error) {
+	alligator, err := NewAlligator(jaw, teeth, lips)
+	if err != nil {
+		return Gator{}, fieldError(err, NameGator, "")
+	}
+
+	gator := Gator{
+		alligator: alligator,
+	}
+
+	if err := gator.validate(); err != nil {
+		// unlikely to happen
+		return Gator{}, err
+	}
+
+	return gator, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (gator *Gator) validate() error {
+	if !gator.alligator.valid {
+		return &ConfigError{
+			Indicator: NameGator,
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	gator.valid = true
+
+	return nil
+}
+
+// CalcLines calculates Gator lines from the provided candles slice.
+// Calculation is based on formula provided by tradingview.
+// https://www.tradingview.com/script/E9L4EfyJ-Gator-Oscillator/.
+// All credits are due to Bill Williams who developed Gator indicator.
+func (gator Gator) CalcLines(cc []Candle) (GatorLines, error) {
+	if !gator.valid {
+		return GatorLines{}, ErrInvalidIndicator
+	}
+
+	ll, err := gator.alligator.CalcLines(cc)
+	if err != nil {
+		return GatorLines{}, err
+	}
+
+	return GatorLines{
+		Upper: ll.Jaw.Sub(ll.Teeth).Abs(),
+		Lower: ll.Teeth.Sub(ll.Lips).Abs().Neg(),
+	}, nil
+}
+
+// Count determines the total amount of candles needed for Gator
+// calculation.
+func (gator Gator) Count() int {
+	return gator.alligator.Count()
+}
+
+// Describe returns structured information about Gator and its output.
+func (gator Gator) Describe() Description {
+	return Description{
+		Name:  NameGator,
+		Input: InputCandle,
+	}
+}
+
+// GMMA holds all the necessary information needed to calculate Guppy
+// multiple moving average.
+// The zero value is not usable.
+type GMMA struct {
+	// valid specifies whether GMMA paremeters were validated.
+	valid bool
+
+	// short specifies the short-term ribbon of moving averages.
+	short Ribbon
+
+	// long specifies the long-term ribbon of moving averages.
+	long Ribbon
+}
+
+// GMMALines holds all lines calculated by GMMA.
+type GMMALines struct {
+	// Short specifies the short-term moving averages, ordered from the
+	// fastest to the slowest one.
+	Short [6]decimal.Decimal `json:"short"`
+
+	// Long specifies the long-term moving averages, ordered from the
+	// fastest to the slowest one.
+	Long [6]decimal.Decimal `json:"long"`
+
+	// Separation specifies the difference between the averages of the
+	// short-term and long-term groups.
+	Separation decimal.Decimal `json:"separation"`
+}
+
+// NewGMMA creates new GMMA indicator instance. The short-term ribbon
+// uses 3, 5, 8, 10, 12 and 15 lengths, while the long-term ribbon uses
+// 30, 35, 40, 45, 50 and 60 lengths.
+func NewGMMA() (GMMA, error) {
+	short, err := newEMARibbon(3, 5, 8, 10, 12, 15)
+	if err != nil {
+		// unlikely to happen
+		return GMMA{}, err
+	}
+
+	long, err := newEMARibbon(30, 35, 40, 45, 50, 60)
+	if err != nil {
+		// unlikely to happen
+		return GMMA{}, err
+	}
+
+	gmma := GMMA{
+		short: short,
+		long:  long,
+	}
+
+	if err := gmma.validate(); err != nil {
+		// unlikely to happen
+		return GMMA{}, err
+	}
+
+	return gmma, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (gmma *GMMA) validate() error {
+	if !gmma.short.valid {
+		return &ConfigError{
+			Indicator: NameGMMA,
+			Field:     "short",
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	if !gmma.long.valid {
+		return &ConfigError{
+			Indicator: NameGMMA,
+			Field:     "long",
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	gmma.valid = true
+
+	return nil
+}
+
+// Calc calculates GMMA separation from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/g/guppy-multiple-moving-average.asp.
+// All credits are due to Daryl Guppy who developed GMMA indicator.
+func (gmma GMMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	ll, err := gmma.CalcLines(dd)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return ll.Separation, nil
+}
+
+// CalcLines calculates all GMMA moving averages and their separation
+// from the provided data points slice.
+func (gmma GMMA) CalcLines(dd []decimal.Decimal) (GMMALines, error) {
+	if !gmma.valid {
+		return GMMALines{}, ErrInvalidIndicator
+	}
+
+	if len(dd) != gmma.Count() {
+		return GMMALines{}, ErrInvalidDataSize
+	}
+
+	short, err := gmma.short.CalcLines(dd[len(dd)-gmma.short.Count():])
+	if err != nil {
+		// unlikely to happen
+		return GMMALines{}, err
+	}
+
+	long, err := gmma.long.CalcLines(dd)
+	if err != nil {
+		// unlikely to happen
+		return GMMALines{}, err
+	}
+
+	var res GMMALines
+
+	copy(res.Short[:], short)
+	copy(res.Long[:], long)
+
+	res.Separation = avg(short).Sub(avg(long))
+
+	return res, nil
+}
+
+// Count determines the total amount of data points needed for GMMA
+// calculation.
+func (gmma GMMA) Count() int {
+	return gmma.long.Count()
+}
+
+// Describe returns structured information about GMMA and its output.
+func (gmma GMMA) Describe() Description {
+	return Description{
+		Name:  NameGMMA,
+		Input: InputClose,
+	}
+}
+
+// Highest holds all the necessary information needed to calculate
+// the highest value of the rolling window.
+// The zero value is not usable.
+type Highest struct {
+	// valid specifies whether Highest paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewHighest validates provided configuration options and
+// creates new Highest indicator instance.
+func NewHighest(length int) (Highest, error) {
+	highest := Highest{length: length}
+
+	if err := highest.validate(); err != nil {
+		return Highest{}, err
+	}
+
+	return highest, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (highest *Highest) validate() error {
+	if highest.length < 1 {
+		return &ConfigError{
+			Indicator: NameHighest,
+			Field:     "length",
+			Value:     highest.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	highest.valid = true
+
+	return nil
+}
+
+// Calc calculates Highest from the provided data points slice.
+func (highest Highest) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !highest.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != highest.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	return decimal.Max(dd[0], dd[1:]...), nil
+}
+
+// Count determines the total amount of data points needed for Highest
+// calculation.
+func (highest Highest) Count() int {
+	return highest.length
+}
+
+// Describe returns structured information about Highest and its output.
+func (highest Highest) Describe() Description {
+	return Description{
+		Name:    NameHighest,
+		Input:   InputClose,
+		Overlay: true,
+	}
+}
+
+// Series calculates the highest value of every consecutive window of
+// the provided data points slice, starting with the oldest one.
+func (highest Highest) Series(dd []decimal.Decimal) (Series, error) {
+	if !highest.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < highest.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	return rollingExtremes(dd, highest.length, func(a, b decimal.Decimal) bool {
+		return a.GreaterThan(b)
+	}), nil
+}
+
+// HilbertPeriod holds all the necessary information needed to calculate
+// dominant cycle period by using Ehlers' Hilbert transform
+// estimator.
+// The zero value is not usable.
+type HilbertPeriod struct {
+	// valid specifies whether HilbertPeriod paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewHilbertPeriod validates provided configuration options and
+// creates new HilbertPeriod indicator instance.
+func NewHilbertPeriod(length int) (HilbertPeriod, error) {
+	hp := HilbertPeriod{length: length}
+
+	if err := hp.validate(); err != nil {
+		return HilbertPeriod{}, err
+	}
+
+	return hp, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (hp *HilbertPeriod) validate() error {
+	if hp.length < 7 {
+		return &ConfigError{
+			Indicator: NameHilbertPeriod,
+			Field:     "length",
+			Value:     hp.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	hp.valid = true
+
+	return nil
+}
+
+// Calc calculates HilbertPeriod from the provided data points slice.
+// The estimator is warmed up over all of the provided data points, so
+// longer lengths produce more stable results. Since the calculation
+// relies on trigonometric functions, it is done by using floating point
+// arithmetic.
+// Calculation is based on formula provided by John F. Ehlers in the
+// Rocket Science for Traders book.
+func (hp HilbertPeriod) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !hp.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != hp.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	// the prices are taken relative to the first data point, so that
+	// the estimate does not depend on the price level.
+	pp := make([]float64, len(dd))
+
+	for i := range dd {
+		pp[i], _ = dd[i].Sub(dd[0]).Float64()
+	}
+
+	var (
+		smooth = make([]float64, len(pp))
+		det    = make([]float64, len(pp))
+		i1     = make([]float64, len(pp))
+		q1     = make([]float64, len(pp))
+		i2     = make([]float64, len(pp))
+		q2     = make([]float64, len(pp))
+		re     = make([]float64, len(pp))
+		im     = make([]float64, len(pp))
+		period = make([]float64, len(pp))
+		res    = make([]float64, len(pp))
+	)
+
+	// the warm-up data points are seeded with the prices and the shortest
+	// period instead of zeros, which would pull the estimate down.
+	for i := 0; i < 6; i++ {
+		smooth[i] = pp[i]
+		period[i] = 6
+		res[i] = 6
+	}
+
+	for i := 6; i < len(pp); i++ {
+		smooth[i] = (4*pp[i] + 3*pp[i-1] + 2*pp[i-2] + pp[i-3]) / 10
+		adj := 0.075*period[i-1] + 0.54
+
+		det[i] = hilbert(smooth, i) * adj
+		q1[i] = hilbert(det, i) * adj
+		i1[i] = det[i-3]
+
+		// advance the phase of both components by 90 degrees.
+		ji := hilbert(i1, i) * adj
+		jq := hilbert(q1, i) * adj
+
+		i2[i] = 0.2*(i1[i]-jq) + 0.8*i2[i-1]
+		q2[i] = 0.2*(q1[i]+ji) + 0.8*q2[i-1]
+
+		// homodyne discriminator.
+		re[i] = 0.2*(i2[i]*i2[i-1]+q2[i]*q2[i-1]) + 0.8*re[i-1]
+		im[i] = 0.2*(i2[i]*q2[i-1]-q2[i]*i2[i-1]) + 0.8*im[i-1]
+
+		p := period[i-1]
+		if im[i] != 0 && re[i] != 0 {
+			p = 2 * math.Pi / math.Atan(im[i]/re[i])
+		}
+
+		p = math.Min(p, 1.5*period[i-1])
+		p = math.Max(p, 0.67*period[i-1])
+		p = math.Min(math.Max(p, 6), 50)
+
+		period[i] = 0.2*p + 0.8*period[i-1]
+		res[i] = 0.33*period[i] + 0.67*res[i-1]
+	}
+
+	return decimal.NewFromFloat(res[len(res)-1]), nil
+}
+
+// Count determines the total amount of data points needed for HilbertPeriod
+// calculation.
+func (hp HilbertPeriod) Count() int {
+	return hp.length
+}
+
+// Describe returns structured information about HilbertPeriod and its output.
+func (hp HilbertPeriod) Describe() Description {
+	return Description{
+		Name:    NameHilbertPeriod,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.NewFromInt(6),
+		Max:     decimal.NewFromInt(50),
+	}
+}
+
+// HMA holds all the necessary information needed to calculate
+// hull moving average.
+// The zero value is not usable.
+type HMA struct {
+	// valid specifies whether HMA paremeters were validated.
+	valid bool
+
+	// wma specifies the base moving average.
+	wma WMA
+}
+
+// NewHMA validates provided configuration options and
+// creates new HMA indicator.
+func NewHMA(length int) (HMA, error) {
+	wma, err := NewWMA(length)
+	if err != nil {
+		return HMA{}, fieldError(err, NameHMA, "length")
+	}
+
+	return HMA{
+		valid: true,
+		wma:   wma,
+	}, nil
+}
+
+// Calc calculates HMA from the provided data points slice.
+// Calculation is based on formula provided by fidelity.
+// https://www.fidelity.com/learning-center/trading-investing/technical-analysis/technical-indicator-guide/hull-moving-average.
+// All credits are due to Alan Hull who developed HMA indicator.
+func (h HMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !h.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != h.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	wma1 := WMA{length: h.wma.length / 2, valid: true}
+	wma2 := WMA{length: int(math.Sqrt(float64(h.wma.length))), valid: true}
+
+	res := make([]decimal.Decimal, wma2.length)
+
+	for i := 0; i < wma2.length; i++ {
+		res1, err := wma1.Calc(dd[i : wma1.length+i])
+		if err != nil {
+			// unlikely to happen
+			return decimal.Zero, err
+		}
+
+		res2, err := h.wma.Calc(dd[i : h.wma.length+i])
+		if err != nil {
+			// unlikely to happen
+			return decimal.Zero, err
+		}
+
+		res[i] = res1.Mul(decimal.NewFromInt(2)).Sub(res2)
+	}
+
+	return wma2.Calc(res)
+}
+
+// Count determines the total amount of data points needed for HMA
+// calculation.
+func (h HMA) Count() int {
+	return int(math.Sqrt(float64(h.wma.length))) + h.wma.length - 1
+}
+
+// Describe returns structured information about HMA and its output.
+func (h HMA) Describe() Description {
+	return Description{
+		Name:    NameHMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.Zero,
+	}
+}
+
+// Ichimoku holds all the necessary information needed to calculate
+// Ichimoku Cloud lines.
+// The zero value is not usable.
+type Ichimoku struct {
+	// valid specifies whether Ichimoku paremeters were validated.
+	valid bool
+
+	// tenkan specifies how many candles should be used during the
+	// conversion line calculations.
+	tenkan int
+
+	// kijun specifies how many candles should be used during the
+	// base line calculations. It is also used as the displacement of
+	// the leading and lagging spans.
+	kijun int
+
+	// senkou specifies how many candles should be used during the
+	// leading span B calculations.
+	senkou int
+}
+
+// IchimokuLines holds all lines calculated by Ichimoku.
+type IchimokuLines struct {
+	// Tenkan specifies the conversion line.
+	Tenkan decimal.Decimal `json:"tenkan"`
+
+	// Kijun specifies the base line.
+	Kijun decimal.Decimal `json:"kijun"`
+
+	// SenkouA specifies the leading span A that is displayed at the
+	// newest candle, i.e. calculated kijun candles ago.
+	SenkouA decimal.Decimal `json:"senkou_a"`
+
+	// SenkouB specifies the leading span B that is displayed at the
+	// newest candle, i.e. calculated kijun candles ago.
+	SenkouB decimal.Decimal `json:"senkou_b"`
+
+	// Chikou specifies the lagging span, which is the newest close
+	// price displayed kijun candles back.
+	Chikou decimal.Decimal `json:"chikou"`
+
+	// ProjectedSenkouA specifies the leading span A calculated from the
+	// newest candles, which is displayed kijun candles ahead.
+	ProjectedSenkouA decimal.Decimal `json:"projected_senkou_a"`
+
+	// ProjectedSenkouB specifies the leading span B calculated from the
+	// newest candles, which is displayed kijun candles ahead.
+	ProjectedSenkouB decimal.Decimal `json:"projected_senkou_b"`
+}
+
+// NewIchimoku validates provided configuration options and
+// creates new Ichimoku indicator instance.
+// Commonly used values are 9, 26 and 52.
+func NewIchimoku(tenkan, kijun, senkou int) (Ichimoku, error) {
+	ichimoku := Ichimoku{
+		tenkan: tenkan,
+		kijun:  kijun,
+		senkou: senkou,
+	}
+
+	if err := ichimoku.validate(); err != nil {
+		return Ichimoku{}, err
+	}
+
+	return ichimoku, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (ichimoku *Ichimoku) validate() error {
+	if ichimoku.tenkan < 1 {
+		return &ConfigError{
+			Indicator: NameIchimoku,
+			Field:     "tenkan",
+			Value:     ichimoku.tenkan,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if ichimoku.kijun < 1 {
+		return &ConfigError{
+			Indicator: NameIchimoku,
+			Field:     "kijun",
+			Value:     ichimoku.kijun,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if ichimoku.senkou < 1 {
+		return &ConfigError{
+			Indicator: NameIchimoku,
+			Field:     "senkou",
+			Value:     ichimoku.senkou,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	ichimoku.valid = true
+
+	return nil
+}
+
+// CalcLines calculates all Ichimoku lines from the provided candles slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/i/ichimoku-cloud.asp.
+// All credits are due to Goichi Hosoda who developed Ichimoku indicator.
+func (ichimoku Ichimoku) CalcLines(cc []Candle) (IchimokuLines, error) {
+	if !ichimoku.valid {
+		return IchimokuLines{}, ErrInvalidIndicator
+	}
+
+	if len(cc) != ichimoku.Count() {
+		return IchimokuLines{}, ErrInvalidDataSize
+	}
+
+	last := func(cc []Candle, n int) []Candle {
+		return cc[len(cc)-n:]
+	}
+
+	senkouA := func(cc []Candle) decimal.Decimal {
+		return midpoint(last(cc, ichimoku.tenkan)).
+			Add(midpoint(last(cc, ichimoku.kijun))).
+			Div(decimal.NewFromInt(2))
+	}
+
+	// leading spans displayed at the newest candle were calculated
+	// kijun candles ago.
+	prev := cc[:len(cc)-ichimoku.kijun]
+
+	return IchimokuLines{
+		Tenkan:           midpoint(last(cc, ichimoku.tenkan)),
+		Kijun:            midpoint(last(cc, ichimoku.kijun)),
+		SenkouA:          senkouA(prev),
+		SenkouB:          midpoint(last(prev, ichimoku.senkou)),
+		Chikou:           cc[len(cc)-1].Close,
+		ProjectedSenkouA: senkouA(cc),
+		ProjectedSenkouB: midpoint(last(cc, ichimoku.senkou)),
+	}, nil
+}
+
+// Count determines the total amount of candles needed for Ichimoku
+// calculation.
+func (ichimoku Ichimoku) Count() int {
+	n := ichimoku.tenkan
+
+	if ichimoku.kijun > n {
+		n = ichimoku.kijun
+	}
+
+	if ichimoku.senkou > n {
+		n = ichimoku.senkou
+	}
+
+	return n + ichimoku.kijun
+}
+
+// Describe returns structured information about Ichimoku and its output.
+func (ichimoku Ichimoku) Describe() Description {
+	return Description{
+		Name:    NameIchimoku,
+		Input:   InputCandle,
+		Overlay: true,
+	}
+}
+
+// IntradayIntensity holds all the necessary information needed to
+// calculate intraday intensity index.
+// The zero value is not usable.
+type IntradayIntensity struct {
+	// valid specifies whether IntradayIntensity paremeters were validated.
+	valid bool
+
+	// percent specifies whether the sum of intensities should be
+	// normalized by the sum of volumes and returned in percent.
+	percent bool
+
+	// length specifies how many candles should be used
+	// during the calculations.
+	length int
+}
+
+// NewIntradayIntensity validates provided configuration options and
+// creates new IntradayIntensity indicator instance.
+func NewIntradayIntensity(length int, percent bool) (IntradayIntensity, error) {
+	ii := IntradayIntensity{
+		percent: percent,
+		length:  length,
+	}
+
+	if err := ii.validate(); err != nil {
+		return IntradayIntensity{}, err
+	}
+
+	return ii, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (ii *IntradayIntensity) validate() error {
+	if ii.length < 1 {
+		return &ConfigError{
+			Indicator: NameIntradayIntensity,
+			Field:     "length",
+			Value:     ii.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	ii.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates IntradayIntensity from the provided candles
+// slice. The intensity of every candle is its volume weighted by the
+// position of the close price within the candle's range, the results
+// are summed over the window.
+// Calculation is based on formula provided by stockcharts.
+// https://school.stockcharts.com/doku.php?id=technical_indicators:intraday_intensity.
+// All credits are due to David Bostian who developed IntradayIntensity
+// indicator.
+func (ii IntradayIntensity) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !ii.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != ii.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	sum := decimal.Zero
+	vol := decimal.Zero
+
+	for i := range cc {
+		sum = sum.Add(moneyFlowMultiplier(cc[i]).Mul(cc[i].Volume))
+		vol = vol.Add(cc[i].Volume)
+	}
+
+	if !ii.percent {
+		return sum, nil
+	}
+
+	if vol.Equal(decimal.Zero) {
+		return decimal.Zero, nil
+	}
+
+	return sum.Div(vol).Mul(_hundred), nil
+}
+
+// Count determines the total amount of candles needed for
+// IntradayIntensity calculation.
+func (ii IntradayIntensity) Count() int {
+	return ii.length
+}
+
+// Describe returns structured information about IntradayIntensity and
+// its output.
+func (ii IntradayIntensity) Describe() Description {
+	if !ii.percent {
+		return Description{
+			Name:  NameIntradayIntensity,
+			Input: InputCandle,
+		}
+	}
+
+	return Description{
+		Name:    NameIntradayIntensity,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     _hundred.Neg(),
+		Max:     _hundred,
+	}
+}
+
+// Keltner holds all the necessary information needed to calculate
+// Keltner channels.
+// The zero value is not usable.
+type Keltner struct {
+	// valid specifies whether Keltner paremeters were validated.
+	valid bool
+
+	// band specifies which Keltner channel band to calculate.
+	band Band
+
+	// ma specifies the moving average of the close prices that is
+	// used as the middle line of the channel.
+	ma Indicator
+
+	// atr specifies the average true range that offsets the bands from
+	// the middle line.
+	atr ATR
+
+	// multiplier specifies the multiplier of the average true range.
+	// default is 2.
+	multiplier decimal.Decimal
+}
+
+// KeltnerLines holds all lines calculated by Keltner.
+type KeltnerLines struct {
+	// Upper specifies the upper band of the channel.
+	Upper decimal.Decimal `json:"upper"`
+
+	// Middle specifies the middle line of the channel.
+	Middle decimal.Decimal `json:"middle"`
+
+	// Lower specifies the lower band of the channel.
+	Lower decimal.Decimal `json:"lower"`
+}
+
+// NewKeltner validates provided configuration options and
+// creates new Keltner indicator instance.
+// If provided moving average is nil, EMA with the provided length is
+// used. If provided multiplier is zero, default value is going to be
+// used (2).
+// Commonly used length is 20.
+func NewKeltner(band Band, ma Indicator, length int, multiplier decimal.Decimal) (Keltner, error) {
+	atr, err := NewATR(length, SmoothingEMA)
+	if err != nil {
+		return Keltner{}, fieldError(err, NameKeltner, "length")
+	}
+
+	if ma == nil {
+		ema, err := NewEMA(length)
+		if err != nil {
+			// unlikely to happen
+			return Keltner{}, err
+		}
+
+		ma = ema
+	}
+
+	if multiplier.Equal(decimal.Zero) {
+		multiplier = decimal.NewFromInt(2)
+	}
+
+	keltner := Keltner{
+		band:       band,
+		ma:         ma,
+		atr:        atr,
+		multiplier: multiplier,
+	}
+
+	if err := keltner.validate(); err != nil {
+		return Keltner{}, err
+	}
+
+	return keltner, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (keltner *Keltner) validate() error {
+	if err := keltner.band.Validate(); err != nil {
+		return &ConfigError{
+			Indicator: NameKeltner,
+			Field:     "band",
+			Value:     keltner.band,
+			Err:       err,
+		}
+	}
+
+	if keltner.ma == nil {
+		return &ConfigError{
+			Indicator: NameKeltner,
+			Field:     "ma",
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	if !keltner.atr.valid {
+		return &ConfigError{
+			Indicator: NameKeltner,
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	if keltner.multiplier.LessThan(decimal.Zero) {
+		return &ConfigError{
+			Indicator: NameKeltner,
+			Field:     "multiplier",
+			Value:     keltner.multiplier,
+			Err:       errors.New("invalid multiplier"),
+		}
+	}
+
+	keltner.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates the Keltner channel band selected by the band
+// from the provided candles slice. BandWidth returns the distance between
+// the upper and lower bands as the percentage of the middle line, just
+// like BB does.
+func (keltner Keltner) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	ll, err := keltner.CalcLines(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	switch keltner.band {
+	case BandUpper:
+		return ll.Upper, nil
+	case BandLower:
+		return ll.Lower, nil
+	default: // Keltner is validated, only BandWidth is left.
+		return bandWidth(ll.Upper, ll.Middle, ll.Lower).Mul(_hundred), nil
+	}
+}
+
+// CalcLines calculates Keltner channel lines from the provided candles
+// slice. The middle line is the moving average of the close prices and
+// the bands are offset by the multiplied average true range.
+// Calculation is based on formula provided by stockcharts.
+// https://school.stockcharts.com/doku.php?id=technical_indicators:keltner_channels.
+// All credits are due to Chester Keltner who developed Keltner channels.
+func (keltner Keltner) CalcLines(cc []Candle) (KeltnerLines, error) {
+	if !keltner.valid {
+		return KeltnerLines{}, ErrInvalidIndicator
+	}
+
+	if len(cc) != keltner.Count() {
+		return KeltnerLines{}, ErrInvalidDataSize
+	}
+
+	mid, err := keltner.ma.Calc(closes(cc[len(cc)-keltner.ma.Count():]))
+	if err != nil {
+		return KeltnerLines{}, err
+	}
+
+	atr, err := keltner.atr.CalcCandles(cc[len(cc)-keltner.atr.Count():])
+	if err != nil {
+		// unlikely to happen
+		return KeltnerLines{}, err
+	}
+
+	offset := atr.Mul(keltner.multiplier)
+
+	return KeltnerLines{
+		Upper:  mid.Add(offset),
+		Middle: mid,
+		Lower:  mid.Sub(offset),
+	}, nil
+}
+
+// Count determines the total amount of candles needed for Keltner
+// calculation.
+func (keltner Keltner) Count() int {
+	c1 := keltner.ma.Count()
+	c2 := keltner.atr.Count()
+
+	if c1 > c2 {
+		return c1
+	}
+
+	return c2
+}
+
+// Describe returns structured information about Keltner and its output.
+func (keltner Keltner) Describe() Description {
+	return Description{
+		Name:    NameKeltner,
+		Input:   InputCandle,
+		Overlay: keltner.band != BandWidth,
+	}
+}
+
+// KeltnerWidth holds all the necessary information needed to calculate
+// Keltner channel width.
+// The zero value is not usable.
+type KeltnerWidth struct {
+	// valid specifies whether KeltnerWidth paremeters were validated.
+	valid bool
+
+	// normalize specifies whether the width should be divided by the
+	// middle line of the channel.
+	normalize bool
+
+	// keltner specifies the channel which width is calculated.
+	keltner Keltner
+}
+
+// NewKeltnerWidth validates provided configuration options and
+// creates new KeltnerWidth indicator instance. The channel's middle line
+// is the EMA of the provided length.
+// If provided multiplier is zero, default value is going to be used (2).
+func NewKeltnerWidth(length int, multiplier decimal.Decimal, normalize bool) (KeltnerWidth, error) {
+	keltner, err := NewKeltner(BandWidth, nil, length, multiplier)
+	if err != nil {
+		return KeltnerWidth{}, fieldError(err, NameKeltnerWidth, "")
+	}
+
+	kw := KeltnerWidth{
+		normalize: normalize,
+		keltner:   keltner,
+	}
+
+	if err := kw.validate(); err != nil {
+		// unlikely to happen
+		return KeltnerWidth{}, err
+	}
+
+	return kw, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (kw *KeltnerWidth) validate() error {
+	if !kw.keltner.valid {
+		return &ConfigError{
+			Indicator: NameKeltnerWidth,
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	kw.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates KeltnerWidth from the provided candles slice.
+// The width is the distance between the upper and lower Keltner bands,
+// optionally divided by the middle line, just like BBW does.
+// Calculation is based on formula provided by stockcharts.
+// https://school.stockcharts.com/doku.php?id=technical_indicators:keltner_channels.
+// All credits are due to Chester Keltner who developed Keltner channels.
+func (kw KeltnerWidth) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !kw.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	ll, err := kw.keltner.CalcLines(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	if !kw.normalize {
+		return ll.Upper.Sub(ll.Lower), nil
+	}
+
+	return bandWidth(ll.Upper, ll.Middle, ll.Lower), nil
+}
+
+// Count determines the total amount of candles needed for KeltnerWidth
+// calculation.
+func (kw KeltnerWidth) Count() int {
+	return kw.keltner.Count()
+}
+
+// Describe returns structured information about KeltnerWidth and its
+// output.
+func (kw KeltnerWidth) Describe() Description {
+	return Description{
+		Name:  NameKeltnerWidth,
+		Input: InputCandle,
+	}
+}
+
+// KST holds all the necessary information needed to calculate Know Sure
+// Thing oscillator.
+// The zero value is not usable.
+type KST struct {
+	// valid specifies whether KST paremeters were validated.
+	valid bool
+
+	// roc specifies rate of change indicators of every component. Only
+	// their lengths are used, since the rates of change are calculated
+	// from the oldest to the newest data point.
+	roc [4]ROC
+
+	// sma specifies moving averages that are used to smooth rate of
+	// change values of every component.
+	sma [4]SMA
+
+	// signal specifies the moving average of the signal line.
+	signal SMA
+}
+
+// KSTLines holds both lines calculated by KST.
+type KSTLines struct {
+	// KST specifies the weighted sum of the smoothed rates of change.
+	KST decimal.Decimal `json:"kst"`
+
+	// Signal specifies the moving average of the KST line.
+	Signal decimal.Decimal `json:"signal"`
+}
+
+// NewKST validates provided configuration options and
+// creates new KST indicator instance. Components are weighted from 1 to 4
+// in the provided order.
+// Commonly used values are 10, 15, 20, 30 for rocs, 10, 10, 10, 15 for
+// smas and 9 for signal.
+func NewKST(rocs, smas [4]int, signal int) (KST, error) {
+	var (
+		kst KST
+		err error
+	)
+
+	for i := range rocs {
+		kst.roc[i], err = NewROC(rocs[i])
+		if err != nil {
+			return KST{}, fieldError(err, NameKST, "rocs["+strconv.Itoa(i)+"]")
+		}
+
+		kst.sma[i], err = NewSMA(smas[i])
+		if err != nil {
+			return KST{}, fieldError(err, NameKST, "smas["+strconv.Itoa(i)+"]")
+		}
+	}
+
+	kst.signal, err = NewSMA(signal)
+	if err != nil {
+		return KST{}, fieldError(err, NameKST, "signal")
+	}
+
+	kst.valid = true
+
+	return kst, nil
+}
+
+// Calc calculates KST line from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/k/know-sure-thing-kst.asp.
+// All credits are due to Martin Pring who developed KST indicator.
+func (kst KST) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !kst.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != kst.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	return kst.line(dd)
+}
+
+// CalcLines calculates both KST and signal lines from the provided data
+// points slice.
+func (kst KST) CalcLines(dd []decimal.Decimal) (KSTLines, error) {
+	if !kst.valid {
+		return KSTLines{}, ErrInvalidIndicator
+	}
+
+	if len(dd) != kst.Count() {
+		return KSTLines{}, ErrInvalidDataSize
+	}
+
+	ll := make([]decimal.Decimal, kst.signal.Count())
+	offset := len(dd) - len(ll) + 1
+
+	for i := range ll {
+		var err error
+
+		ll[i], err = kst.line(dd[:offset+i])
+		if err != nil {
+			// unlikely to happen
+			return KSTLines{}, err
+		}
+	}
+
+	signal, err := kst.signal.Calc(ll)
+	if err != nil {
+		// unlikely to happen
+		return KSTLines{}, err
+	}
+
+	return KSTLines{
+		KST:    ll[len(ll)-1],
+		Signal: signal,
+	}, nil
+}
+
+// line calculates KST line from the newest data points of the provided
+// slice.
+func (kst KST) line(dd []decimal.Decimal) (decimal.Decimal, error) {
+	res := decimal.Zero
+
+	for i := range kst.roc {
+		n := kst.roc[i].Count() + kst.sma[i].Count() - 1
+		if len(dd) < n {
+			return decimal.Zero, ErrInvalidDataSize
+		}
+
+		src := dd[len(dd)-n:]
+		rr := make([]decimal.Decimal, kst.sma[i].Count())
+
+		for j := range rr {
+			rr[j] = rateOfChange(src[j : j+kst.roc[i].Count()])
+		}
+
+		sma, err := kst.sma[i].Calc(rr)
+		if err != nil {
+			return decimal.Zero, err
+		}
+
+		res = res.Add(sma.Mul(decimal.NewFromInt(int64(i + 1))))
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of data points needed for KST
+// calculation.
+func (kst KST) Count() int {
+	var n int
+
+	for i := range kst.roc {
+		if c := kst.roc[i].Count() + kst.sma[i].Count() - 1; c > n {
+			n = c
+		}
+	}
+
+	return n + kst.signal.Count() - 1
+}
+
+// Describe returns structured information about KST and its output.
+func (kst KST) Describe() Description {
+	return Description{
+		Name:  NameKST,
+		Input: InputClose,
+	}
+}
+
+// Kurtosis holds all the necessary information needed to calculate
+// rolling excess kurtosis.
+// The zero value is not usable.
+type Kurtosis struct {
+	// valid specifies whether Kurtosis paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewKurtosis validates provided configuration options and
+// creates new Kurtosis indicator instance.
+func NewKurtosis(length int) (Kurtosis, error) {
+	kurt := Kurtosis{length: length}
+
+	if err := kurt.validate(); err != nil {
+		return Kurtosis{}, err
+	}
+
+	return kurt, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (kurt *Kurtosis) validate() error {
+	if kurt.length < 2 {
+		return &ConfigError{
+			Indicator: NameKurtosis,
+			Field:     "length",
+			Value:     kurt.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	kurt.valid = true
+
+	return nil
+}
+
+// Calc calculates Kurtosis from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/k/kurtosis.asp.
+func (kurt Kurtosis) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !kurt.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != kurt.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	m2, _, m4 := moments(dd)
+
+	if m2.Equal(decimal.Zero) {
+		return decimal.Zero, nil
+	}
+
+	return m4.Div(m2.Mul(m2)).Sub(decimal.NewFromInt(3)), nil
+}
+
+// Count determines the total amount of data points needed for Kurtosis
+// calculation.
+func (kurt Kurtosis) Count() int {
+	return kurt.length
+}
+
+// Describe returns structured information about Kurtosis and its output.
+func (kurt Kurtosis) Describe() Description {
+	return Description{
+		Name:  NameKurtosis,
+		Input: InputClose,
+	}
+}
+
+// LinReg holds all the necessary information needed to calculate
+// linear regression of the data points.
+// The zero value is not usable.
+type LinReg struct {
+	// valid specifies whether LinReg paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// LinRegLines holds all values calculated by LinReg.
+type LinRegLines struct {
+	// Slope specifies the change of the fitted line per data point.
+	Slope decimal.Decimal `json:"slope"`
+
+	// Intercept specifies the value of the fitted line at the oldest
+	// data point.
+	Intercept decimal.Decimal `json:"intercept"`
+
+	// R2 specifies the coefficient of determination, i.e. how well the
+	// fitted line explains the data points, from 0 to 1. It is zero when
+	// all data points are equal.
+	R2 decimal.Decimal `json:"r2"`
+
+	// Forecast specifies the value of the fitted line at the newest
+	// data point.
+	Forecast decimal.Decimal `json:"forecast"`
+}
+
+// NewLinReg validates provided configuration options and
+// creates new LinReg indicator instance.
+func NewLinReg(length int) (LinReg, error) {
+	lr := LinReg{length: length}
+
+	if err := lr.validate(); err != nil {
+		return LinReg{}, err
+	}
+
+	return lr, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (lr *LinReg) validate() error {
+	if lr.length < 2 {
+		return &ConfigError{
+			Indicator: NameLinReg,
+			Field:     "length",
+			Value:     lr.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	lr.valid = true
+
+	return nil
+}
+
+// Calc calculates LinReg from the provided data points slice.
+// The result is the slope of the least squares line fitted through the
+// data points. Use CalcLines to get all linear regression values.
+func (lr LinReg) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !lr.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != lr.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	slope, _ := linreg(dd)
+
+	return slope, nil
+}
+
+// CalcLines calculates slope, intercept, coefficient of determination and
+// forecast of the least squares line fitted through the provided data
+// points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/r/r-squared.asp.
+func (lr LinReg) CalcLines(dd []decimal.Decimal) (LinRegLines, error) {
+	if !lr.valid {
+		return LinRegLines{}, ErrInvalidIndicator
+	}
+
+	if len(dd) != lr.Count() {
+		return LinRegLines{}, ErrInvalidDataSize
+	}
+
+	slope, intercept := linreg(dd)
+	mean := avg(dd)
+
+	res := decimal.Zero
+	tot := decimal.Zero
+
+	for i := range dd {
+		fit := intercept.Add(slope.Mul(decimal.NewFromInt(int64(i))))
+		res = res.Add(dd[i].Sub(fit).Pow(decimal.NewFromInt(2)))
+		tot = tot.Add(dd[i].Sub(mean).Pow(decimal.NewFromInt(2)))
+	}
+
+	r2 := decimal.Zero
+	if !tot.Equal(decimal.Zero) {
+		r2 = _one.Sub(res.Div(tot))
+	}
+
+	return LinRegLines{
+		Slope:     slope,
+		Intercept: intercept,
+		R2:        r2,
+		Forecast:  intercept.Add(slope.Mul(decimal.NewFromInt(int64(len(dd) - 1)))),
+	}, nil
+}
+
+// Count determines the total amount of data points needed for LinReg
+// calculation.
+func (lr LinReg) Count() int {
+	return lr.length
+}
+
+// Describe returns structured information about LinReg and its output.
+func (lr LinReg) Describe() Description {
+	return Description{
+		Name:  NameLinReg,
+		Input: InputClose,
+	}
+}
+
+// Lowest holds all the necessary information needed to calculate
+// the lowest value of the rolling window.
+// The zero value is not usable.
+type Lowest struct {
+	// valid specifies whether Lowest paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewLowest validates provided configuration options and
+// creates new Lowest indicator instance.
+func NewLowest(length int) (Lowest, error) {
+	lowest := Lowest{length: length}
+
+	if err := lowest.validate(); err != nil {
+		return Lowest{}, err
+	}
+
+	return lowest, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (lowest *Lowest) validate() error {
+	if lowest.length < 1 {
+		return &ConfigError{
+			Indicator: NameLowest,
+			Field:     "length",
+			Value:     lowest.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	lowest.valid = true
+
+	return nil
+}
+
+// Calc calculates Lowest from the provided data points slice.
+func (lowest Lowest) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !lowest.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != lowest.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	return decimal.Min(dd[0], dd[1:]...), nil
+}
+
+// Count determines the total amount of data points needed for Lowest
+// calculation.
+func (lowest Lowest) Count() int {
+	return lowest.length
+}
+
+// Describe returns structured information about Lowest and its output.
+func (lowest Lowest) Describe() Description {
+	return Description{
+		Name:    NameLowest,
+		Input:   InputClose,
+		Overlay: true,
+	}
+}
+
+// Series calculates the lowest value of every consecutive window of
+// the provided data points slice, starting with the oldest one.
+func (lowest Lowest) Series(dd []decimal.Decimal) (Series, error) {
+	if !lowest.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < lowest.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	return rollingExtremes(dd, lowest.length, func(a, b decimal.Decimal) bool {
+		return a.LessThan(b)
+	}), nil
+}
+
+// LSMA holds all the necessary information needed to calculate
+// least squares moving average.
+// The zero value is not usable.
+type LSMA struct {
+	// valid specifies whether LSMA paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewLSMA validates provided configuration options and
+// creates new LSMA indicator instance.
+func NewLSMA(length int) (LSMA, error) {
+	lsma := LSMA{length: length}
+
+	if err := lsma.validate(); err != nil {
+		return LSMA{}, err
+	}
+
+	return lsma, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (lsma *LSMA) validate() error {
+	if lsma.length < 1 {
+		return &ConfigError{
+			Indicator: NameLSMA,
+			Field:     "length",
+			Value:     lsma.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	lsma.valid = true
+
+	return nil
+}
+
+// Calc calculates LSMA from the provided data points slice.
+// The result is the newest point of the least squares line fitted
+// through the data points.
+// Calculation is based on formula provided by tradingview.
+// https://www.tradingview.com/support/solutions/43000594683-least-squares-moving-average/.
+func (lsma LSMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !lsma.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != lsma.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	slope, intercept := linreg(dd)
+
+	return intercept.Add(slope.Mul(decimal.NewFromInt(int64(len(dd) - 1)))), nil
+}
+
+// Count determines the total amount of data points needed for LSMA
+// calculation.
+func (lsma LSMA) Count() int {
+	return lsma.length
+}
+
+// Describe returns structured information about LSMA and its output.
+func (lsma LSMA) Describe() Description {
+	return Description{
+		Name:    NameLSMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.Zero,
+	}
+}
+
+// MACD holds all the necessary information needed to calculate moving
+// average convergence divergence.
+// The zero value is not usable.
+type MACD struct {
+	// valid specifies whether MACD paremeters were validated.
+	valid bool
+
+	// ma1 specifies the first (usually faster) moving average.
+	ma1 Indicator
+
+	// ma2 specifies the second (usually slower) moving average.
+	ma2 Indicator
+
+	// signal specifies the moving average of the signal line.
+	signal Indicator
+}
+
+// MACDLines holds all lines calculated by MACD.
+type MACDLines struct {
+	// MACD specifies the difference between the first and second moving
+	// averages.
+	MACD decimal.Decimal `json:"macd"`
+
+	// Signal specifies the moving average of the MACD line.
+	Signal decimal.Decimal `json:"signal"`
+
+	// Histogram specifies the difference between the MACD and signal
+	// lines.
+	Histogram decimal.Decimal `json:"histogram"`
+}
+
+// NewMACD validates provided configuration options and
+// creates new MACD indicator instance.
+// If provided signal indicator is nil, EMA with the length of 9 is used.
+func NewMACD(ma1, ma2, signal Indicator) (MACD, error) {
+	if signal == nil {
+		ema, err := NewEMA(9)
+		if err != nil {
+			// unlikely to happen
+			return MACD{}, err
+		}
+
+		signal = ema
+	}
+
+	macd := MACD{
+		ma1:    ma1,
+		ma2:    ma2,
+		signal: signal,
+	}
+
+	if err := macd.validate(); err != nil {
+		return MACD{}, err
+	}
+
+	return macd, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (macd *MACD) validate() error {
+	if macd.ma1 == nil {
+		return &ConfigError{
+			Indicator: NameMACD,
+			Field:     "ma1",
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	if macd.ma2 == nil {
+		return &ConfigError{
+			Indicator: NameMACD,
+			Field:     "ma2",
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	if macd.signal == nil {
+		return &ConfigError{
+			Indicator: NameMACD,
+			Field:     "signal",
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	macd.valid = true
+
+	return nil
+}
+
+// Calc calculates MACD line from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/m/macd.asp.
+// All credits are due to Gerald Appel who developed MACD indicator.
+func (macd MACD) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !macd.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != macd.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	return macd.line(dd)
+}
+
+// CalcLines calculates MACD, signal and histogram lines from the provided
+// data points slice. The slice must contain CountLines data points.
+func (macd MACD) CalcLines(dd []decimal.Decimal) (MACDLines, error) {
+	if !macd.valid {
+		return MACDLines{}, ErrInvalidIndicator
+	}
+
+	if len(dd) != macd.CountLines() {
+		return MACDLines{}, ErrInvalidDataSize
+	}
+
+	ll := make([]decimal.Decimal, macd.signal.Count())
+	offset := len(dd) - len(ll) + 1
+
+	for i := range ll {
+		var err error
+
+		ll[i], err = macd.line(dd[:offset+i])
+		if err != nil {
+			return MACDLines{}, err
+		}
+	}
+
+	signal, err := macd.signal.Calc(ll)
+	if err != nil {
+		return MACDLines{}, err
+	}
+
+	return MACDLines{
+		MACD:      ll[len(ll)-1],
+		Signal:    signal,
+		Histogram: ll[len(ll)-1].Sub(signal),
+	}, nil
+}
+
+// line calculates MACD line from the newest data points of the provided
+// slice.
+func (macd MACD) line(dd []decimal.Decimal) (decimal.Decimal, error) {
+	r1, r2, err := macd.averages(dd)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return r1.Sub(r2), nil
+}
+
+// averages calculates both moving averages from the newest data points
+// of the provided slice.
+func (macd MACD) averages(dd []decimal.Decimal) (r1, r2 decimal.Decimal, err error) {
+	r1, err = macd.ma1.Calc(dd[len(dd)-macd.ma1.Count():])
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+
+	r2, err = macd.ma2.Calc(dd[len(dd)-macd.ma2.Count():])
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+
+	return r1, r2, nil
+}
+
+// Count determines the total amount of data points needed for MACD
+// calculation.
+func (macd MACD) Count() int {
+	return macd.lineCount()
+}
+
+// CountLines determines the total amount of data points needed for MACD
+// lines calculation. It includes the data points that are needed to
+// calculate the signal line.
+func (macd MACD) CountLines() int {
+	return macd.lineCount() + macd.signal.Count() - 1
+}
+
+// lineCount determines the amount of data points needed for a single
+// MACD line value calculation.
+func (macd MACD) lineCount() int {
+	c1 := macd.ma1.Count()
+	c2 := macd.ma2.Count()
+
+	if c1 > c2 {
+		return c1
+	}
+
+	return c2
+}
+
+// Describe returns structured information about MACD and its output.
+func (macd MACD) Describe() Description {
+	return Description{
+		Name:  NameMACD,
+		Input: InputClose,
+	}
+}
+
+// McGinley holds all the necessary information needed to calculate
+// McGinley dynamic.
+// The zero value is not usable.
+type McGinley struct {
+	// valid specifies whether McGinley paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+
+	// constant specifies the tracking constant that adjusts the speed
+	// of the moving average.
+	// default is 0.6.
+	constant decimal.Decimal
+}
+
+// NewMcGinley validates provided configuration options and
+// creates new McGinley indicator instance.
+// If provided constant is zero, default value is going to be used (0.6).
+func NewMcGinley(length int, constant decimal.Decimal) (McGinley, error) {
+	if constant.Equal(decimal.Zero) {
+		constant = decimal.RequireFromString("0.6")
+	}
+
+	mcg := McGinley{
+		length:   length,
+		constant: constant,
+	}
+
+	if err := mcg.validate(); err != nil {
+		return McGinley{}, err
+	}
+
+	return mcg, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (mcg *McGinley) validate() error {
+	if mcg.length < 1 {
+		return &ConfigError{
+			Indicator: NameMcGinley,
+			Field:     "length",
+			Value:     mcg.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if mcg.constant.LessThanOrEqual(decimal.Zero) {
+		return &ConfigError{
+			Indicator: NameMcGinley,
+			Field:     "constant",
+			Value:     mcg.constant,
+			Err:       errors.New("invalid constant"),
+		}
+	}
+
+	mcg.valid = true
+
+	return nil
+}
+
+// Calc calculates McGinley from the provided data points slice. The
+// moving average is seeded with the SMA of the first length data points,
+// just like EMA.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/m/mcginley-dynamic.asp.
+// All credits are due to John R. McGinley who developed McGinley
+// indicator.
+func (mcg McGinley) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !mcg.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != mcg.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	res := avg(dd[:mcg.length])
+
+	for i := mcg.length; i < len(dd); i++ {
+		res = mcg.next(res, dd[i])
+	}
+
+	return res, nil
+}
+
+// CalcNext calculates sequential McGinley by using previous McGinley.
+func (mcg McGinley) CalcNext(lres, dec decimal.Decimal) (decimal.Decimal, error) {
+	if !mcg.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	return mcg.next(lres, dec), nil
+}
+
+// next calculates the next McGinley value from the previous one. If the
+// previous value is zero, the data point itself is returned.
+func (mcg McGinley) next(lres, dec decimal.Decimal) decimal.Decimal {
+	if lres.Equal(decimal.Zero) {
+		return dec
+	}
+
+	dnm := mcg.constant.
+		Mul(decimal.NewFromInt(int64(mcg.length))).
+		Mul(dec.Div(lres).Pow(decimal.NewFromInt(4)))
+
+	if dnm.Equal(decimal.Zero) {
+		return lres
+	}
+
+	return lres.Add(dec.Sub(lres).Div(dnm))
+}
+
+// Count determines the total amount of data points needed for McGinley
+// calculation.
+func (mcg McGinley) Count() int {
+	return mcg.length*2 - 1
+}
+
+// Describe returns structured information about McGinley and its output.
+func (mcg McGinley) Describe() Description {
+	return Description{
+		Name:    NameMcGinley,
+		Input:   InputClose,
+		Overlay: true,
+	}
+}
+
+// MedianPrice holds all the necessary information needed to calculate
+// median price, the average of the high and low prices, of candles.
+// It is a shorthand for Source with PriceHL2.
+// The zero value is not usable.
+type MedianPrice struct {
+	// valid specifies whether MedianPrice paremeters were validated.
+	valid bool
+
+	// src specifies the source of PriceHL2 prices.
+	src Source
+}
+
+// NewMedianPrice validates provided configuration options and
+// creates new MedianPrice indicator instance.
+// Provided indicator, e.g. the SMA used by the awesome oscillator, is
+// calculated from the median prices instead of the close prices.
+func NewMedianPrice(ind Indicator) (MedianPrice, error) {
+	src, err := NewSource(PriceHL2, ind)
+	if err != nil {
+		// unlikely to happen
+		return MedianPrice{}, err
+	}
+
+	mp := MedianPrice{
+		src: src,
+	}
+
+	if err := mp.validate(); err != nil {
+		// unlikely to happen
+		return MedianPrice{}, err
+	}
+
+	return mp, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (mp *MedianPrice) validate() error {
+	if !mp.src.valid {
+		return &ConfigError{
+			Indicator: NameMedianPrice,
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	mp.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates MedianPrice from the provided candles slice.
+func (mp MedianPrice) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !mp.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	return mp.src.CalcCandles(cc)
+}
+
+// Count determines the total amount of candles needed for MedianPrice
+// calculation.
+func (mp MedianPrice) Count() int {
+	return mp.src.Count()
+}
+
+// Describe returns structured information about MedianPrice and its output.
+func (mp MedianPrice) Describe() Description {
+	return describePrices(NameMedianPrice, mp.src.ind)
+}
+
+// MFI holds all the necessary information needed to calculate
+// money flow index.
+// The zero value is not usable.
+type MFI struct {
+	// valid specifies whether MFI paremeters were validated.
+	valid bool
+
+	// length specifies how many money flow values should be used
+	// during the calculations.
+	length int
+}
+
+// NewMFI validates provided configuration options and
+// creates new MFI indicator instance.
+func NewMFI(length int) (MFI, error) {
+	mfi := MFI{length: length}
+
+	if err := mfi.validate(); err != nil {
+		return MFI{}, err
+	}
+
+	return mfi, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (mfi *MFI) validate() error {
+	if mfi.length < 1 {
+		return &ConfigError{
+			Indicator: NameMFI,
+			Field:     "length",
+			Value:     mfi.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	mfi.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates MFI from the provided candles slice.
+// The first candle is only used as the previous candle of the second one.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/m/mfi.asp.
+// All credits are due to Gene Quong and Avrum Soudack who developed MFI indicator.
+func (mfi MFI) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !mfi.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != mfi.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	pos := decimal.Zero
+	neg := decimal.Zero
+	prev := typicalPrice(cc[0])
+
+	for i := 1; i < len(cc); i++ {
+		tp := typicalPrice(cc[i])
+
+		switch {
+		case tp.GreaterThan(prev):
+			pos = pos.Add(tp.Mul(cc[i].Volume))
+		case tp.LessThan(prev):
+			neg = neg.Add(tp.Mul(cc[i].Volume))
+		}
+
+		prev = tp
+	}
+
+	if pos.Equal(decimal.Zero) {
+		return decimal.Zero, nil
+	}
+
+	if neg.Equal(decimal.Zero) {
+		return _hundred, nil
+	}
+
+	return _hundred.Sub(_hundred.Div(_one.Add(pos.Div(neg)))), nil
+}
+
+// Count determines the total amount of candles needed for MFI
+// calculation.
+func (mfi MFI) Count() int {
+	return mfi.length + 1
+}
+
+// Describe returns structured information about MFI and its output.
+func (mfi MFI) Describe() Description {
+	return Description{
+		Name:    NameMFI,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}
+}
+
+// Normalize holds all the necessary information needed to rescale the
+// output of another indicator to the 0-100 range.
+// The zero value is not usable.
+type Normalize struct {
+	// valid specifies whether Normalize paremeters were validated.
+	valid bool
+
+	// source specifies the indicator which output should be rescaled.
+	source Indicator
+
+	// window specifies how many source indicator values should be used
+	// during the calculations.
+	window int
+
+	// scaling specifies how the source indicator values should be
+	// rescaled.
+	scaling Scaling
+}
+
+// NewNormalize validates provided configuration options and
+// creates new Normalize indicator instance.
+func NewNormalize(source Indicator, window int, scaling Scaling) (Normalize, error) {
+	norm := Normalize{
+		source:  source,
+		window:  window,
+		scaling: scaling,
+	}
+
+	if err := norm.validate(); err != nil {
+		return Normalize{}, err
+	}
+
+	return norm, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (norm *Normalize) validate() error {
+	if norm.source == nil {
+		return &ConfigError{
+			Indicator: NameNormalize,
+			Field:     "source",
+			Value:     norm.source,
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	if norm.window < 2 {
+		return &ConfigError{
+			Indicator: NameNormalize,
+			Field:     "window",
+			Value:     norm.window,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if err := norm.scaling.Validate(); err != nil {
+		return &ConfigError{
+			Indicator: NameNormalize,
+			Field:     "scaling",
+			Value:     norm.scaling,
+			Err:       err,
+		}
+	}
+
+	norm.valid = true
+
+	return nil
+}
+
+// Calc calculates the source indicator values over the window from the
+// provided data points slice and rescales the newest one to the 0-100
+// range.
+func (norm Normalize) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !norm.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != norm.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	vv := make([]decimal.Decimal, norm.window)
+
+	for i := range vv {
+		var err error
+
+		vv[i], err = norm.source.Calc(dd[i : i+norm.source.Count()])
+		if err != nil {
+			return decimal.Zero, err
+		}
+	}
+
+	curr := vv[len(vv)-1]
+
+	if norm.scaling == ScalingPercentRank {
+		cnt := 0
+
+		for i := 0; i < len(vv)-1; i++ {
+			if vv[i].LessThanOrEqual(curr) {
+				cnt++
+			}
+		}
+
+		return decimal.NewFromInt(int64(cnt)).Mul(_hundred).
+			Div(decimal.NewFromInt(int64(len(vv) - 1))), nil
+	}
+
+	min, max := curr, curr
+
+	for i := range vv {
+		if vv[i].LessThan(min) {
+			min = vv[i]
+		}
+
+		if vv[i].GreaterThan(max) {
+			max = vv[i]
+		}
+	}
+
+	if max.Equal(min) {
+		return decimal.Zero, nil
+	}
+
+	return curr.Sub(min).Div(max.Sub(min)).Mul(_hundred), nil
+}
+
+// Count determines the total amount of data points needed for Normalize
+// calculation.
+func (norm Normalize) Count() int {
+	return norm.source.Count() + norm.window - 1
+}
+
+// Describe returns structured information about Normalize and its output.
+func (norm Normalize) Describe() Description {
+	return Description{
+		Name:    NameNormalize,
+		Input:   describe(norm.source).Input,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}
+}
+
+// NVI holds all the necessary information needed to calculate negative
+// volume index over a fixed window of candles. Use NVIStream to
+// accumulate negative volume index over the whole history instead.
+// The zero value is not usable.
+type NVI struct {
+	// valid specifies whether NVI paremeters were validated.
+	valid bool
+
+	// length specifies how many volume changes should be accumulated
+	// during the calculations.
+	length int
+
+	// signal specifies the optional exponential moving average of the
+	// index line. The zero value means that no signal line is used.
+	signal EMA
+}
+
+// NewNVI validates provided configuration options and
+// creates new NVI indicator instance.
+// If provided signal length is zero, no signal line is calculated.
+func NewNVI(length, signal int) (NVI, error) {
+	nvi := NVI{
+		length: length,
+	}
+
+	if signal != 0 {
+		var err error
+
+		nvi.signal, err = NewEMA(signal)
+		if err != nil {
+			return NVI{}, fieldError(err, NameNVI, "signal")
+		}
+	}
+
+	if err := nvi.validate(); err != nil {
+		return NVI{}, err
+	}
+
+	return nvi, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (nvi *NVI) validate() error {
+	if nvi.length < 1 {
+		return &ConfigError{
+			Indicator: NameNVI,
+			Field:     "length",
+			Value:     nvi.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	nvi.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates NVI from the provided candles slice. The index
+// starts at 1000 with the first candle and only changes when the volume
+// decreases.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/n/nvi.asp.
+// All credits are due to Paul Dysart who developed NVI indicator.
+func (nvi NVI) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	ll, err := nvi.CalcLines(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return ll.Index, nil
+}
+
+// CalcLines calculates NVI and its signal lines from the provided
+// candles slice.
+func (nvi NVI) CalcLines(cc []Candle) (VolumeIndexLines, error) {
+	if !nvi.valid {
+		return VolumeIndexLines{}, ErrInvalidIndicator
+	}
+
+	if len(cc) != nvi.Count() {
+		return VolumeIndexLines{}, ErrInvalidDataSize
+	}
+
+	return calcVolumeIndex(cc, false, nvi.signal)
+}
+
+// Count determines the total amount of candles needed for NVI
+// calculation.
+func (nvi NVI) Count() int {
+	if !nvi.signal.valid {
+		return nvi.length + 1
+	}
+
+	return nvi.length + nvi.signal.Count()
+}
+
+// Describe returns structured information about NVI and its output.
+func (nvi NVI) Describe() Description {
+	return Description{
+		Name:  NameNVI,
+		Input: InputCandle,
+	}
+}
+
+// OBV holds all the necessary information needed to calculate on-balance
+// volume over a fixed window of candles. Use OBVStream to accumulate
+// on-balance volume over the whole history instead.
+// The zero value is not usable.
+type OBV struct {
+	// valid specifies whether OBV paremeters were validated.
+	valid bool
+
+	// length specifies how many volume changes should be accumulated
+	// during the calculations.
+	length int
+}
+
+// NewOBV validates provided configuration options and
+// creates new OBV indicator instance.
+func NewOBV(length int) (OBV, error) {
+	obv := OBV{
+		length: length,
+	}
+
+	if err := obv.validate(); err != nil {
+		return OBV{}, err
+	}
+
+	return obv, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (obv *OBV) validate() error {
+	if obv.length < 1 {
+		return &ConfigError{
+			Indicator: NameOBV,
+			Field:     "length",
+			Value:     obv.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	obv.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates OBV from the provided candles slice. The first
+// candle is only used as the previous candle of the second one.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/o/onbalancevolume.asp.
+// All credits are due to Joseph Granville who developed OBV indicator.
+func (obv OBV) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !obv.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != obv.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	var stream OBVStream
+
+	for i := range cc {
+		stream.Add(cc[i])
+	}
+
+	return stream.Value(), nil
+}
+
+// Count determines the total amount of candles needed for OBV
+// calculation.
+func (obv OBV) Count() int {
+	return obv.length + 1
+}
+
+// Describe returns structured information about OBV and its output.
+func (obv OBV) Describe() Description {
+	return Description{
+		Name:  NameOBV,
+		Input: InputCandle,
+	}
+}
+
+// Offset holds all the necessary information needed to calculate the
+// source indicator's value of the given amount of data points ago.
+// The zero value is not usable.
+type Offset struct {
+	// valid specifies whether Offset paremeters were validated.
+	valid bool
+
+	// source specifies the indicator which value is shifted.
+	source Indicator
+
+	// shift specifies by how many data points the source indicator's
+	// value is shifted.
+	shift int
+}
+
+// NewOffset validates provided configuration options and
+// creates new Offset indicator instance.
+func NewOffset(source Indicator, shift int) (Offset, error) {
+	offset := Offset{
+		source: source,
+		shift:  shift,
+	}
+
+	if err := offset.validate(); err != nil {
+		return Offset{}, err
+	}
+
+	return offset, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (offset *Offset) validate() error {
+	if offset.source == nil {
+		return &ConfigError{
+			Indicator: NameOffset,
+			Field:     "source",
+			Value:     offset.source,
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	if offset.shift < 0 {
+		return &ConfigError{
+			Indicator: NameOffset,
+			Field:     "shift",
+			Value:     offset.shift,
+			Err:       ErrInvalidShift,
+		}
+	}
+
+	offset.valid = true
+
+	return nil
+}
+
+// Calc calculates the source indicator from the oldest data points of the
+// provided slice, ignoring the newest shift data points.
+func (offset Offset) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !offset.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != offset.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	return offset.source.Calc(dd[:len(dd)-offset.shift])
+}
+
+// Count determines the total amount of data points needed for Offset
+// calculation.
+func (offset Offset) Count() int {
+	return offset.source.Count() + offset.shift
+}
+
+// Describe returns structured information about Offset and its output.
+// It matches the description of the source indicator, except that the
+// lag of an overlay is increased by the shift.
+func (offset Offset) Describe() Description {
+	desc := describe(offset.source)
+	desc.Name = NameOffset
+
+	if desc.Overlay {
+		desc.Lag = desc.Lag.Add(decimal.NewFromInt(int64(offset.shift)))
+	}
+
+	return desc
+}
+
+// PercentB holds all the necessary information needed to calculate
+// Bollinger %B.
+// The zero value is not usable.
+type PercentB struct {
+	// valid specifies whether PercentB paremeters were validated.
+	valid bool
+
+	// bb specifies the Bollinger Bands configuration.
+	bb BB
+}
+
+// NewPercentB validates provided configuration options and
+// creates new PercentB indicator instance.
+func NewPercentB(stdDev decimal.Decimal, length int) (PercentB, error) {
+	bb, err := NewBB(false, BandWidth, stdDev, length)
+	if err != nil {
+		return PercentB{}, fieldError(err, NamePercentB, "")
+	}
+
+	return PercentB{
+		valid: true,
+		bb:    bb,
+	}, nil
+}
+
+// Calc calculates PercentB from the provided data points slice.
+// The result is 0 when the newest data point is at the lower band and 1
+// when it is at the upper band.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/p/percentb.asp.
+// All credits are due to John Bollinger who developed %B indicator.
+func (pb PercentB) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !pb.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != pb.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	upper, _, lower, err := pb.bb.bands(dd)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	dnm := upper.Sub(lower)
+	if dnm.Equal(decimal.Zero) {
+		return decimal.Zero, nil
+	}
+
+	return dd[len(dd)-1].Sub(lower).Div(dnm), nil
+}
+
+// Count determines the total amount of data points needed for PercentB
+// calculation.
+func (pb PercentB) Count() int {
+	return pb.bb.Count()
+}
+
+// Describe returns structured information about PercentB and its output.
+func (pb PercentB) Describe() Description {
+	return Description{
+		Name:  NamePercentB,
+		Input: InputClose,
+	}
+}
+
+// Periodogram holds all the necessary information needed to estimate the
+// dominant cycle by using Ehlers' autocorrelation periodogram.
+// The zero value is not usable.
+type Periodogram struct {
+	// valid specifies whether Periodogram paremeters were validated.
+	valid bool
+
+	// min specifies the shortest cycle period that is checked.
+	min int
+
+	// max specifies the longest cycle period that is checked.
+	max int
+
+	// length specifies how many data points should be correlated with
+	// their lagged values.
+	length int
+}
+
+// PeriodogramLines holds all values calculated by Periodogram.
+type PeriodogramLines struct {
+	// Period specifies the dominant cycle period.
+	Period decimal.Decimal `json:"period"`
+
+	// Power specifies the share of the dominant cycle period in the
+	// spectral power of all checked periods, which measures the cycle's
+	// strength.
+	Power decimal.Decimal `json:"power"`
+}
+
+// NewPeriodogram validates provided configuration options and
+// creates new Periodogram indicator instance.
+// Commonly used values are 10, 48 and 3 (or higher).
+func NewPeriodogram(min, max, length int) (Periodogram, error) {
+	pg := Periodogram{
+		min:    min,
+		max:    max,
+		length: length,
+	}
+
+	if err := pg.validate(); err != nil {
+		return Periodogram{}, err
+	}
+
+	return pg, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (pg *Periodogram) validate() error {
+	if pg.min < 2 {
+		return &ConfigError{
+			Indicator: NamePeriodogram,
+			Field:     "min",
+			Value:     pg.min,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if pg.max < pg.min {
+		return &ConfigError{
+			Indicator: NamePeriodogram,
+			Field:     "max",
+			Value:     pg.max,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if pg.length < 2 {
+		return &ConfigError{
+			Indicator: NamePeriodogram,
+			Field:     "length",
+			Value:     pg.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	pg.valid = true
+
+	return nil
+}
+
+// Calc calculates the dominant cycle period from the provided data points
+// slice.
+func (pg Periodogram) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	ll, err := pg.CalcLines(dd)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return ll.Period, nil
+}
+
+// CalcLines calculates the dominant cycle period and its power from the
+// provided data points slice. The data points are detrended and smoothed
+// by the roofing filter first, so that only the cycles between min and
+// max periods are left. The spectral power of every period is then
+// calculated from the autocorrelation of the filtered values and the
+// period with the highest power is selected. Since the calculation
+// relies on trigonometric functions, it is done by using floating point
+// arithmetic.
+// Calculation is based on formula provided by John F. Ehlers in the
+// Cycle Analytics for Traders book.
+func (pg Periodogram) CalcLines(dd []decimal.Decimal) (PeriodogramLines, error) {
+	if !pg.valid {
+		return PeriodogramLines{}, ErrInvalidIndicator
+	}
+
+	if len(dd) != pg.Count() {
+		return PeriodogramLines{}, ErrInvalidDataSize
+	}
+
+	pp := make([]float64, len(dd))
+
+	for i := range dd {
+		pp[i], _ = dd[i].Float64()
+	}
+
+	ff := roofingFilter(pp, pg.min, pg.max)
+	vv := make([]decimal.Decimal, len(ff))
+
+	for i := range ff {
+		vv[i] = decimal.NewFromFloat(ff[i])
+	}
+
+	recent := vv[len(vv)-pg.length:]
+	corr := make([]float64, pg.max+1)
+
+	for lag := 1; lag <= pg.max; lag++ {
+		corr[lag], _ = correlation(recent, vv[len(vv)-pg.length-lag:len(vv)-lag]).Float64()
+	}
+
+	var (
+		period = pg.min
+		best   float64
+		total  float64
+	)
+
+	for p := pg.min; p <= pg.max; p++ {
+		var re, im float64
+
+		for lag := 1; lag <= pg.max; lag++ {
+			w := 2 * math.Pi * float64(lag) / float64(p)
+			re += corr[lag] * math.Cos(w)
+			im += corr[lag] * math.Sin(w)
+		}
+
+		pwr := re*re + im*im
+		total += pwr
+
+		if pwr > best {
+			period, best = p, pwr
+		}
+	}
+
+	res := PeriodogramLines{
+		Period: decimal.NewFromInt(int64(period)),
+		Power:  decimal.Zero,
+	}
+
+	if total > 0 {
+		res.Power = decimal.NewFromFloat(best / total)
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of data points needed for
+// Periodogram calculation. The roofing filter is warmed up over the
+// max period.
+func (pg Periodogram) Count() int {
+	return pg.max*2 + pg.length
+}
+
+// Describe returns structured information about Periodogram and its
+// output.
+func (pg Periodogram) Describe() Description {
+	return Description{
+		Name:    NamePeriodogram,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.NewFromInt(int64(pg.min)),
+		Max:     decimal.NewFromInt(int64(pg.max)),
+	}
+}
+
+// Pivots holds all the necessary information needed to calculate pivot
+// point levels.
+// The zero value is not usable.
+type Pivots struct {
+	// valid specifies whether Pivots paremeters were validated.
+	valid bool
+
+	// method specifies how the levels should be calculated.
+	method PivotMethod
+}
+
+// PivotLevels holds all levels calculated by Pivots.
+type PivotLevels struct {
+	// PP specifies the pivot point.
+	PP decimal.Decimal `json:"pp"`
+
+	// R1 specifies the first resistance level.
+	R1 decimal.Decimal `json:"r1"`
+
+	// R2 specifies the second resistance level.
+	R2 decimal.Decimal `json:"r2"`
+
+	// R3 specifies the third resistance level.
+	R3 decimal.Decimal `json:"r3"`
+
+	// S1 specifies the first support level.
+	S1 decimal.Decimal `json:"s1"`
+
+	// S2 specifies the second support level.
+	S2 decimal.Decimal `json:"s2"`
+
+	// S3 specifies the third support level.
+	S3 decimal.Decimal `json:"s3"`
+}
+
+// NewPivots validates provided configuration options and
+// creates new Pivots indicator instance.
+func NewPivots(method PivotMethod) (Pivots, error) {
+	pivots := Pivots{
+		method: method,
+	}
+
+	if err := pivots.validate(); err != nil {
+		return Pivots{}, err
+	}
+
+	return pivots, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (pivots *Pivots) validate() error {
+	if err := pivots.method.Validate(); err != nil {
+		return &ConfigError{
+			Indicator: NamePivots,
+			Field:     "method",
+			Value:     pivots.method,
+			Err:       err,
+		}
+	}
+
+	pivots.valid = true
+
+	return nil
+}
+
+// CalcLines calculates pivot point levels of the current period from the
+// prior period's candle.
+// Calculation is based on formulas provided by investopedia.
+// https://www.investopedia.com/terms/p/pivotpoint.asp.
+func (pivots Pivots) CalcLines(cc []Candle) (PivotLevels, error) {
+	if !pivots.valid {
+		return PivotLevels{}, ErrInvalidIndicator
+	}
+
+	if len(cc) != pivots.Count() {
+		return PivotLevels{}, ErrInvalidDataSize
+	}
+
+	c := cc[0]
+	pp := typicalPrice(c)
+	rng := c.High.Sub(c.Low)
+	two := decimal.NewFromInt(2)
+
+	if pivots.method == PivotWoodie {
+		pp = c.High.Add(c.Low).Add(c.Close.Mul(two)).Div(decimal.NewFromInt(4))
+	}
+
+	switch pivots.method {
+	case PivotFibonacci:
+		r1 := rng.Mul(decimal.RequireFromString("0.382"))
+		r2 := rng.Mul(decimal.RequireFromString("0.618"))
+
+		return PivotLevels{
+			PP: pp,
+			R1: pp.Add(r1),
+			R2: pp.Add(r2),
+			R3: pp.Add(rng),
+			S1: pp.Sub(r1),
+			S2: pp.Sub(r2),
+			S3: pp.Sub(rng),
+		}, nil
+	case PivotCamarilla:
+		rng = rng.Mul(decimal.RequireFromString("1.1"))
+		r1 := rng.Div(decimal.NewFromInt(12))
+		r2 := rng.Div(decimal.NewFromInt(6))
+		r3 := rng.Div(decimal.NewFromInt(4))
+
+		return PivotLevels{
+			PP: pp,
+			R1: c.Close.Add(r1),
+			R2: c.Close.Add(r2),
+			R3: c.Close.Add(r3),
+			S1: c.Close.Sub(r1),
+			S2: c.Close.Sub(r2),
+			S3: c.Close.Sub(r3),
+		}, nil
+	default: // Pivots is validated, only PivotClassic and PivotWoodie are left.
+		return PivotLevels{
+			PP: pp,
+			R1: pp.Mul(two).Sub(c.Low),
+			R2: pp.Add(rng),
+			R3: c.High.Add(pp.Sub(c.Low).Mul(two)),
+			S1: pp.Mul(two).Sub(c.High),
+			S2: pp.Sub(rng),
+			S3: c.Low.Sub(c.High.Sub(pp).Mul(two)),
+		}, nil
+	}
+}
+
+// Count determines the total amount of candles needed for Pivots
+// calculation.
+func (pivots Pivots) Count() int {
+	return 1
+}
+
+// Describe returns structured information about Pivots and its output.
+func (pivots Pivots) Describe() Description {
+	return Description{
+		Name:    NamePivots,
+		Input:   InputCandle,
+		Overlay: true,
+	}
+}
+
+// PPO holds all the necessary information needed to calculate percentage
+// price oscillator.
+// The zero value is not usable.
+type PPO struct {
+	// valid specifies whether PPO paremeters were validated.
+	valid bool
+
+	// macd specifies the fast and slow moving averages along with the
+	// moving average of the signal line.
+	macd MACD
+}
+
+// PPOLines holds all lines calculated by PPO.
+type PPOLines struct {
+	// PPO specifies the difference between the fast and slow moving
+	// averages as the percentage of the slow one.
+	PPO decimal.Decimal `json:"ppo"`
+
+	// Signal specifies the moving average of the PPO line.
+	Signal decimal.Decimal `json:"signal"`
+
+	// Histogram specifies the difference between the PPO and signal
+	// lines.
+	Histogram decimal.Decimal `json:"histogram"`
+}
+
+// NewPPO validates provided configuration options and
+// creates new PPO indicator instance.
+// If provided signal indicator is nil, EMA with the length of 9 is used.
+func NewPPO(fast, slow, signal Indicator) (PPO, error) {
+	if fast == nil {
+		return PPO{}, &ConfigError{
+			Indicator: NamePPO,
+			Field:     "fast",
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	if slow == nil {
+		return PPO{}, &ConfigError{
+			Indicator: NamePPO,
+			Field:     "slow",
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	macd, err := NewMACD(fast, slow, signal)
+	if err != nil {
+		// unlikely to happen
+		return PPO{}, err
+	}
+
+	ppo := PPO{
+		macd: macd,
+	}
+
+	if err := ppo.validate(); err != nil {
+		// unlikely to happen
+		return PPO{}, err
+	}
+
+	return ppo, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (ppo *PPO) validate() error {
+	if !ppo.macd.valid {
+		return &ConfigError{
+			Indicator: NamePPO,
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	ppo.valid = true
+
+	return nil
+}
+
+// Calc calculates PPO line from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/p/ppo.asp.
+func (ppo PPO) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !ppo.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != ppo.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	return ppo.line(dd)
+}
+
+// CalcLines calculates PPO, signal and histogram lines from the provided
+// data points slice. The slice must contain CountLines data points.
+func (ppo PPO) CalcLines(dd []decimal.Decimal) (PPOLines, error) {
+	if !ppo.valid {
+		return PPOLines{}, ErrInvalidIndicator
+	}
+
+	if len(dd) != ppo.CountLines() {
+		return PPOLines{}, ErrInvalidDataSize
+	}
+
+	ll := make([]decimal.Decimal, ppo.macd.signal.Count())
+	offset := len(dd) - len(ll) + 1
+
+	for i := range ll {
+		var err error
+
+		ll[i], err = ppo.line(dd[:offset+i])
+		if err != nil {
+			return PPOLines{}, err
+		}
+	}
+
+	signal, err := ppo.macd.signal.Calc(ll)
+	if err != nil {
+		return PPOLines{}, err
+	}
+
+	return PPOLines{
+		PPO:       ll[len(ll)-1],
+		Signal:    signal,
+		Histogram: ll[len(ll)-1].Sub(signal),
+	}, nil
+}
+
+// line calculates PPO line from the newest data points of the provided
+// slice.
+func (ppo PPO) line(dd []decimal.Decimal) (decimal.Decimal, error) {
+	fast, slow, err := ppo.macd.averages(dd)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	if slow.Equal(decimal.Zero) {
+		return decimal.Zero, nil
+	}
+
+	return fast.Sub(slow).Div(slow).Mul(_hundred), nil
+}
+
+// Count determines the total amount of data points needed for PPO
+// calculation.
+func (ppo PPO) Count() int {
+	return ppo.macd.Count()
+}
+
+// CountLines determines the total amount of data points needed for PPO
+// lines calculation. It includes the data points that are needed to
+// calculate the signal line.
+func (ppo PPO) CountLines() int {
+	return ppo.macd.CountLines()
+}
+
+// Describe returns structured information about PPO and its output.
+func (ppo PPO) Describe() Description {
+	return Description{
+		Name:  NamePPO,
+		Input: InputClose,
+	}
+}
+
+// ProjectionBands holds all the necessary information needed to calculate
+// projection bands.
+// The zero value is not usable.
+type ProjectionBands struct {
+	// valid specifies whether ProjectionBands paremeters were validated.
+	valid bool
+
+	// band specifies which projection band to calculate.
+	band Band
+
+	// length specifies how many candles should be used
+	// during the calculations.
+	length int
+}
+
+// ProjectionBandsLines holds all lines calculated by ProjectionBands.
+type ProjectionBandsLines struct {
+	// Upper specifies the highest high projected to the newest candle.
+	Upper decimal.Decimal `json:"upper"`
+
+	// Lower specifies the lowest low projected to the newest candle.
+	Lower decimal.Decimal `json:"lower"`
+}
+
+// NewProjectionBands validates provided configuration options and
+// creates new ProjectionBands indicator instance.
+func NewProjectionBands(band Band, length int) (ProjectionBands, error) {
+	pb := ProjectionBands{
+		band:   band,
+		length: length,
+	}
+
+	if err := pb.validate(); err != nil {
+		return ProjectionBands{}, err
+	}
+
+	return pb, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (pb *ProjectionBands) validate() error {
+	if err := pb.band.Validate(); err != nil {
+		return &ConfigError{
+			Indicator: NameProjectionBands,
+			Field:     "band",
+			Value:     pb.band,
+			Err:       err,
+		}
+	}
+
+	if pb.length < 2 {
+		return &ConfigError{
+			Indicator: NameProjectionBands,
+			Field:     "length",
+			Value:     pb.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	pb.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates the projection band selected by the band from
+// the provided candles slice. BandWidth returns the distance between the
+// upper and lower bands as the percentage of their average, just like BB
+// does.
+func (pb ProjectionBands) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	ll, err := pb.CalcLines(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	switch pb.band {
+	case BandUpper:
+		return ll.Upper, nil
+	case BandLower:
+		return ll.Lower, nil
+	default: // ProjectionBands is validated, only BandWidth is left.
+		mid := ll.Upper.Add(ll.Lower).Div(decimal.NewFromInt(2))
+
+		return bandWidth(ll.Upper, mid, ll.Lower).Mul(_hundred), nil
+	}
+}
+
+// CalcLines calculates ProjectionBands lines from the provided candles
+// slice. Every high and low is moved forward to the newest candle by the
+// slope of the linear regression of highs and lows respectively.
+// Calculation is based on formula provided by Mel Widner in the
+// Signaling Change with Projection Bands article.
+// All credits are due to Mel Widner who developed ProjectionBands
+// indicator.
+func (pb ProjectionBands) CalcLines(cc []Candle) (ProjectionBandsLines, error) {
+	if !pb.valid {
+		return ProjectionBandsLines{}, ErrInvalidIndicator
+	}
+
+	if len(cc) != pb.Count() {
+		return ProjectionBandsLines{}, ErrInvalidDataSize
+	}
+
+	hh := make([]decimal.Decimal, len(cc))
+	ll := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		hh[i] = cc[i].High
+		ll[i] = cc[i].Low
+	}
+
+	hs, _ := linreg(hh)
+	ls, _ := linreg(ll)
+
+	var res ProjectionBandsLines
+
+	for i := range cc {
+		ago := decimal.NewFromInt(int64(len(cc) - 1 - i))
+
+		high := hh[i].Add(hs.Mul(ago))
+		if i == 0 || high.GreaterThan(res.Upper) {
+			res.Upper = high
+		}
+
+		low := ll[i].Add(ls.Mul(ago))
+		if i == 0 || low.LessThan(res.Lower) {
+			res.Lower = low
+		}
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of candles needed for ProjectionBands
+// calculation.
+func (pb ProjectionBands) Count() int {
+	return pb.length
+}
+
+// Describe returns structured information about ProjectionBands and its
+// output.
+func (pb ProjectionBands) Describe() Description {
+	return Description{
+		Name:    NameProjectionBands,
+		Input:   InputCandle,
+		Overlay: pb.band != BandWidth,
+	}
+}
+
+// ProjectionOscillator holds all the necessary information needed to
+// calculate projection oscillator.
+// The zero value is not usable.
+type ProjectionOscillator struct {
+	// valid specifies whether ProjectionOscillator paremeters were
+	// validated.
+	valid bool
+
+	// pb specifies the projection bands the oscillator is derived from.
+	pb ProjectionBands
+}
+
+// NewProjectionOscillator validates provided configuration options and
+// creates new ProjectionOscillator indicator instance.
+func NewProjectionOscillator(length int) (ProjectionOscillator, error) {
+	pb, err := NewProjectionBands(BandWidth, length)
+	if err != nil {
+		return ProjectionOscillator{}, fieldError(err, NameProjectionOscillator, "length")
+	}
+
+	po := ProjectionOscillator{
+		pb: pb,
+	}
+
+	if err := po.validate(); err != nil {
+		// unlikely to happen
+		return ProjectionOscillator{}, err
+	}
+
+	return po, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (po *ProjectionOscillator) validate() error {
+	if !po.pb.valid {
+		return &ConfigError{
+			Indicator: NameProjectionOscillator,
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	po.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates ProjectionOscillator from the provided candles
+// slice. The result specifies where the newest close is located between
+// the projection bands.
+// Calculation is based on formula provided by Mel Widner in the
+// Signaling Change with Projection Bands article.
+// All credits are due to Mel Widner who developed ProjectionOscillator
+// indicator.
+func (po ProjectionOscillator) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !po.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	ll, err := po.pb.CalcLines(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	width := ll.Upper.Sub(ll.Lower)
+	if width.Equal(decimal.Zero) {
+		return decimal.Zero, nil
+	}
+
+	return cc[len(cc)-1].Close.Sub(ll.Lower).Div(width).Mul(_hundred), nil
+}
+
+// Count determines the total amount of candles needed for
+// ProjectionOscillator calculation.
+func (po ProjectionOscillator) Count() int {
+	return po.pb.Count()
+}
+
+// Describe returns structured information about ProjectionOscillator and
+// its output.
+func (po ProjectionOscillator) Describe() Description {
+	return Description{
+		Name:    NameProjectionOscillator,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}
+}
+
+// PVI holds all the necessary information needed to calculate positive
+// volume index over a fixed window of candles. Use PVIStream to
+// accumulate positive volume index over the whole history instead.
+// The zero value is not usable.
+type PVI struct {
+	// valid specifies whether PVI paremeters were validated.
+	valid bool
+
+	// length specifies how many volume changes should be accumulated
+	// during the calculations.
+	length int
+
+	// signal specifies the optional exponential moving average of the
+	// index line. The zero value means that no signal line is used.
+	signal EMA
+}
+
+// NewPVI validates provided configuration options and
+// creates new PVI indicator instance.
+// If provided signal length is zero, no signal line is calculated.
+func NewPVI(length, signal int) (PVI, error) {
+	pvi := PVI{
+		length: length,
+	}
+
+	if signal != 0 {
+		var err error
+
+		pvi.signal, err = NewEMA(signal)
+		if err != nil {
+			return PVI{}, fieldError(err, NamePVI, "signal")
+		}
+	}
+
+	if err := pvi.validate(); err != nil {
+		return PVI{}, err
+	}
+
+	return pvi, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (pvi *PVI) validate() error {
+	if pvi.length < 1 {
+		return &ConfigError{
+			Indicator: NamePVI,
+			Field:     "length",
+			Value:     pvi.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	pvi.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates PVI from the provided candles slice. The index
+// starts at 1000 with the first candle and only changes when the volume
+// increases.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/p/pvi.asp.
+// All credits are due to Paul Dysart who developed PVI indicator.
+func (pvi PVI) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	ll, err := pvi.CalcLines(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return ll.Index, nil
+}
+
+// CalcLines calculates PVI and its signal lines from the provided
+// candles slice.
+func (pvi PVI) CalcLines(cc []Candle) (VolumeIndexLines, error) {
+	if !pvi.valid {
+		return VolumeIndexLines{}, ErrInvalidIndicator
+	}
+
+	if len(cc) != pvi.Count() {
+		return VolumeIndexLines{}, ErrInvalidDataSize
+	}
+
+	return calcVolumeIndex(cc, true, pvi.signal)
+}
+
+// Count determines the total amount of candles needed for PVI
+// calculation.
+func (pvi PVI) Count() int {
+	if !pvi.signal.valid {
+		return pvi.length + 1
+	}
+
+	return pvi.length + pvi.signal.Count()
+}
+
+// Describe returns structured information about PVI and its output.
+func (pvi PVI) Describe() Description {
+	return Description{
+		Name:  NamePVI,
+		Input: InputCandle,
+	}
+}
+
+// PVO holds all the necessary information needed to calculate percentage
+// volume oscillator.
+// The zero value is not usable.
+type PVO struct {
+	// valid specifies whether PVO paremeters were validated.
+	valid bool
+
+	// ppo specifies the oscillator that is applied to the volume.
+	ppo PPO
+}
+
+// NewPVO validates provided configuration options and
+// creates new PVO indicator instance.
+func NewPVO(fast, slow, signal Indicator) (PVO, error) {
+	ppo, err := NewPPO(fast, slow, signal)
+	if err != nil {
+		return PVO{}, fieldError(err, NamePVO, "")
+	}
+
+	pvo := PVO{
+		ppo: ppo,
+	}
+
+	if err := pvo.validate(); err != nil {
+		// unlikely to happen
+		return PVO{}, err
+	}
+
+	return pvo, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (pvo *PVO) validate() error {
+	if !pvo.ppo.valid {
+		return &ConfigError{
+			Indicator: NamePVO,
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	pvo.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates PVO line from the provided candles slice.
+// Calculation is based on formula provided by stockcharts.
+// https://school.stockcharts.com/doku.php?id=technical_indicators:percentage_volume_oscillator_pvo.
+func (pvo PVO) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !pvo.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	return pvo.ppo.Calc(volumes(cc))
+}
+
+// CalcLines calculates PVO, signal and histogram lines from the provided
+// candles slice. The slice must contain CountLines candles.
+func (pvo PVO) CalcLines(cc []Candle) (PPOLines, error) {
+	if !pvo.valid {
+		return PPOLines{}, ErrInvalidIndicator
+	}
+
+	return pvo.ppo.CalcLines(volumes(cc))
+}
+
+// Count determines the total amount of candles needed for PVO
+// calculation.
+func (pvo PVO) Count() int {
+	return pvo.ppo.Count()
+}
+
+// CountLines determines the total amount of candles needed for PVO lines
+// calculation. It includes the candles that are needed to calculate the
+// signal line.
+func (pvo PVO) CountLines() int {
+	return pvo.ppo.CountLines()
+}
+
+// Describe returns structured information about PVO and its output.
+func (pvo PVO) Describe() Description {
+	return Description{
+		Name:  NamePVO,
+		Input: InputCandle,
+	}
+}
+
+// Qstick holds all the necessary information needed to calculate
+// Qstick, the average difference between close and open prices.
+// The zero value is not usable.
+type Qstick struct {
+	// valid specifies whether Qstick paremeters were validated.
+	valid bool
+
+	// length specifies how many candles should be used
+	// during the calculations.
+	length int
+}
+
+// NewQstick validates provided configuration options and
+// creates new Qstick indicator instance.
+func NewQstick(length int) (Qstick, error) {
+	qstick := Qstick{length: length}
+
+	if err := qstick.validate(); err != nil {
+		return Qstick{}, err
+	}
+
+	return qstick, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (qstick *Qstick) validate() error {
+	if qstick.length < 1 {
+		return &ConfigError{
+			Indicator: NameQstick,
+			Field:     "length",
+			Value:     qstick.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	qstick.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates Qstick from the provided candles slice.
+// Calculation is based on formula provided by tradingview.
+// https://www.tradingview.com/support/solutions/43000589173-qstick/.
+// All credits are due to Tushar Chande who developed Qstick indicator.
+func (qstick Qstick) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !qstick.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != qstick.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	var sum decimal.Decimal
+
+	for i := range cc {
+		sum = sum.Add(cc[i].Close.Sub(cc[i].Open))
+	}
+
+	return sum.Div(decimal.NewFromInt(int64(len(cc)))), nil
+}
+
+// Count determines the total amount of candles needed for Qstick
+// calculation.
+func (qstick Qstick) Count() int {
+	return qstick.length
+}
+
+// Describe returns structured information about Qstick and its output.
+func (qstick Qstick) Describe() Description {
+	return Description{
+		Name:  NameQstick,
+		Input: InputCandle,
+	}
+}
+
+// Rainbow holds all the necessary information needed to calculate
+// rainbow oscillator.
+// The zero value is not usable.
+type Rainbow struct {
+	// valid specifies whether Rainbow paremeters were validated.
+	valid bool
+
+	// length specifies how many values should be used to calculate
+	// every recursively smoothed SMA.
+	length int
+
+	// lookback specifies how many data points should be used to find
+	// the highest and lowest values.
+	lookback int
+}
+
+// RainbowLines holds all values calculated by Rainbow.
+type RainbowLines struct {
+	// Oscillator specifies the distance between the newest data point
+	// and the average of all smoothed SMAs, in percent of the lookback
+	// range.
+	Oscillator decimal.Decimal `json:"oscillator"`
+
+	// Bandwidth specifies the distance between the highest and lowest
+	// smoothed SMAs, in percent of the lookback range.
+	Bandwidth decimal.Decimal `json:"bandwidth"`
+}
+
+// NewRainbow validates provided configuration options and
+// creates new Rainbow indicator instance.
+// Commonly used length is 2 and lookback is 10.
+func NewRainbow(length, lookback int) (Rainbow, error) {
+	rainbow := Rainbow{
+		length:   length,
+		lookback: lookback,
+	}
+
+	if err := rainbow.validate(); err != nil {
+		return Rainbow{}, err
+	}
+
+	return rainbow, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (rainbow *Rainbow) validate() error {
+	if rainbow.length < 1 {
+		return &ConfigError{
+			Indicator: NameRainbow,
+			Field:     "length",
+			Value:     rainbow.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if rainbow.lookback < 1 {
+		return &ConfigError{
+			Indicator: NameRainbow,
+			Field:     "lookback",
+			Value:     rainbow.lookback,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	rainbow.valid = true
+
+	return nil
+}
+
+// Calc calculates Rainbow oscillator from the provided data points slice.
+// Calculation is based on formula provided by Mel Widner in the Rainbow
+// Charts article of Technical Analysis of Stocks & Commodities magazine.
+// All credits are due to Mel Widner who developed Rainbow indicator.
+func (rainbow Rainbow) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	ll, err := rainbow.CalcLines(dd)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return ll.Oscillator, nil
+}
+
+// CalcLines calculates Rainbow oscillator and bandwidth from the provided
+// data points slice. Every one of the 10 SMAs is calculated from the
+// values of the previous one, the first one is calculated from the
+// data points.
+func (rainbow Rainbow) CalcLines(dd []decimal.Decimal) (RainbowLines, error) {
+	if !rainbow.valid {
+		return RainbowLines{}, ErrInvalidIndicator
+	}
+
+	if len(dd) != rainbow.Count() {
+		return RainbowLines{}, ErrInvalidDataSize
+	}
+
+	var mas [10]decimal.Decimal
+
+	vv := dd
+
+	for i := range mas {
+		next := make([]decimal.Decimal, len(vv)-rainbow.length+1)
+
+		for j := range next {
+			next[j] = avg(vv[j : j+rainbow.length])
+		}
+
+		mas[i] = next[len(next)-1]
+		vv = next
+	}
+
+	high, low := dd[len(dd)-1], dd[len(dd)-1]
+
+	for _, d := range dd[len(dd)-rainbow.lookback:] {
+		high = decimal.Max(high, d)
+		low = decimal.Min(low, d)
+	}
+
+	rng := high.Sub(low)
+	if rng.Equal(decimal.Zero) {
+		return RainbowLines{
+			Oscillator: decimal.Zero,
+			Bandwidth:  decimal.Zero,
+		}, nil
+	}
+
+	top, bottom := mas[0], mas[0]
+
+	for i := 1; i < len(mas); i++ {
+		top = decimal.Max(top, mas[i])
+		bottom = decimal.Min(bottom, mas[i])
+	}
+
+	return RainbowLines{
+		Oscillator: dd[len(dd)-1].Sub(avg(mas[:])).Div(rng).Mul(_hundred),
+		Bandwidth:  top.Sub(bottom).Div(rng).Mul(_hundred),
+	}, nil
+}
+
+// Count determines the total amount of data points needed for Rainbow
+// calculation.
+func (rainbow Rainbow) Count() int {
+	if n := (rainbow.length-1)*10 + 1; n > rainbow.lookback {
+		return n
+	}
+
+	return rainbow.lookback
+}
+
+// Describe returns structured information about Rainbow and its output.
+func (rainbow Rainbow) Describe() Description {
+	return Description{
+		Name:  NameRainbow,
+		Input: InputClose,
+	}
+}
+
+// Ribbon holds all the necessary information needed to calculate
+// moving average ribbon, a group of moving averages of the same data
+// points.
+// The zero value is not usable.
+type Ribbon struct {
+	// valid specifies whether Ribbon paremeters were validated.
+	valid bool
+
+	// mas specifies the moving averages of the ribbon.
+	mas []Indicator
+}
+
+// NewRibbon validates provided configuration options and
+// creates new Ribbon indicator instance. Moving averages are expected
+// to be ordered from the fastest to the slowest one.
+func NewRibbon(mas ...Indicator) (Ribbon, error) {
+	ribbon := Ribbon{
+		mas: mas,
+	}
+
+	if err := ribbon.validate(); err != nil {
+		return Ribbon{}, err
+	}
+
+	return ribbon, nil
+}
+
+// newEMARibbon creates new Ribbon of EMAs with the provided lengths.
+func newEMARibbon(lengths ...int) (Ribbon, error) {
+	mas := make([]Indicator, len(lengths))
+
+	for i, length := range lengths {
+		ema, err := NewEMA(length)
+		if err != nil {
+			return Ribbon{}, err
+		}
+
+		mas[i] = ema
+	}
+
+	return NewRibbon(mas...)
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (ribbon *Ribbon) validate() error {
+	if len(ribbon.mas) == 0 {
+		return &ConfigError{
+			Indicator: NameRibbon,
+			Field:     "mas",
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	for i, ma := range ribbon.mas {
+		if ma == nil {
+			return &ConfigError{
+				Indicator: NameRibbon,
+				Field:     "mas[" + strconv.Itoa(i) + "]",
+				Err:       ErrInvalidIndicator,
+			}
+		}
+	}
+
+	ribbon.valid = true
+
+	return nil
+}
+
+// Calc calculates the average of all Ribbon moving averages from the
+// provided data points slice.
+func (ribbon Ribbon) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	vv, err := ribbon.CalcLines(dd)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return avg(vv), nil
+}
+
+// CalcLines calculates every Ribbon moving average from the provided data
+// points slice. The values are ordered the same way as the moving
+// averages.
+func (ribbon Ribbon) CalcLines(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !ribbon.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) != ribbon.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	res := make([]decimal.Decimal, len(ribbon.mas))
+
+	for i, ma := range ribbon.mas {
+		v, err := ma.Calc(dd[len(dd)-ma.Count():])
+		if err != nil {
+			return nil, err
+		}
+
+		res[i] = v
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of data points needed for Ribbon
+// calculation.
+func (ribbon Ribbon) Count() int {
+	var c int
+
+	for _, ma := range ribbon.mas {
+		if n := ma.Count(); n > c {
+			c = n
+		}
+	}
+
+	return c
+}
+
+// Describe returns structured information about Ribbon and its output.
+func (ribbon Ribbon) Describe() Description {
+	return Description{
+		Name:    NameRibbon,
+		Input:   InputClose,
+		Overlay: true,
+	}
+}
+
+// ROC holds all the necessary information needed to calculate rate
+// of change.
+// The zero value is not usable.
+type ROC struct {
+	// valid specifies whether ROC paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewROC validates provided configuration options and
+// creates new ROC indicator.
+func NewROC(length int) (ROC, error) {
+	roc := ROC{length: length}
+
+	if err := roc.validate(); err != nil {
+		return ROC{}, err
+	}
+
+	return roc, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (roc *ROC) validate() error {
+	if roc.length < 1 {
+		return &ConfigError{
+			Indicator: NameROC,
+			Field:     "length",
+			Value:     roc.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	roc.valid = true
+
+	return nil
+}
+
+// Calc calculates ROC from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/p/pricerateofchange.asp.
+func (roc ROC) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !roc.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != roc.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	curr := dd[0]
+	last := dd[len(dd)-1]
+
+	return curr.Div(last).Sub(_one).Mul(_hundred), nil
+}
+
+// Count determines the total amount of data points needed for ROC
+// calculation.
+func (roc ROC) Count() int {
+	return roc.length
+}
+
+// Describe returns structured information about ROC and its output.
+func (roc ROC) Describe() Description {
+	return Description{
+		Name:  NameROC,
+		Input: InputClose,
+	}
+}
+
+// RSC holds all the necessary information needed to calculate relative
+// strength comparison of an asset against its benchmark.
+// The zero value is not usable.
+type RSC struct {
+	// valid specifies whether RSC paremeters were validated.
+	valid bool
+
+	// length specifies how many data points of every series should be
+	// used during the calculations.
+	length int
+}
+
+// RSCLines holds all lines calculated by RSC.
+type RSCLines struct {
+	// Ratio specifies the newest asset price divided by the newest
+	// benchmark price.
+	Ratio decimal.Decimal `json:"ratio"`
+
+	// ROC specifies the rate of change of the ratio over the whole
+	// window, in percent.
+	ROC decimal.Decimal `json:"roc"`
+}
+
+// NewRSC validates provided configuration options and
+// creates new RSC indicator instance.
+func NewRSC(length int) (RSC, error) {
+	rsc := RSC{length: length}
+
+	if err := rsc.validate(); err != nil {
+		return RSC{}, err
+	}
+
+	return rsc, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (rsc *RSC) validate() error {
+	if rsc.length < 2 {
+		return &ConfigError{
+			Indicator: NameRSC,
+			Field:     "length",
+			Value:     rsc.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	rsc.valid = true
+
+	return nil
+}
+
+// CalcPair calculates the ratio of the asset and benchmark prices from
+// the provided data points slices.
+// Calculation is based on formula provided by stockcharts.
+// https://school.stockcharts.com/doku.php?id=technical_indicators:price_relative.
+func (rsc RSC) CalcPair(dd, bb []decimal.Decimal) (decimal.Decimal, error) {
+	ll, err := rsc.CalcLines(dd, bb)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return ll.Ratio, nil
+}
+
+// CalcLines calculates RSC ratio and its rate of change from the provided
+// asset and benchmark data points slices. Zero benchmark prices produce
+// zero ratios.
+func (rsc RSC) CalcLines(dd, bb []decimal.Decimal) (RSCLines, error) {
+	if !rsc.valid {
+		return RSCLines{}, ErrInvalidIndicator
+	}
+
+	if len(dd) != rsc.Count() || len(bb) != rsc.Count() {
+		return RSCLines{}, ErrInvalidDataSize
+	}
+
+	ratio := func(i int) decimal.Decimal {
+		if bb[i].Equal(decimal.Zero) {
+			return decimal.Zero
+		}
+
+		return dd[i].Div(bb[i])
+	}
+
+	res := RSCLines{
+		Ratio: ratio(len(dd) - 1),
+	}
+
+	if first := ratio(0); !first.Equal(decimal.Zero) {
+		res.ROC = res.Ratio.Div(first).Sub(_one).Mul(_hundred)
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of data points of every series needed
+// for RSC calculation.
+func (rsc RSC) Count() int {
+	return rsc.length
+}
+
+// Describe returns structured information about RSC and its output.
+func (rsc RSC) Describe() Description {
+	return Description{
+		Name:  NameRSC,
+		Input: InputPair,
+	}
+}
+
+// RSI holds all the necessary information needed to calculate relative
+// strength index.
+// The zero value is not usable.
+type RSI struct {
+	// valid specifies whether RSI paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+
+	// smoothing specifies how gains and losses should be averaged.
+	smoothing Smoothing
+}
+
+// NewRSI validates provided configuration options and
+// creates new RSI indicator.
+// SmoothingSMA averages gains and losses of a single window of length
+// data points. SmoothingWilder matches the canonical RSI, used by
+// TradingView and TA-Lib, and requires an extended lookback to seed
+// the recursive averages.
+// Both smoothing methods return 50 when the data points are flat, i.e.
+// there are neither gains nor losses.
+func NewRSI(length int, smoothing Smoothing) (RSI, error) {
+	rsi := RSI{
+		length:    length,
+		smoothing: smoothing,
+	}
+
+	if err := rsi.validate(); err != nil {
+		return RSI{}, err
+	}
+
+	return rsi, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (rsi *RSI) validate() error {
+	if err := rsi.smoothing.Validate(); err != nil {
+		return &ConfigError{
+			Indicator: NameRSI,
+			Field:     "smoothing",
+			Value:     rsi.smoothing,
+			Err:       err,
+		}
+	}
+
+	if rsi.length < 1 {
+		return &ConfigError{
+			Indicator: NameRSI,
+			Field:     "length",
+			Value:     rsi.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	rsi.valid = true
+
+	return nil
+}
+
+// Calc calculates RSI from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/r/rsi.asp.
+// All credits are due to J. Welles Wilder Jr. who developed RSI indicator.
+func (rsi RSI) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !rsi.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != rsi.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	if rsi.smoothing != SmoothingSMA {
+		return rsi.smoothed(dd)
+	}
+
+	ag, al := gains(dd)
+	length := decimal.NewFromInt(int64(rsi.length))
+
+	return rsiValue(ag.Div(length), al.Div(length)), nil
+}
+
+// smoothed calculates RSI by recursively averaging gains and losses of
+// every consecutive data point pair.
+func (rsi RSI) smoothed(dd []decimal.Decimal) (decimal.Decimal, error) {
+	up := make([]decimal.Decimal, len(dd)-1)
+	down := make([]decimal.Decimal, len(up))
+
+	for i := range up {
+		diff := dd[i+1].Sub(dd[i])
+		up[i] = decimal.Max(diff, decimal.Zero)
+		down[i] = decimal.Min(diff, decimal.Zero).Abs()
+	}
+
+	ag, err := rsi.smoothing.calc(up, rsi.length)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	al, err := rsi.smoothing.calc(down, rsi.length)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	return rsiValue(ag, al), nil
+}
+
+// rsiValue calculates relative strength index from the average gain and
+// the average loss. Flat data points, without any gains or losses,
+// result in the neutral value of 50.
+func rsiValue(ag, al decimal.Decimal) decimal.Decimal {
+	switch {
+	case ag.Equal(decimal.Zero) && al.Equal(decimal.Zero):
+		return _hundred.Div(decimal.NewFromInt(2))
+	case ag.Equal(decimal.Zero):
+		return decimal.Zero
+	case al.Equal(decimal.Zero):
+		return _hundred
+	default:
+		return _hundred.Sub(_hundred.Div(_one.Add(ag.Div(al))))
+	}
+}
+
+// Count determines the total amount of data points needed for RSI
+// calculation.
+func (rsi RSI) Count() int {
+	if rsi.smoothing == SmoothingSMA {
+		return rsi.length
+	}
+
+	return rsi.smoothing.count(rsi.length) + 1
+}
+
+// Describe returns structured information about RSI and its output.
+func (rsi RSI) Describe() Description {
+	return Description{
+		Name:    NameRSI,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}
+}
+
+// SavitzkyGolay holds all the necessary information needed to calculate
+// Savitzky-Golay smoothing filter.
+// The zero value is not usable.
+type SavitzkyGolay struct {
+	// valid specifies whether SavitzkyGolay paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+
+	// order specifies the order of the fitted polynomial.
+	order int
+}
+
+// NewSavitzkyGolay validates provided configuration options and
+// creates new SavitzkyGolay indicator instance.
+func NewSavitzkyGolay(length, order int) (SavitzkyGolay, error) {
+	sg := SavitzkyGolay{
+		length: length,
+		order:  order,
+	}
+
+	if err := sg.validate(); err != nil {
+		return SavitzkyGolay{}, err
+	}
+
+	return sg, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (sg *SavitzkyGolay) validate() error {
+	if sg.length < 1 {
+		return &ConfigError{
+			Indicator: NameSavitzkyGolay,
+			Field:     "length",
+			Value:     sg.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if sg.order < 0 || sg.order >= sg.length {
+		return &ConfigError{
+			Indicator: NameSavitzkyGolay,
+			Field:     "order",
+			Value:     sg.order,
+			Err:       errors.New("invalid order"),
+		}
+	}
+
+	sg.valid = true
+
+	return nil
+}
+
+// Calc calculates SavitzkyGolay from the provided data points slice.
+// A polynomial is fitted to the data points by the least squares method
+// and evaluated at the newest data point, so the filter does not look
+// into the future.
+// Calculation is based on formula provided by Abraham Savitzky and
+// Marcel J. E. Golay in the Smoothing and Differentiation of Data by
+// Simplified Least Squares Procedures article.
+func (sg SavitzkyGolay) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !sg.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != sg.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	ww := sg.weights()
+	res := decimal.Zero
+
+	for i := range dd {
+		res = res.Add(dd[i].Mul(decimal.NewFromFloat(ww[i])))
+	}
+
+	return res, nil
+}
+
+// weights calculates the convolution coefficients of the filter. The
+// positions are scaled to the (-1, 0] range to keep the normal equations
+// well conditioned.
+func (sg SavitzkyGolay) weights() []float64 {
+	size := sg.order + 1
+
+	// gram holds the normal equations matrix augmented by the unit
+	// vector, so after the elimination the last column holds the first
+	// row of its inverse.
+	gram := make([][]float64, size)
+
+	for r := range gram {
+		gram[r] = make([]float64, size+1)
+
+		for c := 0; c < size; c++ {
+			for j := 0; j < sg.length; j++ {
+				x := float64(j-sg.length+1) / float64(sg.length)
+				gram[r][c] += math.Pow(x, float64(r+c))
+			}
+		}
+	}
+
+	gram[0][size] = 1
+
+	for p := 0; p < size; p++ {
+		best := p
+
+		for r := p + 1; r < size; r++ {
+			if math.Abs(gram[r][p]) > math.Abs(gram[best][p]) {
+				best = r
+			}
+		}
+
+		gram[p], gram[best] = gram[best], gram[p]
+
+		for r := 0; r < size; r++ {
+			if r == p {
+				continue
+			}
+
+			f := gram[r][p] / gram[p][p]
+
+			for c := p; c <= size; c++ {
+				gram[r][c] -= f * gram[p][c]
+			}
+		}
+	}
+
+	coef := make([]float64, size)
+
+	for r := range coef {
+		coef[r] = gram[r][size] / gram[r][r]
+	}
+
+	ww := make([]float64, sg.length)
+
+	for j := range ww {
+		x := float64(j-sg.length+1) / float64(sg.length)
+
+		for k := range coef {
+			ww[j] += coef[k] * math.Pow(x, float64(k))
+		}
+	}
+
+	return ww
+}
+
+// Count determines the total amount of data points needed for
+// SavitzkyGolay calculation.
+func (sg SavitzkyGolay) Count() int {
+	return sg.length
+}
+
+// Describe returns structured information about SavitzkyGolay and its
+// output.
+func (sg SavitzkyGolay) Describe() Description {
+	return Description{
+		Name:    NameSavitzkyGolay,
+		Input:   InputClose,
+		Overlay: true,
+	}
+}
+
+// Sharpe holds all the necessary information needed to calculate
+// rolling Sharpe ratio.
+// The zero value is not usable.
+type Sharpe struct {
+	// valid specifies whether Sharpe paremeters were validated.
+	valid bool
+
+	// length specifies how many returns should be used during the
+	// calculations.
+	length int
+
+	// periods specifies how many data points make up a year. Zero means
+	// that the ratio is not annualized.
+	periods int
+}
+
+// NewSharpe validates provided configuration options and
+// creates new Sharpe indicator instance.
+// Commonly used amount of periods is 252 for daily data points.
+func NewSharpe(length, periods int) (Sharpe, error) {
+	sharpe := Sharpe{
+		length:  length,
+		periods: periods,
+	}
+
+	if err := sharpe.validate(); err != nil {
+		return Sharpe{}, err
+	}
+
+	return sharpe, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (sharpe *Sharpe) validate() error {
+	if sharpe.length < 2 {
+		return &ConfigError{
+			Indicator: NameSharpe,
+			Field:     "length",
+			Value:     sharpe.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if sharpe.periods < 0 {
+		return &ConfigError{
+			Indicator: NameSharpe,
+			Field:     "periods",
+			Value:     sharpe.periods,
+			Err:       errors.New("invalid periods"),
+		}
+	}
+
+	sharpe.valid = true
+
+	return nil
+}
+
+// Calc calculates Sharpe from the provided data points slice. The
+// average return is divided by the standard deviation of returns, the
+// risk-free rate is assumed to be zero.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/s/sharperatio.asp.
+// All credits are due to William F. Sharpe who developed Sharpe ratio.
+func (sharpe Sharpe) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !sharpe.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != sharpe.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	rr := returns(dd)
+
+	return annualRatio(rr, sdev(rr), sharpe.periods), nil
+}
+
+// Count determines the total amount of data points needed for Sharpe
+// calculation.
+func (sharpe Sharpe) Count() int {
+	return sharpe.length + 1
+}
+
+// Describe returns structured information about Sharpe and its output.
+func (sharpe Sharpe) Describe() Description {
+	return Description{
+		Name:  NameSharpe,
+		Input: InputClose,
+	}
+}
+
+// Skew holds all the necessary information needed to calculate
+// rolling skewness.
+// The zero value is not usable.
+type Skew struct {
+	// valid specifies whether Skew paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewSkew validates provided configuration options and
+// creates new Skew indicator instance.
+func NewSkew(length int) (Skew, error) {
+	skew := Skew{length: length}
+
+	if err := skew.validate(); err != nil {
+		return Skew{}, err
+	}
+
+	return skew, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (skew *Skew) validate() error {
+	if skew.length < 2 {
+		return &ConfigError{
+			Indicator: NameSkew,
+			Field:     "length",
+			Value:     skew.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	skew.valid = true
+
+	return nil
+}
+
+// Calc calculates Skew from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/s/skewness.asp.
+func (skew Skew) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !skew.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != skew.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	m2, m3, _ := moments(dd)
+
+	if m2.Equal(decimal.Zero) {
+		return decimal.Zero, nil
+	}
+
+	return m3.Div(m2.Mul(sqrt(m2))), nil
+}
+
+// Count determines the total amount of data points needed for Skew
+// calculation.
+func (skew Skew) Count() int {
+	return skew.length
+}
+
+// Describe returns structured information about Skew and its output.
+func (skew Skew) Describe() Description {
+	return Description{
+		Name:  NameSkew,
+		Input: InputClose,
+	}
+}
+
+// SMA holds all the necessary information needed to calculate simple
+// moving average.
+// The zero value is not usable.
+type SMA struct {
+	// valid specifies whether SMA paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewSMA validates provided configuration options and
+// creates new SMA indicator.
+func NewSMA(length int) (SMA, error) {
+	sma := SMA{
+		length: length,
+	}
+
+	if err := sma.validate(); err != nil {
+		return SMA{}, err
+	}
+
+	return sma, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (sma *SMA) validate() error {
+	if sma.length < 1 {
+		return &ConfigError{
+			Indicator: NameSMA,
+			Field:     "length",
+			Value:     sma.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	sma.valid = true
+
+	return nil
+}
+
+// Calc calculates SMA from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/s/sma.asp.
+func (sma SMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !sma.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != sma.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	res := decimal.Zero
+	for i := 0; i < len(dd); i++ {
+		res = res.Add(dd[i])
+	}
+
+	return res.Div(decimal.NewFromInt(int64(sma.length))), nil
+}
+
+// Count determines the total amount of data points needed for SMA
+// calculation.
+func (sma SMA) Count() int {
+	return sma.length
+}
+
+// Describe returns structured information about SMA and its output.
+func (sma SMA) Describe() Description {
+	return Description{
+		Name:    NameSMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.NewFromInt(int64(sma.length - 1)).Div(decimal.NewFromInt(2)),
+	}
+}
+
+// SMI holds all the necessary information needed to calculate stochastic
+// momentum index.
+// The zero value is not usable.
+type SMI struct {
+	// valid specifies whether SMI paremeters were validated.
+	valid bool
+
+	// length specifies how many candles should be used to determine
+	// the high/low range.
+	length int
+
+	// ema1 specifies the first smoothing pass.
+	ema1 EMA
+
+	// ema2 specifies the second smoothing pass.
+	ema2 EMA
+
+	// signal specifies the moving average of the signal line.
+	signal EMA
+}
+
+// SMILines holds all lines calculated by SMI.
+type SMILines struct {
+	// SMI specifies the stochastic momentum index line.
+	SMI decimal.Decimal `json:"smi"`
+
+	// Signal specifies the moving average of the SMI line.
+	Signal decimal.Decimal `json:"signal"`
+}
+
+// NewSMI validates provided configuration options and
+// creates new SMI indicator instance.
+// Commonly used values are 10, 3, 3 and 10.
+func NewSMI(length, first, second, signal int) (SMI, error) {
+	ema1, err := NewEMA(first)
+	if err != nil {
+		return SMI{}, fieldError(err, NameSMI, "first")
+	}
+
+	ema2, err := NewEMA(second)
+	if err != nil {
+		return SMI{}, fieldError(err, NameSMI, "second")
+	}
+
+	sig, err := NewEMA(signal)
+	if err != nil {
+		return SMI{}, fieldError(err, NameSMI, "signal")
+	}
+
+	smi := SMI{
+		length: length,
+		ema1:   ema1,
+		ema2:   ema2,
+		signal: sig,
+	}
+
+	if err := smi.validate(); err != nil {
+		return SMI{}, err
+	}
+
+	return smi, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (smi *SMI) validate() error {
+	if smi.length < 1 {
+		return &ConfigError{
+			Indicator: NameSMI,
+			Field:     "length",
+			Value:     smi.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	smi.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates SMI line from the provided candles slice.
+// Calculation is based on formula provided by tradingview.
+// https://www.tradingview.com/script/HLbHbz7p-Stochastic-Momentum-Index/.
+// All credits are due to William Blau who developed SMI indicator.
+func (smi SMI) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	ll, err := smi.CalcLines(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return ll.SMI, nil
+}
+
+// CalcLines calculates SMI and signal lines from the provided candles
+// slice.
+func (smi SMI) CalcLines(cc []Candle) (SMILines, error) {
+	if !smi.valid {
+		return SMILines{}, ErrInvalidIndicator
+	}
+
+	if len(cc) != smi.Count() {
+		return SMILines{}, ErrInvalidDataSize
+	}
+
+	dist := make([]decimal.Decimal, len(cc)-smi.length+1)
+	rng := make([]decimal.Decimal, len(dist))
+
+	for i := range dist {
+		high, low := extremes(cc[i : i+smi.length])
+		dist[i] = cc[i+smi.length-1].Close.Sub(high.Add(low).Div(decimal.NewFromInt(2)))
+		rng[i] = high.Sub(low)
+	}
+
+	dist, err := smi.smooth(dist)
+	if err != nil {
+		// unlikely to happen
+		return SMILines{}, err
+	}
+
+	rng, err = smi.smooth(rng)
+	if err != nil {
+		// unlikely to happen
+		return SMILines{}, err
+	}
+
+	ll := make([]decimal.Decimal, len(dist))
+
+	for i := range ll {
+		if rng[i].Equal(decimal.Zero) {
+			ll[i] = decimal.Zero
+			continue
+		}
+
+		ll[i] = dist[i].Div(rng[i]).Mul(decimal.NewFromInt(200))
+	}
+
+	signal, err := smi.signal.series(ll)
+	if err != nil {
+		// unlikely to happen
+		return SMILines{}, err
+	}
+
+	return SMILines{
+		SMI:    ll[len(ll)-1],
+		Signal: signal[len(signal)-1],
+	}, nil
+}
+
+// smooth applies both smoothing passes to the provided values.
+func (smi SMI) smooth(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	res, err := smi.ema1.series(dd)
+	if err != nil {
+		return nil, err
+	}
+
+	return smi.ema2.series(res)
+}
+
+// Count determines the total amount of candles needed for SMI
+// calculation. Every smoothing pass needs length values to be seeded,
+// while the signal line is additionally warmed up the same way as EMA.
+func (smi SMI) Count() int {
+	return smi.length + smi.ema1.sma.length + smi.ema2.sma.length +
+		smi.signal.sma.length*2 - 4
+}
+
+// Describe returns structured information about SMI and its output.
+func (smi SMI) Describe() Description {
+	return Description{
+		Name:    NameSMI,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     _hundred.Neg(),
+		Max:     _hundred,
+	}
+}
+
+// SMMA holds all the necessary information needed to calculate smoothed
+// moving average, also known as Wilder's moving average.
+// The zero value is not usable.
+type SMMA struct {
+	// valid specifies whether SMMA paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewSMMA validates provided configuration options and
+// creates new SMMA indicator instance.
+func NewSMMA(length int) (SMMA, error) {
+	smma := SMMA{
+		length: length,
+	}
+
+	if err := smma.validate(); err != nil {
+		return SMMA{}, err
+	}
+
+	return smma, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (smma *SMMA) validate() error {
+	if smma.length < 1 {
+		return &ConfigError{
+			Indicator: NameSMMA,
+			Field:     "length",
+			Value:     smma.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	smma.valid = true
+
+	return nil
+}
+
+// Calc calculates SMMA from the provided data points slice.
+// Calculation is based on formula provided by tradingview.
+// https://www.tradingview.com/pine-script-reference/v5/#fun_ta.rma.
+func (smma SMMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !smma.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != smma.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	return SmoothingWilder.calc(dd, smma.length)
+}
+
+// CalcNext calculates sequential SMMA by using previous SMMA.
+func (smma SMMA) CalcNext(lres, dec decimal.Decimal) (decimal.Decimal, error) {
+	if !smma.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	length := decimal.NewFromInt(int64(smma.length))
+
+	return lres.Mul(length.Sub(decimal.NewFromInt(1))).Add(dec).Div(length), nil
+}
+
+// Count determines the total amount of data points needed for SMMA
+// calculation.
+func (smma SMMA) Count() int {
+	return SmoothingWilder.count(smma.length)
+}
+
+// Describe returns structured information about SMMA and its output.
+func (smma SMMA) Describe() Description {
+	return Description{
+		Name:    NameSMMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.NewFromInt(int64(smma.length - 1)),
+	}
+}
+
+// Sortino holds all the necessary information needed to calculate
+// rolling Sortino ratio.
+// The zero value is not usable.
+type Sortino struct {
+	// valid specifies whether Sortino paremeters were validated.
+	valid bool
+
+	// length specifies how many returns should be used during the
+	// calculations.
+	length int
+
+	// periods specifies how many data points make up a year. Zero means
+	// that the ratio is not annualized.
+	periods int
+}
+
+// NewSortino validates provided configuration options and
+// creates new Sortino indicator instance.
+// Commonly used amount of periods is 252 for daily data points.
+func NewSortino(length, periods int) (Sortino, error) {
+	sortino := Sortino{
+		length:  length,
+		periods: periods,
+	}
+
+	if err := sortino.validate(); err != nil {
+		return Sortino{}, err
+	}
+
+	return sortino, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (sortino *Sortino) validate() error {
+	if sortino.length < 2 {
+		return &ConfigError{
+			Indicator: NameSortino,
+			Field:     "length",
+			Value:     sortino.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if sortino.periods < 0 {
+		return &ConfigError{
+			Indicator: NameSortino,
+			Field:     "periods",
+			Value:     sortino.periods,
+			Err:       errors.New("invalid periods"),
+		}
+	}
+
+	sortino.valid = true
+
+	return nil
+}
+
+// Calc calculates Sortino from the provided data points slice. The
+// average return is divided by the downside deviation of returns, the
+// risk-free rate is assumed to be zero.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/s/sortinoratio.asp.
+// All credits are due to Frank A. Sortino who developed Sortino ratio.
+func (sortino Sortino) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !sortino.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != sortino.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	rr := returns(dd)
+	down := decimal.Zero
+
+	for i := range rr {
+		if rr[i].LessThan(decimal.Zero) {
+			down = down.Add(rr[i].Mul(rr[i]))
+		}
+	}
+
+	down = sqrt(down.Div(decimal.NewFromInt(int64(len(rr)))))
+
+	return annualRatio(rr, down, sortino.periods), nil
+}
+
+// Count determines the total amount of data points needed for Sortino
+// calculation.
+func (sortino Sortino) Count() int {
+	return sortino.length + 1
+}
+
+// Describe returns structured information about Sortino and its output.
+func (sortino Sortino) Describe() Description {
+	return Description{
+		Name:  NameSortino,
+		Input: InputClose,
+	}
+}
+
+// Source holds all the necessary information needed to select a price
+// of candles, which is then fed into another indicator, just like the
+// source setting of charting platforms.
+// The zero value is not usable.
+type Source struct {
+	// valid specifies whether Source paremeters were validated.
+	valid bool
+
+	// price specifies which price of candles should be used.
+	price Price
+
+	// ind specifies the optional indicator that is calculated from the
+	// prices. Nil means that the price of the newest candle is returned.
+	ind Indicator
+}
+
+// NewSource validates provided configuration options and
+// creates new Source indicator instance.
+// Provided indicator is calculated from the prices selected by the price
+// instead of the close prices; nil returns the newest price itself.
+func NewSource(price Price, ind Indicator) (Source, error) {
+	src := Source{
+		price: price,
+		ind:   ind,
+	}
+
+	if err := src.validate(); err != nil {
+		return Source{}, err
+	}
+
+	return src, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (src *Source) validate() error {
+	if err := src.price.Validate(); err != nil {
+		return &ConfigError{
+			Indicator: NameSource,
+			Field:     "price",
+			Value:     src.price,
+			Err:       err,
+		}
+	}
+
+	src.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates Source from the provided candles slice.
+func (src Source) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !src.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != src.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	return calcPrices(cc, src.price.of, src.ind)
+}
+
+// Count determines the total amount of candles needed for Source
+// calculation.
+func (src Source) Count() int {
+	if src.ind == nil {
+		return 1
+	}
+
+	return src.ind.Count()
+}
+
+// Describe returns structured information about Source and its output.
+func (src Source) Describe() Description {
+	return describePrices(NameSource, src.ind)
+}
+
+// SRSI holds all the necessary information needed to calculate stoch
+// relative strength index.
+// The zero value is not usable.
+type SRSI struct {
+	// valid specifies whether SRSI paremeters were validated.
+	valid bool
+
+	// rsi specifies the base relative strength index.
+	rsi RSI
+}
+
+// NewSRSI validates provided configuration options and
+// creates new SRSI indicator.
+func NewSRSI(length int) (SRSI, error) {
+	rsi, err := NewRSI(length, SmoothingSMA)
+	if err != nil {
+		return SRSI{}, fieldError(err, NameSRSI, "length")
+	}
+
+	return SRSI{
+		valid: true,
+		rsi:   rsi,
+	}, nil
+}
+
+// Calc calculates SRSI from the provided data slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/s/stochrsi.asp.
+func (srsi SRSI) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !srsi.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != srsi.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	res := make([]decimal.Decimal, srsi.rsi.length)
+
+	var err error
+	for i := 0; i < srsi.rsi.length; i++ {
+		res[i], err = srsi.rsi.Calc(dd[i : srsi.rsi.length+i])
+		if err != nil {
+			// unlikely to happen
+			return decimal.Zero, err
+		}
+	}
+
+	curr := res[0]
+	max := res[0]
+	min := res[0]
+
+	for i := 1; i < len(res); i++ {
+		if max.LessThan(res[i]) {
+			max = res[i]
+		}
+
+		if min.GreaterThan(res[i]) {
+			min = res[i]
+		}
+	}
+
+	if max.Equal(min) {
+		return decimal.Zero, nil
+	}
+
+	return curr.Sub(min).Div(max.Sub(min)), nil
+}
+
+// Count determines the total amount of data needed for SRSI
+// calculation.
+func (srsi SRSI) Count() int {
+	return srsi.rsi.length*2 - 1
+}
+
+// Describe returns structured information about SRSI and its output.
+func (srsi SRSI) Describe() Description {
+	return Description{
+		Name:    NameSRSI,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _one,
+	}
+}
+
+// Stoch holds all the necessary information needed to calculate stochastic
+// oscillator.
+// The zero value is not usable.
+type Stoch struct {
+	// valid specifies whether Stoch paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewStoch validates provided configuration options and
+// creates new Stoch indicator.
+func NewStoch(length int) (Stoch, error) {
+	stoch := Stoch{
+		length: length,
+	}
+
+	if err := stoch.validate(); err != nil {
+		return Stoch{}, err
+	}
+
+	return stoch, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (stoch *Stoch) validate() error {
+	if stoch.length < 1 {
+		return &ConfigError{
+			Indicator: NameStoch,
+			Field:     "length",
+			Value:     stoch.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	stoch.valid = true
+
+	return nil
+}
+
+// Calc calculates Stoch from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/s/stochasticoscillator.asp.
+func (stoch Stoch) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !stoch.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != stoch.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	low := dd[0]
+	high := dd[0]
+
+	for i := 0; i < len(dd); i++ {
+		if dd[i].LessThan(low) {
+			low = dd[i]
+		}
+
+		if dd[i].GreaterThan(high) {
+			high = dd[i]
+		}
+	}
+
+	dnm := high.Sub(low)
+	if dnm.Equal(decimal.Zero) {
+		return decimal.Zero, nil
+	}
+
+	return dd[len(dd)-1].Sub(low).Div(dnm).Mul(_hundred), nil
+}
+
+// CalcCandles calculates Stoch from the provided candles slice. Unlike
+// Calc, the newest close price is compared with the highest high and
+// the lowest low prices of the candles.
+func (stoch Stoch) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !stoch.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != stoch.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	high, low := extremes(cc)
+
+	dnm := high.Sub(low)
+	if dnm.Equal(decimal.Zero) {
+		return decimal.Zero, nil
+	}
+
+	return cc[len(cc)-1].Close.Sub(low).Div(dnm).Mul(_hundred), nil
+}
+
+// Count determines the total amount of data points needed for Stoch
+// calculation.
+func (stoch Stoch) Count() int {
+	return stoch.length
+}
+
+// Describe returns structured information about Stoch and its output.
+func (stoch Stoch) Describe() Description {
+	return Description{
+		Name:    NameStoch,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}
+}
+
+// StochFull holds all the necessary information needed to calculate
+// full stochastic oscillator, i.e. the smoothed %K line and its %D signal
+// line.
+// The zero value is not usable.
+type StochFull struct {
+	// valid specifies whether StochFull paremeters were validated.
+	valid bool
+
+	// stoch specifies the indicator that calculates raw %K values.
+	stoch Stoch
+
+	// smoothing specifies the moving average applied to raw %K values.
+	smoothing Indicator
+
+	// signal specifies the moving average applied to smoothed %K
+	// values.
+	signal Indicator
+}
+
+// StochFullLines holds all lines calculated by StochFull.
+type StochFullLines struct {
+	// K specifies the smoothed %K line.
+	K decimal.Decimal `json:"k"`
+
+	// D specifies the %D signal line.
+	D decimal.Decimal `json:"d"`
+}
+
+// NewStochFull validates provided configuration options and
+// creates new StochFull indicator instance.
+// If smoothing or signal indicators are nil, SMA with length of 3 is used
+// instead. SMA with length of 1 as the smoothing indicator results in
+// fast stochastic oscillator.
+func NewStochFull(length int, smoothing, signal Indicator) (StochFull, error) {
+	if smoothing == nil {
+		smoothing = SMA{valid: true, length: 3}
+	}
+
+	if signal == nil {
+		signal = SMA{valid: true, length: 3}
+	}
+
+	sf := StochFull{
+		stoch:     Stoch{length: length},
+		smoothing: smoothing,
+		signal:    signal,
+	}
+
+	if err := sf.validate(); err != nil {
+		return StochFull{}, err
+	}
+
+	return sf, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (sf *StochFull) validate() error {
+	if sf.smoothing == nil {
+		return &ConfigError{
+			Indicator: NameStochFull,
+			Field:     "smoothing",
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	if sf.signal == nil {
+		return &ConfigError{
+			Indicator: NameStochFull,
+			Field:     "signal",
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	if err := sf.stoch.validate(); err != nil {
+		return fieldError(err, NameStochFull, "")
+	}
+
+	sf.valid = true
+
+	return nil
+}
+
+// Calc calculates smoothed %K line from the provided data points slice.
+// Use CalcLines to get %D signal line as well.
+func (sf StochFull) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !sf.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != sf.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	kk := make([]decimal.Decimal, len(dd)-sf.stoch.Count()+1)
+
+	for i := range kk {
+		var err error
+
+		kk[i], err = sf.stoch.Calc(dd[i : i+sf.stoch.Count()])
+		if err != nil {
+			// unlikely to happen
+			return decimal.Zero, err
+		}
+	}
+
+	ll, err := sf.lines(kk)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return ll.K, nil
+}
+
+// CalcCandles calculates smoothed %K line from the provided candles
+// slice. Use CalcLines to get %D signal line as well.
+func (sf StochFull) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	ll, err := sf.CalcLines(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return ll.K, nil
+}
+
+// CalcLines calculates smoothed %K and %D signal lines from the provided
+// candles slice. Raw %K values are calculated the same way as by
+// Stoch's CalcCandles.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/s/stochasticoscillator.asp.
+func (sf StochFull) CalcLines(cc []Candle) (StochFullLines, error) {
+	if !sf.valid {
+		return StochFullLines{}, ErrInvalidIndicator
+	}
+
+	if len(cc) != sf.Count() {
+		return StochFullLines{}, ErrInvalidDataSize
+	}
+
+	kk := make([]decimal.Decimal, len(cc)-sf.stoch.Count()+1)
+
+	for i := range kk {
+		var err error
+
+		kk[i], err = sf.stoch.CalcCandles(cc[i : i+sf.stoch.Count()])
+		if err != nil {
+			// unlikely to happen
+			return StochFullLines{}, err
+		}
+	}
+
+	return sf.lines(kk)
+}
+
+// lines smooths the provided raw %K values and calculates %D signal
+// line from them.
+func (sf StochFull) lines(kk []decimal.Decimal) (StochFullLines, error) {
+	kk, err := CalcAll(sf.smoothing, kk)
+	if err != nil {
+		return StochFullLines{}, err
+	}
+
+	d, err := sf.signal.Calc(kk)
+	if err != nil {
+		return StochFullLines{}, err
+	}
+
+	return StochFullLines{
+		K: kk[len(kk)-1],
+		D: d,
+	}, nil
+}
+
+// Count determines the total amount of data points needed for StochFull
+// calculation.
+func (sf StochFull) Count() int {
+	return sf.stoch.Count() + sf.smoothing.Count() + sf.signal.Count() - 2
+}
+
+// Describe returns structured information about StochFull and its output.
+func (sf StochFull) Describe() Description {
+	return Description{
+		Name:    NameStochFull,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}
+}
+
+// StochOf holds all the necessary information needed to calculate
+// stochastic oscillator of another indicator's output.
+// The zero value is not usable.
+type StochOf struct {
+	// valid specifies whether StochOf paremeters were validated.
+	valid bool
+
+	// source specifies the indicator which output should be used
+	// as the stochastic oscillator's input.
+	source Indicator
+
+	// length specifies how many source indicator values should be used
+	// to calculate a single raw stochastic value.
+	length int
+
+	// smoothing specifies how many raw stochastic values should be
+	// averaged. 1 means that no smoothing is applied.
+	smoothing int
+}
+
+// NewStochOf validates provided configuration options and
+// creates new StochOf indicator instance.
+func NewStochOf(source Indicator, length, smoothing int) (StochOf, error) {
+	so := StochOf{
+		source:    source,
+		length:    length,
+		smoothing: smoothing,
+	}
+
+	if err := so.validate(); err != nil {
+		return StochOf{}, err
+	}
+
+	return so, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (so *StochOf) validate() error {
+	if so.source == nil {
+		return &ConfigError{
+			Indicator: NameStochOf,
+			Field:     "source",
+			Value:     so.source,
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	if so.length < 1 {
+		return &ConfigError{
+			Indicator: NameStochOf,
+			Field:     "length",
+			Value:     so.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if so.smoothing < 1 {
+		return &ConfigError{
+			Indicator: NameStochOf,
+			Field:     "smoothing",
+			Value:     so.smoothing,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	so.valid = true
+
+	return nil
+}
+
+// Calc calculates the source indicator values from the provided data
+// points slice, applies the stochastic formula to every window of them
+// and returns the simple average of the newest raw stochastic values.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/s/stochasticoscillator.asp.
+func (so StochOf) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !so.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != so.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	vv := make([]decimal.Decimal, so.length+so.smoothing-1)
+
+	for i := range vv {
+		var err error
+
+		vv[i], err = so.source.Calc(dd[i : i+so.source.Count()])
+		if err != nil {
+			return decimal.Zero, err
+		}
+	}
+
+	kk := make([]decimal.Decimal, so.smoothing)
+
+	for i := range kk {
+		w := vv[i : i+so.length]
+		low, high := w[0], w[0]
+
+		for j := 1; j < len(w); j++ {
+			low = decimal.Min(low, w[j])
+			high = decimal.Max(high, w[j])
+		}
+
+		kk[i] = decimal.Zero
+
+		if dnm := high.Sub(low); !dnm.Equal(decimal.Zero) {
+			kk[i] = w[len(w)-1].Sub(low).Div(dnm).Mul(_hundred)
+		}
+	}
+
+	return avg(kk), nil
+}
+
+// Count determines the total amount of data points needed for StochOf
+// calculation.
+func (so StochOf) Count() int {
+	return so.source.Count() + so.length + so.smoothing - 2
+}
+
+// Describe returns structured information about StochOf and its output.
+func (so StochOf) Describe() Description {
+	return Description{
+		Name:    NameStochOf,
+		Input:   describe(so.source).Input,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}
+}
+
+// SuperSmoother holds all the necessary information needed to calculate
+// Ehlers' two-pole SuperSmoother filter.
+// The zero value is not usable.
+type SuperSmoother struct {
+	// valid specifies whether SuperSmoother paremeters were validated.
+	valid bool
+
+	// length specifies the cutoff period of the filter.
+	length int
+}
+
+// NewSuperSmoother validates provided configuration options and
+// creates new SuperSmoother indicator instance.
+func NewSuperSmoother(length int) (SuperSmoother, error) {
+	ss := SuperSmoother{length: length}
+
+	if err := ss.validate(); err != nil {
+		return SuperSmoother{}, err
+	}
+
+	return ss, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (ss *SuperSmoother) validate() error {
+	if ss.length < 2 {
+		return &ConfigError{
+			Indicator: NameSuperSmoother,
+			Field:     "length",
+			Value:     ss.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	ss.valid = true
+
+	return nil
+}
+
+// Calc calculates SuperSmoother from the provided data points slice.
+// The filter is seeded with the oldest data points and warmed up over the
+// rest of them.
+// Calculation is based on formula provided by John F. Ehlers in the
+// Cycle Analytics for Traders book.
+func (ss SuperSmoother) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !ss.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != ss.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	a := math.Exp(-math.Sqrt2 * math.Pi / float64(ss.length))
+	c2 := decimal.NewFromFloat(2 * a * math.Cos(math.Sqrt2*math.Pi/float64(ss.length)))
+	c3 := decimal.NewFromFloat(-a * a)
+	c1 := _one.Sub(c2).Sub(c3)
+
+	prev2, prev1 := dd[0], dd[1]
+
+	for i := 2; i < len(dd); i++ {
+		res := c1.Mul(dd[i].Add(dd[i-1])).Div(decimal.NewFromInt(2)).
+			Add(c2.Mul(prev1)).
+			Add(c3.Mul(prev2))
+
+		prev2, prev1 = prev1, res
+	}
+
+	return prev1, nil
+}
+
+// Count determines the total amount of data points needed for SuperSmoother
+// calculation.
+func (ss SuperSmoother) Count() int {
+	return ss.length*2 - 1
+}
+
+// Describe returns structured information about SuperSmoother and its output.
+func (ss SuperSmoother) Describe() Description {
+	return Description{
+		Name:    NameSuperSmoother,
+		Input:   InputClose,
+		Overlay: true,
+	}
+}
+
+// T3 holds all the necessary information needed to calculate Tillson T3
+// moving average.
+// The zero value is not usable.
+type T3 struct {
+	// valid specifies whether T3 paremeters were validated.
+	valid bool
+
+	// ema specifies what ema should be used for t3 calculations.
+	ema EMA
+
+	// vfactor specifies the volume factor which controls how much the
+	// moving average reacts to price changes.
+	// default is 0.7.
+	vfactor decimal.Decimal
+}
+
+// NewT3 validates provided configuration options and creates
+// new T3 indicator.
+// If provided volume factor is zero, default value is going to be used
+// (0.7).
+func NewT3(length int, vfactor decimal.Decimal) (T3, error) {
+	if vfactor.Equal(decimal.Zero) {
+		vfactor = decimal.RequireFromString("0.7")
+	}
+
+	ema, err := NewEMA(length)
+	if err != nil {
+		return T3{}, fieldError(err, NameT3, "length")
+	}
+
+	t3 := T3{
+		ema:     ema,
+		vfactor: vfactor,
+	}
+
+	if err := t3.validate(); err != nil {
+		return T3{}, err
+	}
+
+	return t3, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (t3 *T3) validate() error {
+	if t3.vfactor.LessThan(decimal.Zero) || t3.vfactor.GreaterThan(_one) {
+		return &ConfigError{
+			Indicator: NameT3,
+			Field:     "vfactor",
+			Value:     t3.vfactor,
+			Err:       ErrInvalidFactor,
+		}
+	}
+
+	t3.valid = true
+
+	return nil
+}
+
+// Calc calculates T3 from the provided data points slice.
+// Calculation is based on formula provided by tradingview.
+// https://www.tradingview.com/support/solutions/43000591346-t3/.
+// All credits are due to Tim Tillson who developed T3 indicator.
+func (t3 T3) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !t3.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != t3.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	ee := make([][]decimal.Decimal, 6)
+
+	src := dd
+
+	for i := range ee {
+		var err error
+
+		ee[i], err = t3.ema.series(src)
+		if err != nil {
+			// unlikely to happen
+			return decimal.Zero, err
+		}
+
+		src = ee[i]
+	}
+
+	v := t3.vfactor
+	v2 := v.Mul(v)
+	v3 := v2.Mul(v)
+	three := decimal.NewFromInt(3)
+
+	cc := []decimal.Decimal{
+		_one.Add(v.Mul(three)).Add(v2.Mul(three)).Add(v3),
+		v2.Mul(decimal.NewFromInt(-6)).Sub(v.Mul(three)).Sub(v3.Mul(three)),
+		v2.Mul(three).Add(v3.Mul(three)),
+		v3.Neg(),
+	}
+
+	res := decimal.Zero
+
+	for i := range cc {
+		e := ee[i+2]
+		res = res.Add(cc[i].Mul(e[len(e)-1]))
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of data points needed for T3
+// calculation. Every smoothing pass needs length data points to be
+// seeded, while the last one is additionally warmed up the same way
+// as EMA.
+func (t3 T3) Count() int {
+	return t3.ema.sma.length*7 - 6
+}
+
+// Describe returns structured information about T3 and its output.
+func (t3 T3) Describe() Description {
+	return Description{
+		Name:    NameT3,
+		Input:   InputClose,
+		Overlay: true,
+	}
+}
+
+// TDSequential holds all the necessary information needed to calculate
+// TD Sequential setup and countdown counts.
+// The zero value is not usable.
+type TDSequential struct {
+	// valid specifies whether TDSequential paremeters were validated.
+	valid bool
+
+	// length specifies how many candles should be scanned
+	// during the calculations.
+	length int
+}
+
+// TDSequentialLines holds all values calculated by TDSequential.
+type TDSequentialLines struct {
+	// Setup specifies the current setup count, from 1 to 9. It is
+	// positive for a sell setup and negative for a buy setup.
+	Setup int `json:"setup"`
+
+	// Countdown specifies the current countdown count, from 1 to 13.
+	// It is positive for a sell countdown and negative for a buy
+	// countdown.
+	Countdown int `json:"countdown"`
+
+	// Perfected specifies whether the setup was completed on the
+	// newest candle and is perfected.
+	Perfected bool `json:"perfected"`
+}
+
+// NewTDSequential validates provided configuration options and
+// creates new TDSequential indicator instance.
+func NewTDSequential(length int) (TDSequential, error) {
+	td := TDSequential{length: length}
+
+	if err := td.validate(); err != nil {
+		return TDSequential{}, err
+	}
+
+	return td, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (td *TDSequential) validate() error {
+	if td.length < 5 {
+		return &ConfigError{
+			Indicator: NameTDSequential,
+			Field:     "length",
+			Value:     td.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	td.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates TDSequential setup count from the provided
+// candles slice.
+// Calculation is based on formula provided by Jason Perl in the DeMark
+// Indicators book.
+// All credits are due to Tom DeMark who developed TDSequential
+// indicator.
+func (td TDSequential) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	ll, err := td.CalcLines(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return decimal.NewFromInt(int64(ll.Setup)), nil
+}
+
+// CalcLines calculates TDSequential setup and countdown counts of the
+// newest candle from the provided candles slice. The setup counts
+// consecutive closes above (sell) or below (buy) the close of four
+// candles earlier, the countdown starts once the setup reaches 9 and
+// counts closes above the high (sell) or below the low (buy) of two
+// candles earlier until it reaches 13.
+func (td TDSequential) CalcLines(cc []Candle) (TDSequentialLines, error) {
+	if !td.valid {
+		return TDSequentialLines{}, ErrInvalidIndicator
+	}
+
+	if len(cc) != td.Count() {
+		return TDSequentialLines{}, ErrInvalidDataSize
+	}
+
+	var (
+		res       TDSequentialLines
+		direction int
+		count     int
+	)
+
+	for i := 4; i < len(cc); i++ {
+		switch {
+		case cc[i].Close.GreaterThan(cc[i-4].Close):
+			if res.Setup <= 0 || res.Setup == 9 {
+				res.Setup = 0
+			}
+
+			res.Setup++
+		case cc[i].Close.LessThan(cc[i-4].Close):
+			if res.Setup >= 0 || res.Setup == -9 {
+				res.Setup = 0
+			}
+
+			res.Setup--
+		default:
+			res.Setup = 0
+		}
+
+		res.Perfected = false
+
+		switch {
+		case res.Setup == 9:
+			res.Perfected = decimal.Max(cc[i].High, cc[i-1].High).
+				GreaterThanOrEqual(decimal.Max(cc[i-2].High, cc[i-3].High))
+			direction, count = 1, 0
+		case res.Setup == -9:
+			res.Perfected = decimal.Min(cc[i].Low, cc[i-1].Low).
+				LessThanOrEqual(decimal.Min(cc[i-2].Low, cc[i-3].Low))
+			direction, count = -1, 0
+		case count == 13:
+			direction, count = 0, 0
+		}
+
+		if direction > 0 && cc[i].Close.GreaterThanOrEqual(cc[i-2].High) ||
+			direction < 0 && cc[i].Close.LessThanOrEqual(cc[i-2].Low) {
+			count++
+		}
+
+		res.Countdown = direction * count
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of candles needed for TDSequential
+// calculation.
+func (td TDSequential) Count() int {
+	return td.length
+}
+
+// Describe returns structured information about TDSequential and its
+// output.
+func (td TDSequential) Describe() Description {
+	return Description{
+		Name:    NameTDSequential,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.NewFromInt(-9),
+		Max:     decimal.NewFromInt(9),
+	}
+}
+
+// TEMA holds all the necessary information needed to calculate
+// triple exponential moving average.
+// The zero value is not usable.
+type TEMA struct {
+	// valid specifies whether TEMA paremeters were validated.
+	valid bool
+
+	// ema specifies what ema should be used for tema calculations.
+	ema EMA
+}
+
+// NewTEMA validates provided configuration options and creates
+// new TEMA indicator.
+func NewTEMA(length int) (TEMA, error) {
+	ema, err := NewEMA(length)
+	if err != nil {
+		return TEMA{}, fieldError(err, NameTEMA, "length")
+	}
+
+	return TEMA{
+		valid: true,
+		ema:   ema,
+	}, nil
+}
+
+// Calc calculates TEMA from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/t/triple-exponential-moving-average.asp.
+// All credits are due to Patrick Mulloy who developed TEMA indicator.
+func (tema TEMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !tema.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != tema.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	ema1, err := tema.ema.series(dd)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	ema2, err := tema.ema.series(ema1)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	ema3, err := tema.ema.series(ema2)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	three := decimal.NewFromInt(3)
+
+	return ema1[len(ema1)-1].Mul(three).
+		Sub(ema2[len(ema2)-1].Mul(three)).
+		Add(ema3[len(ema3)-1]), nil
+}
+
+// Count determines the total amount of data points needed for TEMA
+// calculation. Every smoothing pass needs length data points to be
+// seeded, while the last one is additionally warmed up the same way
+// as EMA.
+func (tema TEMA) Count() int {
+	return tema.ema.sma.length*4 - 3
+}
+
+// Describe returns structured information about TEMA and its output.
+func (tema TEMA) Describe() Description {
+	return Description{
+		Name:    NameTEMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.Zero,
+	}
+}
+
+// TSI holds all the necessary information needed to calculate true
+// strength index.
+// The zero value is not usable.
+type TSI struct {
+	// valid specifies whether TSI paremeters were validated.
+	valid bool
+
+	// long specifies the first smoothing pass.
+	long EMA
+
+	// short specifies the second smoothing pass.
+	short EMA
+
+	// signal specifies the moving average of the signal line.
+	// The zero value means that the signal line is not used.
+	signal EMA
+}
+
+// TSILines holds all lines calculated by TSI.
+type TSILines struct {
+	// TSI specifies the true strength index line.
+	TSI decimal.Decimal `json:"tsi"`
+
+	// Signal specifies the moving average of the TSI line. It is zero
+	// when the signal line is not used.
+	Signal decimal.Decimal `json:"signal"`
+}
+
+// NewTSI validates provided configuration options and
+// creates new TSI indicator instance.
+// If provided signal length is zero, the signal line is not used.
+// Commonly used values are 25, 13 and 13.
+func NewTSI(long, short, signal int) (TSI, error) {
+	ema1, err := NewEMA(long)
+	if err != nil {
+		return TSI{}, fieldError(err, NameTSI, "long")
+	}
+
+	ema2, err := NewEMA(short)
+	if err != nil {
+		return TSI{}, fieldError(err, NameTSI, "short")
+	}
+
+	var sig EMA
+
+	if signal != 0 {
+		sig, err = NewEMA(signal)
 		if err != nil {
-			// unlikely to happen
-			return decimal.Zero, err
+			return TSI{}, fieldError(err, NameTSI, "signal")
+		}
+	}
+
+	tsi := TSI{
+		long:   ema1,
+		short:  ema2,
+		signal: sig,
+	}
+
+	if err := tsi.validate(); err != nil {
+		// unlikely to happen
+		return TSI{}, err
+	}
+
+	return tsi, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (tsi *TSI) validate() error {
+	if !tsi.long.valid {
+		return &ConfigError{
+			Indicator: NameTSI,
+			Field:     "long",
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	if !tsi.short.valid {
+		return &ConfigError{
+			Indicator: NameTSI,
+			Field:     "short",
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	if tsi.signal != (EMA{}) && !tsi.signal.valid {
+		return &ConfigError{
+			Indicator: NameTSI,
+			Field:     "signal",
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	tsi.valid = true
+
+	return nil
+}
+
+// Calc calculates TSI line from the provided data points slice.
+// Calculation is based on formula provided by stockcharts.
+// https://school.stockcharts.com/doku.php?id=technical_indicators:true_strength_index.
+// All credits are due to William Blau who developed TSI indicator.
+func (tsi TSI) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	ll, err := tsi.CalcLines(dd)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return ll.TSI, nil
+}
+
+// CalcLines calculates TSI and signal lines from the provided data points
+// slice.
+func (tsi TSI) CalcLines(dd []decimal.Decimal) (TSILines, error) {
+	if !tsi.valid {
+		return TSILines{}, ErrInvalidIndicator
+	}
+
+	if len(dd) != tsi.Count() {
+		return TSILines{}, ErrInvalidDataSize
+	}
+
+	mom := make([]decimal.Decimal, len(dd)-1)
+	abs := make([]decimal.Decimal, len(mom))
+
+	for i := range mom {
+		mom[i] = dd[i+1].Sub(dd[i])
+		abs[i] = mom[i].Abs()
+	}
+
+	mom, err := tsi.smooth(mom)
+	if err != nil {
+		// unlikely to happen
+		return TSILines{}, err
+	}
+
+	abs, err = tsi.smooth(abs)
+	if err != nil {
+		// unlikely to happen
+		return TSILines{}, err
+	}
+
+	ll := make([]decimal.Decimal, len(mom))
+
+	for i := range ll {
+		if abs[i].Equal(decimal.Zero) {
+			ll[i] = decimal.Zero
+			continue
+		}
+
+		ll[i] = mom[i].Div(abs[i]).Mul(_hundred)
+	}
+
+	if !tsi.signal.valid {
+		return TSILines{
+			TSI:    ll[len(ll)-1],
+			Signal: decimal.Zero,
+		}, nil
+	}
+
+	signal, err := tsi.signal.series(ll)
+	if err != nil {
+		// unlikely to happen
+		return TSILines{}, err
+	}
+
+	return TSILines{
+		TSI:    ll[len(ll)-1],
+		Signal: signal[len(signal)-1],
+	}, nil
+}
+
+// smooth applies both smoothing passes to the provided values.
+func (tsi TSI) smooth(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	res, err := tsi.long.series(dd)
+	if err != nil {
+		return nil, err
+	}
+
+	return tsi.short.series(res)
+}
+
+// Count determines the total amount of data points needed for TSI
+// calculation. Every smoothing pass needs length values to be seeded,
+// while the signal line, if used, is additionally warmed up the same way
+// as EMA.
+func (tsi TSI) Count() int {
+	c := tsi.long.sma.length + tsi.short.sma.length
+
+	if tsi.signal.valid {
+		c += tsi.signal.sma.length*2 - 2
+	}
+
+	return c
+}
+
+// Describe returns structured information about TSI and its output.
+func (tsi TSI) Describe() Description {
+	return Description{
+		Name:    NameTSI,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     _hundred.Neg(),
+		Max:     _hundred,
+	}
+}
+
+// TTMSqueeze holds all the necessary information needed to calculate
+// TTM squeeze.
+// The zero value is not usable.
+type TTMSqueeze struct {
+	// valid specifies whether TTMSqueeze paremeters were validated.
+	valid bool
+
+	// length specifies how many candles should be used to calculate
+	// the bands, the channels and every momentum value.
+	length int
+
+	// bb specifies the multiplier of the standard deviation used by the
+	// Bollinger Bands.
+	// default is 2.
+	bb decimal.Decimal
+
+	// kc specifies the multiplier of the average true range used by the
+	// Keltner Channels.
+	// default is 1.5.
+	kc decimal.Decimal
+}
+
+// TTMSqueezeLines holds all values calculated by TTMSqueeze.
+type TTMSqueezeLines struct {
+	// On specifies whether the Bollinger Bands are inside of the Keltner
+	// Channels.
+	On bool `json:"on"`
+
+	// Momentum specifies the momentum histogram value.
+	Momentum decimal.Decimal `json:"momentum"`
+}
+
+// NewTTMSqueeze validates provided configuration options and
+// creates new TTMSqueeze indicator instance.
+// If provided multipliers are zero, default values are going to be used
+// (2 for the Bollinger Bands and 1.5 for the Keltner Channels).
+// Commonly used length is 20.
+func NewTTMSqueeze(length int, bb, kc decimal.Decimal) (TTMSqueeze, error) {
+	if bb.Equal(decimal.Zero) {
+		bb = decimal.NewFromInt(2)
+	}
+
+	if kc.Equal(decimal.Zero) {
+		kc = decimal.RequireFromString("1.5")
+	}
+
+	ttm := TTMSqueeze{
+		length: length,
+		bb:     bb,
+		kc:     kc,
+	}
+
+	if err := ttm.validate(); err != nil {
+		return TTMSqueeze{}, err
+	}
+
+	return ttm, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (ttm *TTMSqueeze) validate() error {
+	if ttm.length < 2 {
+		return &ConfigError{
+			Indicator: NameTTMSqueeze,
+			Field:     "length",
+			Value:     ttm.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if ttm.bb.LessThan(decimal.Zero) {
+		return &ConfigError{
+			Indicator: NameTTMSqueeze,
+			Field:     "bb",
+			Value:     ttm.bb,
+			Err:       errors.New("invalid multiplier"),
+		}
+	}
+
+	if ttm.kc.LessThan(decimal.Zero) {
+		return &ConfigError{
+			Indicator: NameTTMSqueeze,
+			Field:     "kc",
+			Value:     ttm.kc,
+			Err:       errors.New("invalid multiplier"),
+		}
+	}
+
+	ttm.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates TTMSqueeze momentum from the provided candles
+// slice.
+// Calculation is based on formula provided by tradingview.
+// https://www.tradingview.com/script/nqQ1DT5a-Squeeze-Momentum-Indicator-LazyBear/.
+// All credits are due to John Carter who developed TTMSqueeze indicator.
+func (ttm TTMSqueeze) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	ll, err := ttm.CalcLines(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return ll.Momentum, nil
+}
+
+// CalcLines calculates TTMSqueeze state and momentum from the provided
+// candles slice. The momentum is the linear regression value of the
+// distance between the close price and the average of the range
+// midpoint and SMA.
+func (ttm TTMSqueeze) CalcLines(cc []Candle) (TTMSqueezeLines, error) {
+	if !ttm.valid {
+		return TTMSqueezeLines{}, ErrInvalidIndicator
+	}
+
+	if len(cc) != ttm.Count() {
+		return TTMSqueezeLines{}, ErrInvalidDataSize
+	}
+
+	two := decimal.NewFromInt(2)
+	dist := make([]decimal.Decimal, ttm.length)
+
+	for i := range dist {
+		w := cc[i : i+ttm.length]
+		mid := midpoint(w).Add(avg(closes(w))).Div(two)
+		dist[i] = w[len(w)-1].Close.Sub(mid)
+	}
+
+	slope, intercept := linreg(dist)
+
+	w := cc[len(cc)-ttm.length:]
+	dd := closes(w)
+	ma := avg(dd)
+	dev := sdev(dd).Mul(ttm.bb)
+	rng := avg(trueRanges(cc[len(cc)-ttm.length-1:])).Mul(ttm.kc)
+
+	return TTMSqueezeLines{
+		On:       ma.Add(dev).LessThan(ma.Add(rng)) && ma.Sub(dev).GreaterThan(ma.Sub(rng)),
+		Momentum: intercept.Add(slope.Mul(decimal.NewFromInt(int64(ttm.length - 1)))),
+	}, nil
+}
+
+// Count determines the total amount of candles needed for TTMSqueeze
+// calculation.
+func (ttm TTMSqueeze) Count() int {
+	return ttm.length*2 - 1
+}
+
+// Describe returns structured information about TTMSqueeze and its
+// output.
+func (ttm TTMSqueeze) Describe() Description {
+	return Description{
+		Name:  NameTTMSqueeze,
+		Input: InputCandle,
+	}
+}
+
+// TypicalPrice holds all the necessary information needed to calculate
+// typical price, the average of the high, low and close
+// prices, of candles.
+// It is a shorthand for Source with PriceHLC3.
+// The zero value is not usable.
+type TypicalPrice struct {
+	// valid specifies whether TypicalPrice paremeters were validated.
+	valid bool
+
+	// src specifies the source of PriceHLC3 prices.
+	src Source
+}
+
+// NewTypicalPrice validates provided configuration options and
+// creates new TypicalPrice indicator instance.
+// Provided indicator is calculated from the typical prices, e.g. an SMA
+// of them is the middle line of the CCI.
+func NewTypicalPrice(ind Indicator) (TypicalPrice, error) {
+	src, err := NewSource(PriceHLC3, ind)
+	if err != nil {
+		// unlikely to happen
+		return TypicalPrice{}, err
+	}
+
+	tp := TypicalPrice{
+		src: src,
+	}
+
+	if err := tp.validate(); err != nil {
+		// unlikely to happen
+		return TypicalPrice{}, err
+	}
+
+	return tp, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (tp *TypicalPrice) validate() error {
+	if !tp.src.valid {
+		return &ConfigError{
+			Indicator: NameTypicalPrice,
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	tp.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates TypicalPrice from the provided candles slice.
+func (tp TypicalPrice) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !tp.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	return tp.src.CalcCandles(cc)
+}
+
+// Count determines the total amount of candles needed for TypicalPrice
+// calculation.
+func (tp TypicalPrice) Count() int {
+	return tp.src.Count()
+}
+
+// Describe returns structured information about TypicalPrice and its output.
+func (tp TypicalPrice) Describe() Description {
+	return describePrices(NameTypicalPrice, tp.src.ind)
+}
+
+// Ulcer holds all the necessary information needed to calculate
+// ulcer index, the root mean square of percentage drawdowns.
+// The zero value is not usable.
+type Ulcer struct {
+	// valid specifies whether Ulcer paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewUlcer validates provided configuration options and
+// creates new Ulcer indicator instance.
+func NewUlcer(length int) (Ulcer, error) {
+	ulcer := Ulcer{length: length}
+
+	if err := ulcer.validate(); err != nil {
+		return Ulcer{}, err
+	}
+
+	return ulcer, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (ulcer *Ulcer) validate() error {
+	if ulcer.length < 2 {
+		return &ConfigError{
+			Indicator: NameUlcer,
+			Field:     "length",
+			Value:     ulcer.length,
+			Err:       ErrInvalidLength,
 		}
 	}
 
-	res := pres[0]
+	ulcer.valid = true
 
-	for i := 0; i < len(pres); i++ {
-		res, err = dema.ema.CalcNext(res, pres[i])
-		if err != nil {
-			// unlikely to happen
-			return decimal.Zero, err
+	return nil
+}
+
+// Calc calculates Ulcer from the provided data points slice.
+// Every drawdown is measured from the highest value seen since the start
+// of the window.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/u/ulcerindex.asp.
+// All credits are due to Peter Martin who developed Ulcer indicator.
+func (ulcer Ulcer) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !ulcer.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != ulcer.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	high := dd[0]
+	sum := decimal.Zero
+
+	for i := range dd {
+		if dd[i].GreaterThan(high) {
+			high = dd[i]
+		}
+
+		if high.Equal(decimal.Zero) {
+			continue
 		}
+
+		pct := dd[i].Sub(high).Div(high).Mul(_hundred)
+		sum = sum.Add(pct.Mul(pct))
 	}
 
-	return res, nil
+	return sqrt(sum.Div(decimal.NewFromInt(int64(len(dd))))), nil
 }
 
-// Count determines the total amount of data points needed for DEMA
+// Count determines the total amount of data points needed for Ulcer
 // calculation.
-func (dema DEMA) Count() int {
-	return dema.ema.Count()
+func (ulcer Ulcer) Count() int {
+	return ulcer.length
 }
 
-// EMA holds all the necessary information needed to calculate exponential
-// moving average.
+// Describe returns structured information about Ulcer and its output.
+func (ulcer Ulcer) Describe() Description {
+	return Description{
+		Name:  NameUlcer,
+		Input: InputClose,
+	}
+}
+
+// VHF holds all the necessary information needed to calculate
+// vertical horizontal filter.
 // The zero value is not usable.
-type EMA struct {
-	// valid specifies whether DEMA paremeters were validated.
+type VHF struct {
+	// valid specifies whether VHF paremeters were validated.
 	valid bool
 
-	// sma specifies what sma should be used for ema calculations.
-	sma SMA
+	// length specifies how many price changes should be used
+	// during the calculations.
+	length int
 }
 
-// NewEMA validates provided configuration options and
-// creates new EMA indicator.
-func NewEMA(length int) (EMA, error) {
-	sma, err := NewSMA(length)
-	if err != nil {
-		return EMA{}, err
+// NewVHF validates provided configuration options and
+// creates new VHF indicator instance.
+func NewVHF(length int) (VHF, error) {
+	vhf := VHF{length: length}
+
+	if err := vhf.validate(); err != nil {
+		return VHF{}, err
 	}
 
-	return EMA{
-		valid: true,
-		sma:   sma,
-	}, nil
+	return vhf, nil
 }
 
-// Calc calculates EMA from the provided data points slice.
+// validate checks whether the indicator has valid configuration properties.
+func (vhf *VHF) validate() error {
+	if vhf.length < 1 {
+		return &ConfigError{
+			Indicator: NameVHF,
+			Field:     "length",
+			Value:     vhf.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	vhf.valid = true
+
+	return nil
+}
+
+// Calc calculates VHF from the provided data points slice.
+// The result is the range of the values divided by the sum of absolute
+// changes between them, higher values indicate a trending market.
 // Calculation is based on formula provided by investopedia.
-// https://www.investopedia.com/terms/e/ema.asp.
-func (ema EMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
-	if !ema.valid {
+// https://www.investopedia.com/terms/v/vertical-horizontal-filter.asp.
+// All credits are due to Adam White who developed VHF indicator.
+func (vhf VHF) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !vhf.valid {
 		return decimal.Zero, ErrInvalidIndicator
 	}
 
-	if len(dd) != ema.Count() {
+	if len(dd) != vhf.Count() {
 		return decimal.Zero, ErrInvalidDataSize
 	}
 
-	res, err := ema.sma.Calc(dd[:ema.sma.length])
-	if err != nil {
-		// unlikely to happen
-		return decimal.Zero, err
+	vol := decimal.Zero
+
+	for i := 1; i < len(dd); i++ {
+		vol = vol.Add(dd[i].Sub(dd[i-1]).Abs())
 	}
 
-	for i := ema.sma.length; i < len(dd); i++ {
-		res, err = ema.CalcNext(res, dd[i])
-		if err != nil {
-			// unlikely to happen
-			return decimal.Zero, err
-		}
+	if vol.Equal(decimal.Zero) {
+		return decimal.Zero, nil
 	}
 
-	return res, nil
+	high := decimal.Max(dd[0], dd[1:]...)
+	low := decimal.Min(dd[0], dd[1:]...)
+
+	return high.Sub(low).Div(vol), nil
 }
 
-// CalcNext calculates sequential EMA by using previous EMA.
-func (ema EMA) CalcNext(lres, dec decimal.Decimal) (decimal.Decimal, error) {
-	if !ema.valid {
-		return decimal.Zero, ErrInvalidIndicator
+// Count determines the total amount of data points needed for VHF
+// calculation.
+func (vhf VHF) Count() int {
+	return vhf.length + 1
+}
+
+// Describe returns structured information about VHF and its output.
+func (vhf VHF) Describe() Description {
+	return Description{
+		Name:    NameVHF,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _one,
 	}
+}
 
-	mtp := ema.multiplier()
+// VolumeProfile holds all the necessary information needed to calculate
+// the distribution of traded volume by price.
+// The zero value is not usable.
+type VolumeProfile struct {
+	// valid specifies whether VolumeProfile paremeters were validated.
+	valid bool
 
-	return dec.Mul(mtp).Add(lres.Mul(decimal.NewFromInt(1).Sub(mtp))), nil
+	// length specifies how many candles should be used
+	// during the calculations.
+	length int
+
+	// bins specifies into how many equal price ranges the volume should
+	// be distributed.
+	bins int
+
+	// area specifies the share of the total volume that the value area
+	// should contain.
+	// default is 0.7.
+	area decimal.Decimal
 }
 
-// multiplier calculates EMA multiplier.
-func (ema EMA) multiplier() decimal.Decimal {
-	return decimal.NewFromInt(2).Div(decimal.NewFromInt(int64(ema.sma.length) + 1))
+// Profile holds all values calculated by VolumeProfile.
+type Profile struct {
+	// POC specifies the point of control, which is the middle price of
+	// the bin with the highest volume.
+	POC decimal.Decimal `json:"poc"`
+
+	// VAH specifies the highest price of the value area.
+	VAH decimal.Decimal `json:"vah"`
+
+	// VAL specifies the lowest price of the value area.
+	VAL decimal.Decimal `json:"val"`
+
+	// Histogram specifies all bins ordered from the lowest to the
+	// highest price.
+	Histogram []ProfileBin `json:"histogram"`
 }
 
-// Count determines the total amount of data points needed for EMA
-// calculation.
-func (ema EMA) Count() int {
-	return ema.sma.length*2 - 1
+// ProfileBin holds the volume traded within a price range.
+type ProfileBin struct {
+	// Low specifies the lowest price of the range.
+	Low decimal.Decimal `json:"low"`
+
+	// High specifies the highest price of the range.
+	High decimal.Decimal `json:"high"`
+
+	// Volume specifies the volume traded within the range.
+	Volume decimal.Decimal `json:"volume"`
 }
 
-// HMA holds all the necessary information needed to calculate
-// hull moving average.
-// The zero value is not usable.
-type HMA struct {
-	// valid specifies whether HMA paremeters were validated.
-	valid bool
+// NewVolumeProfile validates provided configuration options and
+// creates new VolumeProfile instance.
+// If provided area is zero, default value is going to be used (0.7).
+func NewVolumeProfile(length, bins int, area decimal.Decimal) (VolumeProfile, error) {
+	if area.Equal(decimal.Zero) {
+		area = decimal.RequireFromString("0.7")
+	}
 
-	// wma specifies the base moving average.
-	wma WMA
+	vp := VolumeProfile{
+		length: length,
+		bins:   bins,
+		area:   area,
+	}
+
+	if err := vp.validate(); err != nil {
+		return VolumeProfile{}, err
+	}
+
+	return vp, nil
 }
 
-// NewHMA validates provided configuration options and
-// creates new HMA indicator.
-func NewHMA(length int) (HMA, error) {
-	wma, err := NewWMA(length)
-	if err != nil {
-		return HMA{}, err
+// validate checks whether the indicator has valid configuration properties.
+func (vp *VolumeProfile) validate() error {
+	if vp.length < 1 {
+		return &ConfigError{
+			Indicator: NameVolumeProfile,
+			Field:     "length",
+			Value:     vp.length,
+			Err:       ErrInvalidLength,
+		}
 	}
 
-	return HMA{
-		valid: true,
-		wma:   wma,
-	}, nil
+	if vp.bins < 1 {
+		return &ConfigError{
+			Indicator: NameVolumeProfile,
+			Field:     "bins",
+			Value:     vp.bins,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if vp.area.LessThanOrEqual(decimal.Zero) || vp.area.GreaterThan(_one) {
+		return &ConfigError{
+			Indicator: NameVolumeProfile,
+			Field:     "area",
+			Value:     vp.area,
+			Err:       ErrInvalidFactor,
+		}
+	}
+
+	vp.valid = true
+
+	return nil
 }
 
-// Calc calculates HMA from the provided data points slice.
-// Calculation is based on formula provided by fidelity.
-// https://www.fidelity.com/learning-center/trading-investing/technical-analysis/technical-indicator-guide/hull-moving-average.
-// All credits are due to Alan Hull who developed HMA indicator.
-func (h HMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
-	if !h.valid {
-		return decimal.Zero, ErrInvalidIndicator
+// CalcProfile calculates volume profile from the provided candles slice.
+// The volume of every candle is distributed evenly over its high/low
+// range. The value area is built by starting at the point of control and
+// repeatedly adding the adjacent bin with the higher volume.
+// Calculation is based on formula provided by tradingview.
+// https://www.tradingview.com/support/solutions/43000502040-volume-profile/.
+func (vp VolumeProfile) CalcProfile(cc []Candle) (Profile, error) {
+	if !vp.valid {
+		return Profile{}, ErrInvalidIndicator
 	}
 
-	if len(dd) != h.Count() {
-		return decimal.Zero, ErrInvalidDataSize
+	if len(cc) != vp.Count() {
+		return Profile{}, ErrInvalidDataSize
 	}
 
-	wma1 := WMA{length: h.wma.length / 2, valid: true}
-	wma2 := WMA{length: int(math.Sqrt(float64(h.wma.length))), valid: true}
+	high, low := extremes(cc)
+	size := high.Sub(low).Div(decimal.NewFromInt(int64(vp.bins)))
 
-	res := make([]decimal.Decimal, wma2.length)
+	hist := make([]ProfileBin, vp.bins)
 
-	for i := 0; i < wma2.length; i++ {
-		res1, err := wma1.Calc(dd[i : wma1.length+i])
-		if err != nil {
-			// unlikely to happen
-			return decimal.Zero, err
+	for i := range hist {
+		hist[i].Low = low.Add(size.Mul(decimal.NewFromInt(int64(i))))
+		hist[i].High = hist[i].Low.Add(size)
+	}
+
+	hist[len(hist)-1].High = high
+
+	for _, c := range cc {
+		rng := c.High.Sub(c.Low)
+
+		if rng.Equal(decimal.Zero) {
+			i := vp.bin(c.High, low, size)
+			hist[i].Volume = hist[i].Volume.Add(c.Volume)
+
+			continue
 		}
 
-		res2, err := h.wma.Calc(dd[i : h.wma.length+i])
-		if err != nil {
-			// unlikely to happen
-			return decimal.Zero, err
+		for i := range hist {
+			overlap := decimal.Min(c.High, hist[i].High).Sub(decimal.Max(c.Low, hist[i].Low))
+			if overlap.GreaterThan(decimal.Zero) {
+				hist[i].Volume = hist[i].Volume.Add(c.Volume.Mul(overlap).Div(rng))
+			}
 		}
+	}
 
-		res[i] = res1.Mul(decimal.NewFromInt(2)).Sub(res2)
+	poc := 0
+	total := decimal.Zero
+
+	for i := range hist {
+		total = total.Add(hist[i].Volume)
+
+		if hist[i].Volume.GreaterThan(hist[poc].Volume) {
+			poc = i
+		}
 	}
 
-	return wma2.Calc(res)
+	target := total.Mul(vp.area)
+	sum := hist[poc].Volume
+	lo, hi := poc, poc
+
+	for sum.LessThan(target) && (lo > 0 || hi < len(hist)-1) {
+		if hi == len(hist)-1 || (lo > 0 && hist[lo-1].Volume.GreaterThan(hist[hi+1].Volume)) {
+			lo--
+			sum = sum.Add(hist[lo].Volume)
+
+			continue
+		}
+
+		hi++
+		sum = sum.Add(hist[hi].Volume)
+	}
+
+	return Profile{
+		POC:       hist[poc].Low.Add(hist[poc].High).Div(decimal.NewFromInt(2)),
+		VAH:       hist[hi].High,
+		VAL:       hist[lo].Low,
+		Histogram: hist,
+	}, nil
 }
 
-// Count determines the total amount of data points needed for HMA
+// bin determines the index of the bin that contains the provided price.
+func (vp VolumeProfile) bin(price, low, size decimal.Decimal) int {
+	if size.Equal(decimal.Zero) {
+		return 0
+	}
+
+	i := int(price.Sub(low).Div(size).IntPart())
+	if i >= vp.bins {
+		return vp.bins - 1
+	}
+
+	return i
+}
+
+// Count determines the total amount of candles needed for VolumeProfile
 // calculation.
-func (h HMA) Count() int {
-	return int(math.Sqrt(float64(h.wma.length))) + h.wma.length - 1
+func (vp VolumeProfile) Count() int {
+	return vp.length
 }
 
-// ROC holds all the necessary information needed to calculate rate
-// of change.
+// Describe returns structured information about VolumeProfile and its
+// output.
+func (vp VolumeProfile) Describe() Description {
+	return Description{
+		Name:    NameVolumeProfile,
+		Input:   InputCandle,
+		Overlay: true,
+	}
+}
+
+// VWMA holds all the necessary information needed to calculate
+// volume weighted moving average.
 // The zero value is not usable.
-type ROC struct {
-	// valid specifies whether ROC paremeters were validated.
+type VWMA struct {
+	// valid specifies whether VWMA paremeters were validated.
 	valid bool
 
-	// length specifies how many data points should be used
+	// length specifies how many candles should be used
 	// during the calculations.
 	length int
 }
 
-// NewROC validates provided configuration options and
-// creates new ROC indicator.
-func NewROC(length int) (ROC, error) {
-	roc := ROC{length: length}
+// NewVWMA validates provided configuration options and
+// creates new VWMA indicator instance.
+func NewVWMA(length int) (VWMA, error) {
+	vwma := VWMA{length: length}
 
-	if err := roc.validate(); err != nil {
-		return ROC{}, err
+	if err := vwma.validate(); err != nil {
+		return VWMA{}, err
 	}
 
-	return roc, nil
+	return vwma, nil
 }
 
 // validate checks whether the indicator has valid configuration properties.
-func (roc *ROC) validate() error {
-	if roc.length < 1 {
-		return ErrInvalidLength
+func (vwma *VWMA) validate() error {
+	if vwma.length < 1 {
+		return &ConfigError{
+			Indicator: NameVWMA,
+			Field:     "length",
+			Value:     vwma.length,
+			Err:       ErrInvalidLength,
+		}
 	}
 
-	roc.valid = true
+	vwma.valid = true
 
 	return nil
 }
 
-// Calc calculates ROC from the provided data points slice.
+// CalcCandles calculates VWMA from the provided candles slice.
 // Calculation is based on formula provided by investopedia.
-// https://www.investopedia.com/terms/p/pricerateofchange.asp.
-func (roc ROC) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
-	if !roc.valid {
+// https://www.investopedia.com/articles/trading/11/trading-with-vwap-mvwap.asp.
+func (vwma VWMA) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !vwma.valid {
 		return decimal.Zero, ErrInvalidIndicator
 	}
 
-	if len(dd) != roc.Count() {
+	if len(cc) != vwma.Count() {
 		return decimal.Zero, ErrInvalidDataSize
 	}
 
-	curr := dd[0]
-	last := dd[len(dd)-1]
+	sum := decimal.Zero
+	vol := decimal.Zero
 
-	return curr.Div(last).Sub(_one).Mul(_hundred), nil
+	for i := range cc {
+		sum = sum.Add(cc[i].Close.Mul(cc[i].Volume))
+		vol = vol.Add(cc[i].Volume)
+	}
+
+	if vol.Equal(decimal.Zero) {
+		return decimal.Zero, nil
+	}
+
+	return sum.Div(vol), nil
 }
 
-// Count determines the total amount of data points needed for ROC
+// Count determines the total amount of candles needed for VWMA
 // calculation.
-func (roc ROC) Count() int {
-	return roc.length
+func (vwma VWMA) Count() int {
+	return vwma.length
 }
 
-// RSI holds all the necessary information needed to calculate relative
-// strength index.
+// Describe returns structured information about VWMA and its output.
+func (vwma VWMA) Describe() Description {
+	return Description{
+		Name:    NameVWMA,
+		Input:   InputCandle,
+		Overlay: true,
+		Lag:     decimal.NewFromInt(int64(vwma.length - 1)).Div(decimal.NewFromInt(2)),
+	}
+}
+
+// VWMACD holds all the necessary information needed to calculate volume
+// weighted moving average convergence divergence.
 // The zero value is not usable.
-type RSI struct {
-	// valid specifies whether RSI paremeters were validated.
+type VWMACD struct {
+	// valid specifies whether VWMACD paremeters were validated.
 	valid bool
 
-	// length specifies how many data points should be used
-	// during the calculations.
-	length int
-}
+	// fast specifies the fast volume weighted moving average.
+	fast VWMA
+
+	// slow specifies the slow volume weighted moving average.
+	slow VWMA
+
+	// signal specifies the moving average of the signal line.
+	signal EMA
+}
+
+// VWMACDLines holds all lines calculated by VWMACD.
+type VWMACDLines struct {
+	// MACD specifies the difference between the fast and slow volume
+	// weighted moving averages.
+	MACD decimal.Decimal `json:"macd"`
+
+	// Signal specifies the exponential moving average of the MACD line.
+	Signal decimal.Decimal `json:"signal"`
+
+	// Histogram specifies the difference between the MACD and signal
+	// lines.
+	Histogram decimal.Decimal `json:"histogram"`
+}
+
+// NewVWMACD validates provided configuration options and
+// creates new VWMACD indicator instance.
+// Commonly used values are 12, 26 and 9.
+func NewVWMACD(fast, slow, signal int) (VWMACD, error) {
+	if fast >= slow {
+		return VWMACD{}, &ConfigError{
+			Indicator: NameVWMACD,
+			Field:     "fast",
+			Value:     fast,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	fvwma, err := NewVWMA(fast)
+	if err != nil {
+		return VWMACD{}, fieldError(err, NameVWMACD, "fast")
+	}
+
+	svwma, err := NewVWMA(slow)
+	if err != nil {
+		// unlikely to happen
+		return VWMACD{}, err
+	}
 
-// NewRSI validates provided configuration options and
-// creates new RSI indicator.
-func NewRSI(length int) (RSI, error) {
-	rsi := RSI{
-		length: length,
+	ema, err := NewEMA(signal)
+	if err != nil {
+		return VWMACD{}, fieldError(err, NameVWMACD, "signal")
 	}
 
-	if err := rsi.validate(); err != nil {
-		return RSI{}, err
+	vwmacd := VWMACD{
+		fast:   fvwma,
+		slow:   svwma,
+		signal: ema,
 	}
 
-	return rsi, nil
+	if err := vwmacd.validate(); err != nil {
+		// unlikely to happen
+		return VWMACD{}, err
+	}
+
+	return vwmacd, nil
 }
 
 // validate checks whether the indicator has valid configuration properties.
-func (rsi *RSI) validate() error {
-	if rsi.length < 1 {
-		return ErrInvalidLength
+func (vwmacd *VWMACD) validate() error {
+	if !vwmacd.fast.valid {
+		return &ConfigError{
+			Indicator: NameVWMACD,
+			Field:     "fast",
+			Err:       ErrInvalidIndicator,
+		}
 	}
 
-	rsi.valid = true
+	if !vwmacd.slow.valid {
+		return &ConfigError{
+			Indicator: NameVWMACD,
+			Field:     "slow",
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	if !vwmacd.signal.valid {
+		return &ConfigError{
+			Indicator: NameVWMACD,
+			Field:     "signal",
+			Err:       ErrInvalidIndicator,
+		}
+	}
+
+	vwmacd.valid = true
 
 	return nil
 }
 
-// Calc calculates RSI from the provided data points slice.
-// Calculation is based on formula provided by investopedia.
-// https://www.investopedia.com/terms/r/rsi.asp.
-// All credits are due to J. Welles Wilder Jr. who developed RSI indicator.
-func (rsi RSI) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
-	if !rsi.valid {
+// CalcCandles calculates VWMACD line from the provided candles slice.
+// Calculation is based on formula provided by Buff Dormeier in the
+// Investing with Volume Analysis book.
+// All credits are due to Buff Dormeier who developed VWMACD indicator.
+func (vwmacd VWMACD) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !vwmacd.valid {
 		return decimal.Zero, ErrInvalidIndicator
 	}
 
-	if len(dd) != rsi.Count() {
+	if len(cc) != vwmacd.Count() {
 		return decimal.Zero, ErrInvalidDataSize
 	}
 
-	ag := decimal.Zero
-	al := decimal.Zero
-	length := decimal.NewFromInt(int64(rsi.length))
+	return vwmacd.line(cc)
+}
 
-	for i := 1; i < len(dd); i++ {
-		if dd[i].Sub(dd[i-1]).LessThan(decimal.Zero) {
-			al = al.Add(dd[i].Sub(dd[i-1]).Abs())
-		} else {
-			ag = ag.Add(dd[i].Sub(dd[i-1]))
-		}
+// CalcLines calculates VWMACD, signal and histogram lines from the
+// provided candles slice.
+func (vwmacd VWMACD) CalcLines(cc []Candle) (VWMACDLines, error) {
+	if !vwmacd.valid {
+		return VWMACDLines{}, ErrInvalidIndicator
 	}
 
-	if ag == decimal.Zero {
-		return decimal.NewFromInt(0), nil
+	if len(cc) != vwmacd.Count() {
+		return VWMACDLines{}, ErrInvalidDataSize
 	}
 
-	if al == decimal.Zero {
-		return _hundred, nil
+	ll := make([]decimal.Decimal, vwmacd.signal.Count())
+	offset := len(cc) - len(ll) + 1
+
+	for i := range ll {
+		var err error
+
+		ll[i], err = vwmacd.line(cc[:offset+i])
+		if err != nil {
+			// unlikely to happen
+			return VWMACDLines{}, err
+		}
+	}
+
+	signal, err := vwmacd.signal.Calc(ll)
+	if err != nil {
+		// unlikely to happen
+		return VWMACDLines{}, err
 	}
 
-	ag = ag.Div(length)
+	return VWMACDLines{
+		MACD:      ll[len(ll)-1],
+		Signal:    signal,
+		Histogram: ll[len(ll)-1].Sub(signal),
+	}, nil
+}
+
+// line calculates VWMACD line from the newest candles of the provided
+// slice.
+func (vwmacd VWMACD) line(cc []Candle) (decimal.Decimal, error) {
+	fast, err := vwmacd.fast.CalcCandles(cc[len(cc)-vwmacd.fast.Count():])
+	if err != nil {
+		return decimal.Zero, err
+	}
 
-	al = al.Div(length)
+	slow, err := vwmacd.slow.CalcCandles(cc[len(cc)-vwmacd.slow.Count():])
+	if err != nil {
+		return decimal.Zero, err
+	}
 
-	return _hundred.Sub(_hundred.Div(decimal.NewFromInt(1).Add(ag.Div(al)))), nil
+	return fast.Sub(slow), nil
 }
 
-// Count determines the total amount of data points needed for RSI
+// Count determines the total amount of candles needed for VWMACD
 // calculation.
-func (rsi RSI) Count() int {
-	return rsi.length
+func (vwmacd VWMACD) Count() int {
+	return vwmacd.slow.Count() + vwmacd.signal.Count() - 1
 }
 
-// SMA holds all the necessary information needed to calculate simple
-// moving average.
+// Describe returns structured information about VWMACD and its output.
+func (vwmacd VWMACD) Describe() Description {
+	return Description{
+		Name:  NameVWMACD,
+		Input: InputCandle,
+	}
+}
+
+// WeightedClose holds all the necessary information needed to calculate
+// weighted close price, the average of the high, low and
+// twice weighted close prices, of candles.
+// It is a shorthand for Source with PriceHLCC4.
 // The zero value is not usable.
-type SMA struct {
-	// valid specifies whether SMA paremeters were validated.
+type WeightedClose struct {
+	// valid specifies whether WeightedClose paremeters were validated.
 	valid bool
 
-	// length specifies how many data points should be used
-	// during the calculations.
-	length int
+	// src specifies the source of PriceHLCC4 prices.
+	src Source
 }
 
-// NewSMA validates provided configuration options and
-// creates new SMA indicator.
-func NewSMA(length int) (SMA, error) {
-	sma := SMA{
-		length: length,
+// NewWeightedClose validates provided configuration options and
+// creates new WeightedClose indicator instance.
+// Provided indicator is calculated from the weighted close prices, which
+// favour the close over the range of the candle.
+func NewWeightedClose(ind Indicator) (WeightedClose, error) {
+	src, err := NewSource(PriceHLCC4, ind)
+	if err != nil {
+		// unlikely to happen
+		return WeightedClose{}, err
 	}
 
-	if err := sma.validate(); err != nil {
-		return SMA{}, err
+	wc := WeightedClose{
+		src: src,
 	}
 
-	return sma, nil
+	if err := wc.validate(); err != nil {
+		// unlikely to happen
+		return WeightedClose{}, err
+	}
+
+	return wc, nil
 }
 
 // validate checks whether the indicator has valid configuration properties.
-func (sma *SMA) validate() error {
-	if sma.length < 1 {
-		return ErrInvalidLength
+func (wc *WeightedClose) validate() error {
+	if !wc.src.valid {
+		return &ConfigError{
+			Indicator: NameWeightedClose,
+			Err:       ErrInvalidIndicator,
+		}
 	}
 
-	sma.valid = true
+	wc.valid = true
 
 	return nil
 }
 
-// Calc calculates SMA from the provided data points slice.
-// Calculation is based on formula provided by investopedia.
-// https://www.investopedia.com/terms/s/sma.asp.
-func (sma SMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
-	if !sma.valid {
+// CalcCandles calculates WeightedClose from the provided candles slice.
+func (wc WeightedClose) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !wc.valid {
 		return decimal.Zero, ErrInvalidIndicator
 	}
 
-	if len(dd) != sma.Count() {
-		return decimal.Zero, ErrInvalidDataSize
-	}
-
-	res := decimal.Zero
-	for i := 0; i < len(dd); i++ {
-		res = res.Add(dd[i])
-	}
-
-	return res.Div(decimal.NewFromInt(int64(sma.length))), nil
+	return wc.src.CalcCandles(cc)
 }
 
-// Count determines the total amount of data points needed for SMA
+// Count determines the total amount of candles needed for WeightedClose
 // calculation.
-func (sma SMA) Count() int {
-	return sma.length
+func (wc WeightedClose) Count() int {
+	return wc.src.Count()
 }
 
-// SRSI holds all the necessary information needed to calculate stoch
-// relative strength index.
+// Describe returns structured information about WeightedClose and its output.
+func (wc WeightedClose) Describe() Description {
+	return describePrices(NameWeightedClose, wc.src.ind)
+}
+
+// WeisWave holds all the necessary information needed to calculate Weis
+// wave volume.
 // The zero value is not usable.
-type SRSI struct {
-	// valid specifies whether SRSI paremeters were validated.
+type WeisWave struct {
+	// valid specifies whether WeisWave paremeters were validated.
 	valid bool
 
-	// rsi specifies the base relative strength index.
-	rsi RSI
+	// length specifies how many candles should be used
+	// during the calculations.
+	length int
+
+	// reversal specifies by how many percent the close price has to move
+	// against the extreme of the current wave for a new wave to start.
+	// Zero means that every change of direction starts a new wave.
+	reversal decimal.Decimal
 }
 
-// NewSRSI validates provided configuration options and
-// creates new SRSI indicator.
-func NewSRSI(length int) (SRSI, error) {
-	rsi, err := NewRSI(length)
-	if err != nil {
-		return SRSI{}, err
+// NewWeisWave validates provided configuration options and
+// creates new WeisWave indicator instance.
+func NewWeisWave(length int, reversal decimal.Decimal) (WeisWave, error) {
+	ww := WeisWave{
+		length:   length,
+		reversal: reversal,
 	}
 
-	return SRSI{
-		valid: true,
-		rsi:   rsi,
-	}, nil
+	if err := ww.validate(); err != nil {
+		return WeisWave{}, err
+	}
+
+	return ww, nil
 }
 
-// Calc calculates SRSI from the provided data slice.
-// Calculation is based on formula provided by investopedia.
-// https://www.investopedia.com/terms/s/stochrsi.asp.
-func (srsi SRSI) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
-	if !srsi.valid {
+// validate checks whether the indicator has valid configuration properties.
+func (ww *WeisWave) validate() error {
+	if ww.length < 2 {
+		return &ConfigError{
+			Indicator: NameWeisWave,
+			Field:     "length",
+			Value:     ww.length,
+			Err:       ErrInvalidLength,
+		}
+	}
+
+	if ww.reversal.LessThan(decimal.Zero) {
+		return &ConfigError{
+			Indicator: NameWeisWave,
+			Field:     "reversal",
+			Value:     ww.reversal,
+			Err:       errors.New("invalid reversal"),
+		}
+	}
+
+	ww.valid = true
+
+	return nil
+}
+
+// CalcCandles calculates WeisWave from the provided candles slice. The
+// candles are split into up and down waves by the close price and the
+// volume of the newest wave is returned, positive for an up wave and
+// negative for a down wave. The first candle is only used as the
+// previous candle of the second one.
+// Calculation is based on formula provided by David Weis in the Trades
+// About to Happen book.
+// All credits are due to David Weis who developed WeisWave indicator.
+func (ww WeisWave) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !ww.valid {
 		return decimal.Zero, ErrInvalidIndicator
 	}
 
-	if len(dd) != srsi.Count() {
+	if len(cc) != ww.Count() {
 		return decimal.Zero, ErrInvalidDataSize
 	}
 
-	res := make([]decimal.Decimal, srsi.rsi.length)
+	var up, started bool
 
-	var err error
-	for i := 0; i < srsi.rsi.length; i++ {
-		res[i], err = srsi.rsi.Calc(dd[i : srsi.rsi.length+i])
-		if err != nil {
-			// unlikely to happen
-			return decimal.Zero, err
-		}
-	}
+	vol := decimal.Zero
+	ext := cc[0].Close
 
-	curr := res[0]
-	max := res[0]
-	min := res[0]
+	for i := 1; i < len(cc); i++ {
+		if !started {
+			if cc[i].Close.Equal(ext) {
+				continue
+			}
 
-	for i := 1; i < len(res); i++ {
-		if max.LessThan(res[i]) {
-			max = res[i]
+			started = true
+			up = cc[i].Close.GreaterThan(ext)
+			vol = cc[i].Volume
+			ext = cc[i].Close
+
+			continue
 		}
 
-		if min.GreaterThan(res[i]) {
-			min = res[i]
+		diff := cc[i].Close.Sub(ext)
+		if !up {
+			diff = diff.Neg()
+		}
+
+		switch {
+		case diff.GreaterThanOrEqual(decimal.Zero):
+			vol = vol.Add(cc[i].Volume)
+			ext = cc[i].Close
+		case diff.Abs().GreaterThan(ext.Abs().Mul(ww.reversal).Div(_hundred)):
+			up = !up
+			vol = cc[i].Volume
+			ext = cc[i].Close
+		default:
+			vol = vol.Add(cc[i].Volume)
 		}
 	}
 
-	if max.Equal(min) {
-		return decimal.Zero, nil
+	if !up {
+		return vol.Neg(), nil
 	}
 
-	return curr.Sub(min).Div(max.Sub(min)), nil
+	return vol, nil
 }
 
-// Count determines the total amount of data needed for SRSI
+// Count determines the total amount of candles needed for WeisWave
 // calculation.
-func (srsi SRSI) Count() int {
-	return srsi.rsi.length*2 - 1
+func (ww WeisWave) Count() int {
+	return ww.length
 }
 
-// Stoch holds all the necessary information needed to calculate stochastic
-// oscillator.
+// Describe returns structured information about WeisWave and its output.
+func (ww WeisWave) Describe() Description {
+	return Description{
+		Name:  NameWeisWave,
+		Input: InputCandle,
+	}
+}
+
+// WillR holds all the necessary information needed to calculate
+// Williams %R.
 // The zero value is not usable.
-type Stoch struct {
-	// valid specifies whether Stoch paremeters were validated.
+type WillR struct {
+	// valid specifies whether WillR paremeters were validated.
 	valid bool
 
-	// length specifies how many data points should be used
+	// length specifies how many candles should be used
 	// during the calculations.
 	length int
+
+	// rescale specifies whether the result should be rescaled from the
+	// -100..0 range to the 0..100 range.
+	rescale bool
 }
 
-// NewStoch validates provided configuration options and
-// creates new Stoch indicator.
-func NewStoch(length int) (Stoch, error) {
-	stoch := Stoch{
-		length: length,
+// NewWillR validates provided configuration options and
+// creates new WillR indicator instance.
+func NewWillR(length int, rescale bool) (WillR, error) {
+	willr := WillR{
+		length:  length,
+		rescale: rescale,
 	}
 
-	if err := stoch.validate(); err != nil {
-		return Stoch{}, err
+	if err := willr.validate(); err != nil {
+		return WillR{}, err
 	}
 
-	return stoch, nil
+	return willr, nil
 }
 
 // validate checks whether the indicator has valid configuration properties.
-func (stoch *Stoch) validate() error {
-	if stoch.length < 1 {
-		return ErrInvalidLength
+func (willr *WillR) validate() error {
+	if willr.length < 1 {
+		return &ConfigError{
+			Indicator: NameWillR,
+			Field:     "length",
+			Value:     willr.length,
+			Err:       ErrInvalidLength,
+		}
 	}
 
-	stoch.valid = true
+	willr.valid = true
 
 	return nil
 }
 
-// Calc calculates Stoch from the provided data points slice.
+// CalcCandles calculates WillR from the provided candles slice.
 // Calculation is based on formula provided by investopedia.
-// https://www.investopedia.com/terms/s/stochasticoscillator.asp.
-func (stoch Stoch) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
-	if !stoch.valid {
+// https://www.investopedia.com/terms/w/williamsr.asp.
+// All credits are due to Larry Williams who developed WillR indicator.
+func (willr WillR) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	if !willr.valid {
 		return decimal.Zero, ErrInvalidIndicator
 	}
 
-	if len(dd) != stoch.Count() {
+	if len(cc) != willr.Count() {
 		return decimal.Zero, ErrInvalidDataSize
 	}
 
-	low := dd[0]
-	high := dd[0]
+	high, low := extremes(cc)
 
-	for i := 0; i < len(dd); i++ {
-		if dd[i].LessThan(low) {
-			low = dd[i]
-		}
+	res := decimal.Zero
 
-		if dd[i].GreaterThan(high) {
-			high = dd[i]
-		}
+	if dnm := high.Sub(low); !dnm.Equal(decimal.Zero) {
+		res = cc[len(cc)-1].Close.Sub(low).Div(dnm).Mul(_hundred)
 	}
 
-	dnm := high.Sub(low)
-	if dnm.Equal(decimal.Zero) {
-		return decimal.Zero, nil
+	if willr.rescale {
+		return res, nil
 	}
 
-	return dd[len(dd)-1].Sub(low).Div(dnm).Mul(_hundred), nil
+	return res.Sub(_hundred), nil
 }
 
-// Count determines the total amount of data points needed for Stoch
+// Count determines the total amount of candles needed for WillR
 // calculation.
-func (stoch Stoch) Count() int {
-	return stoch.length
+func (willr WillR) Count() int {
+	return willr.length
+}
+
+// Describe returns structured information about WillR and its output.
+func (willr WillR) Describe() Description {
+	desc := Description{
+		Name:    NameWillR,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     _hundred.Neg(),
+		Max:     decimal.Zero,
+	}
+
+	if willr.rescale {
+		desc.Min = decimal.Zero
+		desc.Max = _hundred
+	}
+
+	return desc
 }
 
 // WMA holds all the necessary information needed to calculate weighted
@@ -886,7 +10710,12 @@ func NewWMA(length int) (WMA, error) {
 // validate checks whether the indicator has valid configuration properties.
 func (wma *WMA) validate() error {
 	if wma.length < 1 {
-		return ErrInvalidLength
+		return &ConfigError{
+			Indicator: NameWMA,
+			Field:     "length",
+			Value:     wma.length,
+			Err:       ErrInvalidLength,
+		}
 	}
 
 	wma.valid = true
@@ -922,3 +10751,86 @@ func (wma WMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
 func (wma WMA) Count() int {
 	return wma.length
 }
+
+// Describe returns structured information about WMA and its output.
+func (wma WMA) Describe() Description {
+	return Description{
+		Name:    NameWMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.NewFromInt(int64(wma.length - 1)).Div(decimal.NewFromInt(3)),
+	}
+}
+
+// ZLEMA holds all the necessary information needed to calculate
+// zero lag exponential moving average.
+// The zero value is not usable.
+type ZLEMA struct {
+	// valid specifies whether ZLEMA paremeters were validated.
+	valid bool
+
+	// ema specifies what ema should be used for zlema calculations.
+	ema EMA
+}
+
+// NewZLEMA validates provided configuration options and creates
+// new ZLEMA indicator.
+func NewZLEMA(length int) (ZLEMA, error) {
+	ema, err := NewEMA(length)
+	if err != nil {
+		return ZLEMA{}, fieldError(err, NameZLEMA, "length")
+	}
+
+	return ZLEMA{
+		valid: true,
+		ema:   ema,
+	}, nil
+}
+
+// Calc calculates ZLEMA from the provided data points slice.
+// Every data point is de-lagged by adding the difference between it and
+// the data point lag periods before it, after which EMA is applied.
+// Calculation is based on formula provided by wikipedia.
+// https://en.wikipedia.org/wiki/Zero_lag_exponential_moving_average.
+// All credits are due to John Ehlers and Ric Way who developed ZLEMA
+// indicator.
+func (zlema ZLEMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !zlema.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != zlema.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	lag := zlema.lag()
+	pres := make([]decimal.Decimal, len(dd)-lag)
+
+	for i := range pres {
+		pres[i] = dd[i+lag].Mul(decimal.NewFromInt(2)).Sub(dd[i])
+	}
+
+	return zlema.ema.Calc(pres)
+}
+
+// lag calculates how many periods back the data points used for
+// de-lagging are.
+func (zlema ZLEMA) lag() int {
+	return (zlema.ema.sma.length - 1) / 2
+}
+
+// Count determines the total amount of data points needed for ZLEMA
+// calculation.
+func (zlema ZLEMA) Count() int {
+	return zlema.ema.Count() + zlema.lag()
+}
+
+// Describe returns structured information about ZLEMA and its output.
+func (zlema ZLEMA) Describe() Description {
+	return Description{
+		Name:    NameZLEMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.Zero,
+	}
+}
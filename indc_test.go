@@ -6,25 +6,26 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
-func Test_NewAroon(t *testing.T) {
+func Test_NewADX(t *testing.T) {
 	cc := map[string]struct {
-		Trend  Trend
-		Length int
-		Result Aroon
-		Error  error
+		Length    int
+		Smoothing Smoothing
+		Result    ADX
+		Error     error
 	}{
 		"Validate returns an error": {
 			Error: assert.AnError,
 		},
-		"Successfully created new Aroon": {
-			Trend:  TrendDown,
-			Length: 5,
-			Result: Aroon{
-				valid:  true,
-				trend:  TrendDown,
-				length: 5,
+		"Successfully created new ADX": {
+			Length:    5,
+			Smoothing: SmoothingWilder,
+			Result: ADX{
+				valid:     true,
+				length:    5,
+				smoothing: SmoothingWilder,
 			},
 		},
 	}
@@ -35,36 +36,44 @@ func Test_NewAroon(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := NewAroon(c.Trend, c.Length)
+			res, err := NewADX(c.Length, c.Smoothing)
 			assertEqualError(t, c.Error, err)
 			assert.Equal(t, c.Result, res)
 		})
 	}
 }
 
-func Test_Aroon_validate(t *testing.T) {
+func Test_ADX_validate(t *testing.T) {
 	cc := map[string]struct {
-		Aroon Aroon
+		ADX   ADX
 		Error error
 	}{
-		"Invalid trend": {
-			Aroon: Aroon{
-				valid:  false,
-				trend:  70,
-				length: 5,
+		"Invalid length": {
+			ADX: ADX{
+				smoothing: SmoothingSMA,
+			},
+			Error: &ConfigError{
+				Indicator: NameADX,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
 			},
-			Error: ErrInvalidTrend,
 		},
-		"Invalid length": {
-			Aroon: Aroon{
-				trend: TrendDown,
+		"Invalid smoothing": {
+			ADX: ADX{
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameADX,
+				Field:     "smoothing",
+				Value:     Smoothing(0),
+				Err:       ErrInvalidSmoothing,
 			},
-			Error: ErrInvalidLength,
 		},
 		"Successfully validated": {
-			Aroon: Aroon{
-				trend:  TrendUp,
-				length: 1,
+			ADX: ADX{
+				length:    1,
+				smoothing: SmoothingEMA,
 			},
 		},
 	}
@@ -75,67 +84,111 @@ func Test_Aroon_validate(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			assertEqualError(t, c.Error, c.Aroon.validate())
+			assertEqualError(t, c.Error, c.ADX.validate())
 			if c.Error == nil {
-				assert.True(t, c.Aroon.valid)
+				assert.True(t, c.ADX.valid)
 			}
 		})
 	}
 }
 
-func Test_Aroon_Calc(t *testing.T) {
+func Test_ADX_CalcCandles(t *testing.T) {
 	cc := map[string]struct {
-		Aroon  Aroon
-		Data   []decimal.Decimal
-		Result decimal.Decimal
-		Error  error
+		ADX     ADX
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
 	}{
 		"Invalid indicator": {
-			Aroon: Aroon{
-				valid: false,
-			},
 			Error: ErrInvalidIndicator,
 		},
 		"Invalid data size": {
-			Aroon: Aroon{
-				valid:  true,
-				trend:  TrendDown,
-				length: 5,
+			ADX: ADX{
+				valid:     true,
+				length:    2,
+				smoothing: SmoothingSMA,
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(30),
+			Candles: testCandles(t)[:1],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			ADX: ADX{
+				valid:     true,
+				length:    2,
+				smoothing: SmoothingSMA,
 			},
-			Error: ErrInvalidDataSize,
+			Candles: []Candle{{}, {}, {}, {}},
+			Result:  decimal.Zero,
 		},
-		"Successful calculation with TrendUp": {
-			Aroon: Aroon{
-				valid:  true,
-				trend:  TrendUp,
-				length: 5,
+		"Successful calculation": {
+			ADX: ADX{
+				valid:     true,
+				length:    2,
+				smoothing: SmoothingSMA,
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(31),
-				decimal.NewFromInt(38),
-				decimal.NewFromInt(35),
-				decimal.NewFromInt(29),
-				decimal.NewFromInt(29),
+			Candles: []Candle{
+				{High: decimal.NewFromInt(10), Low: decimal.NewFromInt(8), Close: decimal.NewFromInt(9)},
+				{High: decimal.NewFromInt(11), Low: decimal.NewFromInt(7), Close: decimal.NewFromInt(8)},
+				{High: decimal.NewFromInt(10), Low: decimal.NewFromInt(5), Close: decimal.NewFromInt(6)},
+				{High: decimal.NewFromInt(13), Low: decimal.NewFromInt(6), Close: decimal.NewFromInt(12)},
 			},
-			Result: decimal.NewFromInt(40),
+			Result: decimal.NewFromInt(60),
 		},
-		"Successful calculation with TrendDown": {
-			Aroon: Aroon{
-				valid:  true,
-				trend:  TrendDown,
-				length: 5,
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ADX.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_ADX_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		ADX     ADX
+		Candles []Candle
+		Result  ADXLines
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			ADX: ADX{
+				valid:     true,
+				length:    2,
+				smoothing: SmoothingSMA,
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(31),
-				decimal.NewFromInt(38),
-				decimal.NewFromInt(35),
-				decimal.NewFromInt(29),
-				decimal.NewFromInt(29),
+			Candles: testCandles(t)[:1],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			ADX: ADX{
+				valid:     true,
+				length:    2,
+				smoothing: SmoothingSMA,
+			},
+			Candles: []Candle{
+				{High: decimal.NewFromInt(10), Low: decimal.NewFromInt(8), Close: decimal.NewFromInt(9)},
+				{High: decimal.NewFromInt(11), Low: decimal.NewFromInt(7), Close: decimal.NewFromInt(8)},
+				{High: decimal.NewFromInt(10), Low: decimal.NewFromInt(5), Close: decimal.NewFromInt(6)},
+				{High: decimal.NewFromInt(13), Low: decimal.NewFromInt(6), Close: decimal.NewFromInt(12)},
+			},
+			Result: ADXLines{
+				Plus:  decimal.NewFromInt(25),
+				Minus: decimal.RequireFromString("16.66666667"),
+				ADX:   decimal.NewFromInt(60),
 			},
-			Result: _hundred,
 		},
 	}
 
@@ -145,54 +198,76 @@ func Test_Aroon_Calc(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := c.Aroon.Calc(c.Data)
+			res, err := c.ADX.CalcLines(c.Candles)
 			assertEqualError(t, c.Error, err)
 			if err != nil {
 				return
 			}
 
-			assert.Equal(t, c.Result.String(), res.String())
+			assert.Equal(t, c.Result.Plus.Round(8).String(), res.Plus.Round(8).String())
+			assert.Equal(t, c.Result.Minus.Round(8).String(), res.Minus.Round(8).String())
+			assert.Equal(t, c.Result.ADX.Round(8).String(), res.ADX.Round(8).String())
 		})
 	}
 }
 
-func Test_Aroon_Count(t *testing.T) {
-	assert.Equal(t, 5, Aroon{
-		length: 5,
+func Test_ADX_Count(t *testing.T) {
+	assert.Equal(t, 10, ADX{
+		length:    5,
+		smoothing: SmoothingSMA,
+	}.Count())
+
+	assert.Equal(t, 18, ADX{
+		length:    5,
+		smoothing: SmoothingWilder,
 	}.Count())
 }
 
-func Test_NewBB(t *testing.T) {
+func Test_ADX_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameADX,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     decimal.NewFromInt(100),
+	}, ADX{}.Describe())
+}
+
+// testAlligator returns a small valid Alligator used in calculation
+// tests.
+func testAlligator() Alligator {
+	return Alligator{
+		valid: true,
+		jaw: Offset{
+			valid:  true,
+			source: SMA{valid: true, length: 3},
+			shift:  2,
+		},
+		teeth: Offset{
+			valid:  true,
+			source: SMA{valid: true, length: 2},
+			shift:  1,
+		},
+		lips: SMA{valid: true, length: 1},
+	}
+}
+
+func Test_NewAlligator(t *testing.T) {
 	cc := map[string]struct {
-		Percent bool
-		Band    Band
-		StdDev  decimal.Decimal
-		Length  int
-		Result  BB
-		Error   error
+		Jaw    Indicator
+		Teeth  Indicator
+		Lips   Indicator
+		Result Alligator
+		Error  error
 	}{
-		"NewSMA returns an error": {
-			Error: assert.AnError,
-		},
 		"Validate returns an error": {
-			Length: 1,
-			Error:  ErrInvalidBand,
+			Error: assert.AnError,
 		},
-		"Successfully created new BB": {
-			Percent: true,
-			Band:    BandUpper,
-			StdDev:  decimal.RequireFromString("2.5"),
-			Length:  5,
-			Result: BB{
-				valid:   true,
-				percent: true,
-				band:    BandUpper,
-				stdDev:  decimal.RequireFromString("2.5"),
-				sma: SMA{
-					length: 5,
-					valid:  true,
-				},
-			},
+		"Successfully created new Alligator": {
+			Jaw:    testAlligator().jaw,
+			Teeth:  testAlligator().teeth,
+			Lips:   testAlligator().lips,
+			Result: testAlligator(),
 		},
 	}
 
@@ -202,46 +277,56 @@ func Test_NewBB(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := NewBB(c.Percent, c.Band, c.StdDev, c.Length)
+			res, err := NewAlligator(c.Jaw, c.Teeth, c.Lips)
 			assertEqualError(t, c.Error, err)
 			assert.Equal(t, c.Result, res)
 		})
 	}
 }
 
-func Test_BB_validate(t *testing.T) {
+func Test_Alligator_validate(t *testing.T) {
 	cc := map[string]struct {
-		BB    BB
-		Error error
+		Alligator Alligator
+		Error     error
 	}{
-		"Invalid band": {
-			BB: BB{
-				band:   70,
-				stdDev: decimal.Decimal{},
-				sma: SMA{
-					length: 5,
-				},
+		"Invalid jaw": {
+			Alligator: Alligator{
+				teeth: SMA{},
+				lips:  SMA{},
+			},
+			Error: &ConfigError{
+				Indicator: NameAlligator,
+				Field:     "jaw",
+				Err:       ErrInvalidIndicator,
 			},
-			Error: ErrInvalidBand,
 		},
-		"Invalid BB band width configuration": {
-			BB: BB{
-				percent: true,
-				band:    BandWidth,
-				stdDev:  decimal.Decimal{},
-				sma: SMA{
-					length: 1,
-				},
+		"Invalid teeth": {
+			Alligator: Alligator{
+				jaw:  SMA{},
+				lips: SMA{},
+			},
+			Error: &ConfigError{
+				Indicator: NameAlligator,
+				Field:     "teeth",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Invalid lips": {
+			Alligator: Alligator{
+				jaw:   SMA{},
+				teeth: SMA{},
+			},
+			Error: &ConfigError{
+				Indicator: NameAlligator,
+				Field:     "lips",
+				Err:       ErrInvalidIndicator,
 			},
-			Error: errors.New("invalid bb configuration"),
 		},
 		"Successfully validated": {
-			BB: BB{
-				band:   BandUpper,
-				stdDev: decimal.Decimal{},
-				sma: SMA{
-					length: 1,
-				},
+			Alligator: Alligator{
+				jaw:   SMA{},
+				teeth: SMA{},
+				lips:  SMA{},
 			},
 		},
 	}
@@ -252,152 +337,14423 @@ func Test_BB_validate(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			assertEqualError(t, c.Error, c.BB.validate())
+			assertEqualError(t, c.Error, c.Alligator.validate())
 			if c.Error == nil {
-				assert.True(t, c.BB.valid)
+				assert.True(t, c.Alligator.valid)
 			}
 		})
 	}
 }
 
-func Test_BB_Calc(t *testing.T) {
+func Test_Alligator_CalcLines(t *testing.T) {
+	invalidJaw := testAlligator()
+	invalidJaw.jaw = SMA{length: 5}
+
+	invalidTeeth := testAlligator()
+	invalidTeeth.teeth = SMA{length: 3}
+
+	invalidLips := testAlligator()
+	invalidLips.lips = SMA{length: 1}
+
 	cc := map[string]struct {
-		BB     BB
-		Data   []decimal.Decimal
-		Result decimal.Decimal
-		Error  error
+		Alligator Alligator
+		Candles   []Candle
+		Result    AlligatorLines
+		Error     error
 	}{
 		"Invalid indicator": {
-			BB: BB{
-				valid: false,
-			},
 			Error: ErrInvalidIndicator,
 		},
 		"Invalid data size": {
-			BB: BB{
-				valid: true,
-				band:  BandUpper,
-				sma: SMA{
-					valid:  true,
-					length: 5,
-				},
-			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(30),
+			Alligator: testAlligator(),
+			Candles:   testCandles(t)[:4],
+			Error:     ErrInvalidDataSize,
+		},
+		"Jaw returns an error": {
+			Alligator: invalidJaw,
+			Candles:   testCandles(t),
+			Error:     ErrInvalidIndicator,
+		},
+		"Teeth returns an error": {
+			Alligator: invalidTeeth,
+			Candles:   testCandles(t),
+			Error:     ErrInvalidIndicator,
+		},
+		"Lips returns an error": {
+			Alligator: invalidLips,
+			Candles:   testCandles(t),
+			Error:     ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			Alligator: testAlligator(),
+			Candles:   testCandles(t),
+			Result: AlligatorLines{
+				Jaw:   decimal.RequireFromString("9.83333333"),
+				Teeth: decimal.RequireFromString("10.5"),
+				Lips:  decimal.RequireFromString("13"),
 			},
-			Error: ErrInvalidDataSize,
 		},
-		"Successful calculation with BandUpper": {
-			BB: BB{
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Alligator.CalcLines(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Jaw.Round(8).String(), res.Jaw.Round(8).String())
+			assert.Equal(t, c.Result.Teeth.Round(8).String(), res.Teeth.Round(8).String())
+			assert.Equal(t, c.Result.Lips.Round(8).String(), res.Lips.Round(8).String())
+		})
+	}
+}
+
+func Test_Alligator_Count(t *testing.T) {
+	assert.Equal(t, 5, testAlligator().Count())
+
+	assert.Equal(t, 4, Alligator{
+		jaw:   SMA{length: 2},
+		teeth: SMA{length: 4},
+		lips:  SMA{length: 3},
+	}.Count())
+
+	assert.Equal(t, 4, Alligator{
+		jaw:   SMA{length: 2},
+		teeth: SMA{length: 3},
+		lips:  SMA{length: 4},
+	}.Count())
+}
+
+func Test_Alligator_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameAlligator,
+		Input:   InputCandle,
+		Overlay: true,
+	}, Alligator{}.Describe())
+}
+
+func Test_NewALMA(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Offset decimal.Decimal
+		Sigma  decimal.Decimal
+		Result ALMA
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new ALMA": {
+			Length: 9,
+			Offset: decimal.RequireFromString("0.85"),
+			Sigma:  decimal.NewFromInt(6),
+			Result: ALMA{
 				valid:  true,
-				band:   BandUpper,
-				stdDev: decimal.RequireFromString("1"),
-				sma: SMA{
-					length: 5,
-					valid:  true,
-				},
-			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(30),
-				decimal.NewFromInt(35),
-				decimal.NewFromInt(40),
-				decimal.NewFromInt(38),
-				decimal.NewFromInt(32),
+				length: 9,
+				offset: decimal.RequireFromString("0.85"),
+				sigma:  decimal.NewFromInt(6),
 			},
-			Result: decimal.RequireFromString("38.68781778"),
 		},
-		"Successful calculation with BandUpper using percent": {
-			BB: BB{
-				valid:   true,
-				percent: true,
-				band:    BandUpper,
-				stdDev:  decimal.RequireFromString("1"),
-				sma: SMA{
-					length: 5,
-					valid:  true,
-				},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewALMA(c.Length, c.Offset, c.Sigma)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ALMA_validate(t *testing.T) {
+	cc := map[string]struct {
+		ALMA  ALMA
+		Error error
+	}{
+		"Invalid length": {
+			ALMA: ALMA{
+				sigma: decimal.NewFromInt(6),
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(30),
-				decimal.NewFromInt(35),
-				decimal.NewFromInt(40),
-				decimal.NewFromInt(38),
-				decimal.NewFromInt(32),
+			Error: &ConfigError{
+				Indicator: NameALMA,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
 			},
-			Result: decimal.RequireFromString("10.53662224"),
 		},
-		"Successful calculation with BandLower": {
-			BB: BB{
-				valid:  true,
-				band:   BandLower,
-				stdDev: decimal.RequireFromString("1"),
-				sma: SMA{
-					length: 5,
-					valid:  true,
-				},
+		"Invalid offset": {
+			ALMA: ALMA{
+				length: 9,
+				offset: decimal.RequireFromString("1.1"),
+				sigma:  decimal.NewFromInt(6),
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(30),
-				decimal.NewFromInt(35),
-				decimal.NewFromInt(40),
-				decimal.NewFromInt(38),
-				decimal.NewFromInt(32),
+			Error: assert.AnError,
+		},
+		"Invalid sigma": {
+			ALMA: ALMA{
+				length: 9,
+				offset: decimal.RequireFromString("0.85"),
 			},
-			Result: decimal.RequireFromString("31.31218222"),
+			Error: assert.AnError,
 		},
-		"Successful calculation with BandLower using percent": {
-			BB: BB{
-				valid:   true,
-				percent: true,
-				band:    BandLower,
-				stdDev:  decimal.RequireFromString("1"),
-				sma: SMA{
-					length: 5,
-					valid:  true,
-				},
+		"Successfully validated": {
+			ALMA: ALMA{
+				length: 9,
+				offset: decimal.RequireFromString("0.85"),
+				sigma:  decimal.NewFromInt(6),
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(30),
-				decimal.NewFromInt(35),
-				decimal.NewFromInt(40),
-				decimal.NewFromInt(38),
-				decimal.NewFromInt(32),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.ALMA.validate())
+			if c.Error == nil {
+				assert.True(t, c.ALMA.valid)
+			}
+		})
+	}
+}
+
+func Test_ALMA_Calc(t *testing.T) {
+	cc := map[string]struct {
+		ALMA   ALMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			ALMA: ALMA{
+				valid:  true,
+				length: 3,
+				offset: decimal.RequireFromString("0.5"),
+				sigma:  decimal.NewFromInt(3),
 			},
-			Result: decimal.RequireFromString("-10.53662224"),
+			Data:  series(2, 4),
+			Error: ErrInvalidDataSize,
 		},
-		"Successful calculation with BandWidth": {
-			BB: BB{
+		"Successfully handled division by 0": {
+			ALMA: ALMA{
 				valid:  true,
-				band:   BandWidth,
-				stdDev: decimal.RequireFromString("2"),
-				sma: SMA{
-					length: 20,
-					valid:  true,
+				length: 2,
+				offset: decimal.RequireFromString("0.5"),
+				sigma:  decimal.NewFromInt(1000000),
+			},
+			Data:   series(2, 4),
+			Result: decimal.Zero,
+		},
+		"Successful calculation with centered weights": {
+			ALMA: ALMA{
+				valid:  true,
+				length: 3,
+				offset: decimal.RequireFromString("0.5"),
+				sigma:  decimal.NewFromInt(3),
+			},
+			Data:   series(2, 4, 9),
+			Result: decimal.RequireFromString("4.82220586"),
+		},
+		"Successful calculation": {
+			ALMA: ALMA{
+				valid:  true,
+				length: 3,
+				offset: decimal.NewFromInt(1),
+				sigma:  decimal.NewFromInt(6),
+			},
+			Data:   series(2, 4, 9),
+			Result: decimal.RequireFromString("8.40209373"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ALMA.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_ALMA_Count(t *testing.T) {
+	assert.Equal(t, 9, ALMA{length: 9}.Count())
+}
+
+func Test_ALMA_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameALMA,
+		Input:   InputClose,
+		Overlay: true,
+	}, ALMA{}.Describe())
+}
+
+func Test_NewAPO(t *testing.T) {
+	cc := map[string]struct {
+		Type   MAType
+		Price  Price
+		Fast   int
+		Slow   int
+		Result APO
+		Error  error
+	}{
+		"Invalid lengths": {
+			Type: MATypeSMA,
+			Fast: 3,
+			Slow: 2,
+			Error: &ConfigError{
+				Indicator: NameAPO,
+				Field:     "fast",
+				Value:     3,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid fast moving average": {
+			Type:  MATypeSMA,
+			Fast:  0,
+			Slow:  2,
+			Error: assert.AnError,
+		},
+		"Invalid slow moving average": {
+			Fast: 1,
+			Slow: 2,
+			Error: &ConfigError{
+				Indicator: NameAPO,
+				Field:     "fast",
+				Err:       ErrInvalidMA,
+			},
+		},
+		"Validate returns an error": {
+			Type:  MATypeSMA,
+			Price: 70,
+			Fast:  2,
+			Slow:  3,
+			Error: &ConfigError{
+				Indicator: NameAPO,
+				Field:     "price",
+				Value:     Price(70),
+				Err:       ErrInvalidPrice,
+			},
+		},
+		"Successfully created new APO with default price": {
+			Type: MATypeSMA,
+			Fast: 2,
+			Slow: 3,
+			Result: APO{
+				valid: true,
+				price: PriceClose,
+				macd: MACD{
+					valid: true,
+					ma1:   SMA{valid: true, length: 2},
+					ma2:   SMA{valid: true, length: 3},
+					signal: EMA{
+						valid: true,
+						sma:   SMA{valid: true, length: 9},
+					},
 				},
 			},
-			Data: []decimal.Decimal{
-				decimal.RequireFromString("63.98"),
-				decimal.RequireFromString("64.17"),
-				decimal.RequireFromString("64.71"),
-				decimal.RequireFromString("64.75"),
-				decimal.RequireFromString("63.94"),
-				decimal.RequireFromString("63.82"),
-				decimal.RequireFromString("63.19"),
-				decimal.RequireFromString("62.84"),
-				decimal.RequireFromString("62.25"),
-				decimal.RequireFromString("63.20"),
-				decimal.RequireFromString("63.02"),
-				decimal.RequireFromString("63.35"),
-				decimal.RequireFromString("64.21"),
-				decimal.RequireFromString("64.91"),
-				decimal.RequireFromString("64.05"),
-				decimal.RequireFromString("63.28"),
-				decimal.RequireFromString("62.78"),
-				decimal.RequireFromString("62.36"),
-				decimal.RequireFromString("63.19"),
-				decimal.RequireFromString("64.69"),
+		},
+		"Successfully created new APO": {
+			Type:  MATypeSMA,
+			Price: PriceHL2,
+			Fast:  2,
+			Slow:  3,
+			Result: APO{
+				valid: true,
+				price: PriceHL2,
+				macd: MACD{
+					valid: true,
+					ma1:   SMA{valid: true, length: 2},
+					ma2:   SMA{valid: true, length: 3},
+					signal: EMA{
+						valid: true,
+						sma:   SMA{valid: true, length: 9},
+					},
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewAPO(c.Type, c.Price, c.Fast, c.Slow)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_APO_validate(t *testing.T) {
+	cc := map[string]struct {
+		APO   APO
+		Error error
+	}{
+		"Invalid price": {
+			Error: &ConfigError{
+				Indicator: NameAPO,
+				Field:     "price",
+				Value:     Price(0),
+				Err:       ErrInvalidPrice,
+			},
+		},
+		"Invalid MACD": {
+			APO: APO{
+				price: PriceClose,
+			},
+			Error: &ConfigError{
+				Indicator: NameAPO,
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Successfully validated": {
+			APO: APO{
+				price: PriceClose,
+				macd:  MACD{valid: true},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.APO.validate())
+			if c.Error == nil {
+				assert.True(t, c.APO.valid)
+			}
+		})
+	}
+}
+
+func Test_APO_Calc(t *testing.T) {
+	apo := APO{
+		valid: true,
+		price: PriceClose,
+		macd: MACD{
+			valid: true,
+			ma1:   SMA{valid: true, length: 2},
+			ma2:   SMA{valid: true, length: 3},
+		},
+	}
+
+	cc := map[string]struct {
+		APO    APO
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			APO:   apo,
+			Data:  series(1),
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			APO:    apo,
+			Data:   series(2, 4, 5),
+			Result: decimal.RequireFromString("0.83333333"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.APO.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_APO_CalcCandles(t *testing.T) {
+	apo := APO{
+		valid: true,
+		price: PriceHL2,
+		macd: MACD{
+			valid: true,
+			ma1:   SMA{valid: true, length: 2},
+			ma2:   SMA{valid: true, length: 3},
+		},
+	}
+
+	cc := map[string]struct {
+		APO     APO
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			APO:     apo,
+			Candles: testCandles(t)[4:],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			APO:     apo,
+			Candles: testCandles(t)[2:],
+			Result:  decimal.RequireFromString("0.41666667"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.APO.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_APO_Count(t *testing.T) {
+	assert.Equal(t, 3, APO{
+		macd: MACD{
+			ma1: SMA{length: 2},
+			ma2: SMA{length: 3},
+		},
+	}.Count())
+}
+
+func Test_APO_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameAPO,
+		Input: InputClose,
+	}, APO{}.Describe())
+}
+
+func Test_NewAroon(t *testing.T) {
+	cc := map[string]struct {
+		Trend  Trend
+		Length int
+		Result Aroon
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Aroon": {
+			Trend:  TrendDown,
+			Length: 5,
+			Result: Aroon{
+				valid:  true,
+				trend:  TrendDown,
+				length: 5,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewAroon(c.Trend, c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Aroon_validate(t *testing.T) {
+	cc := map[string]struct {
+		Aroon Aroon
+		Error error
+	}{
+		"Invalid trend": {
+			Aroon: Aroon{
+				valid:  false,
+				trend:  70,
+				length: 5,
+			},
+			Error: &ConfigError{
+				Indicator: NameAroon,
+				Field:     "trend",
+				Value:     Trend(70),
+				Err:       ErrInvalidTrend,
+			},
+		},
+		"Invalid length": {
+			Aroon: Aroon{
+				trend: TrendDown,
+			},
+			Error: &ConfigError{
+				Indicator: NameAroon,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			Aroon: Aroon{
+				trend:  TrendUp,
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Aroon.validate())
+			if c.Error == nil {
+				assert.True(t, c.Aroon.valid)
+			}
+		})
+	}
+}
+
+func Test_Aroon_Calc(t *testing.T) {
+	cc := map[string]struct {
+		Aroon  Aroon
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Aroon: Aroon{
+				valid: false,
+			},
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Aroon: Aroon{
+				valid:  true,
+				trend:  TrendDown,
+				length: 5,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation with TrendUp": {
+			Aroon: Aroon{
+				valid:  true,
+				trend:  TrendUp,
+				length: 5,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(31),
+				decimal.NewFromInt(38),
+				decimal.NewFromInt(35),
+				decimal.NewFromInt(29),
+				decimal.NewFromInt(29),
+			},
+			Result: decimal.NewFromInt(40),
+		},
+		"Successful calculation with TrendDown": {
+			Aroon: Aroon{
+				valid:  true,
+				trend:  TrendDown,
+				length: 5,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(31),
+				decimal.NewFromInt(38),
+				decimal.NewFromInt(35),
+				decimal.NewFromInt(29),
+				decimal.NewFromInt(29),
+			},
+			Result: _hundred,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Aroon.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_Aroon_Count(t *testing.T) {
+	assert.Equal(t, 5, Aroon{
+		length: 5,
+	}.Count())
+}
+
+func Test_Aroon_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameAroon,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}, Aroon{}.Describe())
+}
+
+func Test_NewAroonOsc(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result AroonOsc
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new AroonOsc": {
+			Length: 5,
+			Result: AroonOsc{
+				valid:  true,
+				length: 5,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewAroonOsc(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_AroonOsc_validate(t *testing.T) {
+	cc := map[string]struct {
+		AroonOsc AroonOsc
+		Error    error
+	}{
+		"Invalid length": {
+			Error: &ConfigError{
+				Indicator: NameAroonOsc,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			AroonOsc: AroonOsc{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.AroonOsc.validate())
+			if c.Error == nil {
+				assert.True(t, c.AroonOsc.valid)
+			}
+		})
+	}
+}
+
+func Test_AroonOsc_Calc(t *testing.T) {
+	cc := map[string]struct {
+		AroonOsc AroonOsc
+		Data     []decimal.Decimal
+		Result   decimal.Decimal
+		Error    error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			AroonOsc: AroonOsc{
+				valid:  true,
+				length: 5,
+			},
+			Data:   series(31, 38, 35, 29, 29),
+			Result: decimal.NewFromInt(-60),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.AroonOsc.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_AroonOsc_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		AroonOsc AroonOsc
+		Data     []decimal.Decimal
+		Result   AroonOscLines
+		Error    error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			AroonOsc: AroonOsc{
+				valid:  true,
+				length: 5,
+			},
+			Data:  series(30),
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation with equal data points": {
+			AroonOsc: AroonOsc{
+				valid:  true,
+				length: 3,
+			},
+			Data: series(5, 5, 5),
+			Result: AroonOscLines{
+				Up:         _hundred,
+				Down:       _hundred,
+				Oscillator: decimal.Zero,
+			},
+		},
+		"Successful calculation": {
+			AroonOsc: AroonOsc{
+				valid:  true,
+				length: 5,
+			},
+			Data: series(31, 38, 35, 29, 29),
+			Result: AroonOscLines{
+				Up:         decimal.NewFromInt(40),
+				Down:       _hundred,
+				Oscillator: decimal.NewFromInt(-60),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.AroonOsc.CalcLines(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Up.String(), res.Up.String())
+			assert.Equal(t, c.Result.Down.String(), res.Down.String())
+			assert.Equal(t, c.Result.Oscillator.String(), res.Oscillator.String())
+		})
+	}
+}
+
+func Test_AroonOsc_Count(t *testing.T) {
+	assert.Equal(t, 5, AroonOsc{
+		length: 5,
+	}.Count())
+}
+
+func Test_AroonOsc_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameAroonOsc,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     _hundred.Neg(),
+		Max:     _hundred,
+	}, AroonOsc{}.Describe())
+}
+
+func Test_NewATR(t *testing.T) {
+	cc := map[string]struct {
+		Length    int
+		Smoothing Smoothing
+		Result    ATR
+		Error     error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new ATR": {
+			Length:    5,
+			Smoothing: SmoothingWilder,
+			Result: ATR{
+				valid:     true,
+				length:    5,
+				smoothing: SmoothingWilder,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewATR(c.Length, c.Smoothing)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ATR_validate(t *testing.T) {
+	cc := map[string]struct {
+		ATR   ATR
+		Error error
+	}{
+		"Invalid length": {
+			ATR: ATR{
+				smoothing: SmoothingSMA,
+			},
+			Error: &ConfigError{
+				Indicator: NameATR,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid smoothing": {
+			ATR: ATR{
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameATR,
+				Field:     "smoothing",
+				Value:     Smoothing(0),
+				Err:       ErrInvalidSmoothing,
+			},
+		},
+		"Successfully validated": {
+			ATR: ATR{
+				length:    1,
+				smoothing: SmoothingEMA,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.ATR.validate())
+			if c.Error == nil {
+				assert.True(t, c.ATR.valid)
+			}
+		})
+	}
+}
+
+func Test_ATR_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		ATR     ATR
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			ATR: ATR{
+				valid:     true,
+				length:    2,
+				smoothing: SmoothingSMA,
+			},
+			Candles: testCandles(t)[:1],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation with SmoothingSMA": {
+			ATR: ATR{
+				valid:     true,
+				length:    2,
+				smoothing: SmoothingSMA,
+			},
+			Candles: testCandles(t)[2:],
+			Result:  decimal.NewFromInt(3),
+		},
+		"Successful calculation with SmoothingEMA": {
+			ATR: ATR{
+				valid:     true,
+				length:    2,
+				smoothing: SmoothingEMA,
+			},
+			Candles: testCandles(t)[1:],
+			Result:  decimal.NewFromInt(4),
+		},
+		"Successful calculation with SmoothingWilder": {
+			ATR: ATR{
+				valid:     true,
+				length:    2,
+				smoothing: SmoothingWilder,
+			},
+			Candles: testCandles(t)[1:],
+			Result:  decimal.RequireFromString("3.5"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ATR.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_ATR_Count(t *testing.T) {
+	assert.Equal(t, 6, ATR{
+		length:    5,
+		smoothing: SmoothingSMA,
+	}.Count())
+
+	assert.Equal(t, 10, ATR{
+		length:    5,
+		smoothing: SmoothingWilder,
+	}.Count())
+}
+
+func Test_ATR_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameATR,
+		Input: InputCandle,
+	}, ATR{}.Describe())
+}
+
+func Test_NewBB(t *testing.T) {
+	cc := map[string]struct {
+		Percent bool
+		Band    Band
+		StdDev  decimal.Decimal
+		Length  int
+		Result  BB
+		Error   error
+	}{
+		"NewSMA returns an error": {
+			Error: assert.AnError,
+		},
+		"Validate returns an error": {
+			Length: 1,
+			Error: &ConfigError{
+				Indicator: NameBB,
+				Field:     "band",
+				Value:     Band(0),
+				Err:       ErrInvalidBand,
+			},
+		},
+		"Successfully created new BB": {
+			Percent: true,
+			Band:    BandUpper,
+			StdDev:  decimal.RequireFromString("2.5"),
+			Length:  5,
+			Result: BB{
+				valid:   true,
+				percent: true,
+				band:    BandUpper,
+				stdDev:  decimal.RequireFromString("2.5"),
+				sma: SMA{
+					length: 5,
+					valid:  true,
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewBB(c.Percent, c.Band, c.StdDev, c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_BB_validate(t *testing.T) {
+	cc := map[string]struct {
+		BB    BB
+		Error error
+	}{
+		"Invalid band": {
+			BB: BB{
+				band:   70,
+				stdDev: decimal.Decimal{},
+				sma: SMA{
+					length: 5,
+				},
+			},
+			Error: &ConfigError{
+				Indicator: NameBB,
+				Field:     "band",
+				Value:     Band(70),
+				Err:       ErrInvalidBand,
+			},
+		},
+		"Invalid BB band width configuration": {
+			BB: BB{
+				percent: true,
+				band:    BandWidth,
+				stdDev:  decimal.Decimal{},
+				sma: SMA{
+					length: 1,
+				},
+			},
+			Error: &ConfigError{
+				Indicator: NameBB,
+				Field:     "band",
+				Value:     Band(3),
+				Err:       errors.New("invalid bb configuration"),
+			},
+		},
+		"Successfully validated": {
+			BB: BB{
+				band:   BandUpper,
+				stdDev: decimal.Decimal{},
+				sma: SMA{
+					length: 1,
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.BB.validate())
+			if c.Error == nil {
+				assert.True(t, c.BB.valid)
+			}
+		})
+	}
+}
+
+func Test_BB_Calc(t *testing.T) {
+	cc := map[string]struct {
+		BB     BB
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			BB: BB{
+				valid: false,
+			},
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			BB: BB{
+				valid: true,
+				band:  BandUpper,
+				sma: SMA{
+					valid:  true,
+					length: 5,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation with BandUpper": {
+			BB: BB{
+				valid:  true,
+				band:   BandUpper,
+				stdDev: decimal.RequireFromString("1"),
+				sma: SMA{
+					length: 5,
+					valid:  true,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+				decimal.NewFromInt(35),
+				decimal.NewFromInt(40),
+				decimal.NewFromInt(38),
+				decimal.NewFromInt(32),
+			},
+			Result: decimal.RequireFromString("38.68781778"),
+		},
+		"Successful calculation with BandUpper using percent": {
+			BB: BB{
+				valid:   true,
+				percent: true,
+				band:    BandUpper,
+				stdDev:  decimal.RequireFromString("1"),
+				sma: SMA{
+					length: 5,
+					valid:  true,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+				decimal.NewFromInt(35),
+				decimal.NewFromInt(40),
+				decimal.NewFromInt(38),
+				decimal.NewFromInt(32),
+			},
+			Result: decimal.RequireFromString("10.53662224"),
+		},
+		"Successful calculation with BandLower": {
+			BB: BB{
+				valid:  true,
+				band:   BandLower,
+				stdDev: decimal.RequireFromString("1"),
+				sma: SMA{
+					length: 5,
+					valid:  true,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+				decimal.NewFromInt(35),
+				decimal.NewFromInt(40),
+				decimal.NewFromInt(38),
+				decimal.NewFromInt(32),
+			},
+			Result: decimal.RequireFromString("31.31218222"),
+		},
+		"Successful calculation with BandLower using percent": {
+			BB: BB{
+				valid:   true,
+				percent: true,
+				band:    BandLower,
+				stdDev:  decimal.RequireFromString("1"),
+				sma: SMA{
+					length: 5,
+					valid:  true,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+				decimal.NewFromInt(35),
+				decimal.NewFromInt(40),
+				decimal.NewFromInt(38),
+				decimal.NewFromInt(32),
+			},
+			Result: decimal.RequireFromString("-10.53662224"),
+		},
+		"Successful calculation with BandWidth": {
+			BB: BB{
+				valid:  true,
+				band:   BandWidth,
+				stdDev: decimal.RequireFromString("2"),
+				sma: SMA{
+					length: 20,
+					valid:  true,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.RequireFromString("63.98"),
+				decimal.RequireFromString("64.17"),
+				decimal.RequireFromString("64.71"),
+				decimal.RequireFromString("64.75"),
+				decimal.RequireFromString("63.94"),
+				decimal.RequireFromString("63.82"),
+				decimal.RequireFromString("63.19"),
+				decimal.RequireFromString("62.84"),
+				decimal.RequireFromString("62.25"),
+				decimal.RequireFromString("63.20"),
+				decimal.RequireFromString("63.02"),
+				decimal.RequireFromString("63.35"),
+				decimal.RequireFromString("64.21"),
+				decimal.RequireFromString("64.91"),
+				decimal.RequireFromString("64.05"),
+				decimal.RequireFromString("63.28"),
+				decimal.RequireFromString("62.78"),
+				decimal.RequireFromString("62.36"),
+				decimal.RequireFromString("63.19"),
+				decimal.RequireFromString("64.69"),
+			},
+			Result: decimal.RequireFromString("4.91959301"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.BB.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_BB_bands(t *testing.T) {
+	_, _, _, err := BB{}.bands(nil)
+	assert.Equal(t, ErrInvalidIndicator, err)
+
+	upper, middle, lower, err := BB{
+		stdDev: decimal.NewFromInt(2),
+		sma: SMA{
+			valid:  true,
+			length: 2,
+		},
+	}.bands([]decimal.Decimal{
+		decimal.NewFromInt(3),
+		decimal.NewFromInt(5),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "6", upper.String())
+	assert.Equal(t, "4", middle.String())
+	assert.Equal(t, "2", lower.String())
+}
+
+func Test_BB_Count(t *testing.T) {
+	assert.Equal(t, 1, BB{sma: SMA{length: 1}}.Count())
+}
+
+func Test_BB_Describe(t *testing.T) {
+	cc := map[string]struct {
+		BB     BB
+		Result Description
+	}{
+		"Percent output": {
+			BB: BB{
+				percent: true,
+				band:    BandUpper,
+			},
+			Result: Description{
+				Name:  NameBB,
+				Input: InputClose,
+			},
+		},
+		"Width output": {
+			BB: BB{
+				band: BandWidth,
+			},
+			Result: Description{
+				Name:  NameBB,
+				Input: InputClose,
+			},
+		},
+		"Band output": {
+			BB: BB{
+				band: BandLower,
+				sma: SMA{
+					length: 5,
+				},
+			},
+			Result: Description{
+				Name:    NameBB,
+				Input:   InputClose,
+				Overlay: true,
+				Lag:     decimal.NewFromInt(2),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualDescription(t, c.Result, c.BB.Describe())
+		})
+	}
+}
+
+func Test_NewBBW(t *testing.T) {
+	cc := map[string]struct {
+		StdDev decimal.Decimal
+		Length int
+		Result BBW
+		Error  error
+	}{
+		"NewBB returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new BBW": {
+			StdDev: decimal.NewFromInt(2),
+			Length: 5,
+			Result: BBW{
+				valid: true,
+				bb: BB{
+					valid:  true,
+					band:   BandWidth,
+					stdDev: decimal.NewFromInt(2),
+					sma: SMA{
+						valid:  true,
+						length: 5,
+					},
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewBBW(c.StdDev, c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_BBW_Calc(t *testing.T) {
+	bb := BB{
+		valid:  true,
+		band:   BandWidth,
+		stdDev: decimal.NewFromInt(2),
+		sma: SMA{
+			valid:  true,
+			length: 2,
+		},
+	}
+
+	cc := map[string]struct {
+		BBW    BBW
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			BBW: BBW{
+				valid: true,
+				bb:    bb,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"BB returns an error": {
+			BBW: BBW{
+				valid: true,
+				bb: BB{
+					sma: SMA{
+						length: 2,
+					},
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(5),
+			},
+			Error: ErrInvalidIndicator,
+		},
+		"Successfully handled division by 0": {
+			BBW: BBW{
+				valid: true,
+				bb:    bb,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(-3),
+				decimal.NewFromInt(3),
+			},
+			Result: decimal.Zero,
+		},
+		"Successful calculation": {
+			BBW: BBW{
+				valid: true,
+				bb:    bb,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(5),
+			},
+			Result: decimal.NewFromInt(1),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.BBW.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_BBW_Count(t *testing.T) {
+	assert.Equal(t, 5, BBW{
+		bb: BB{
+			sma: SMA{
+				length: 5,
+			},
+		},
+	}.Count())
+}
+
+func Test_BBW_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameBBW,
+		Input: InputClose,
+	}, BBW{}.Describe())
+}
+
+func Test_NewBeta(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result Beta
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Beta": {
+			Length: 2,
+			Result: Beta{
+				valid:  true,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewBeta(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Beta_validate(t *testing.T) {
+	cc := map[string]struct {
+		Beta  Beta
+		Error error
+	}{
+		"Invalid length": {
+			Beta: Beta{
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameBeta,
+				Field:     "length",
+				Value:     1,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			Beta: Beta{
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Beta.validate())
+			if c.Error == nil {
+				assert.True(t, c.Beta.valid)
+			}
+		})
+	}
+}
+
+func Test_Beta_CalcPair(t *testing.T) {
+	cc := map[string]struct {
+		Beta      Beta
+		Data      []decimal.Decimal
+		Benchmark []decimal.Decimal
+		Result    decimal.Decimal
+		Error     error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Beta:      Beta{valid: true, length: 3},
+			Data:      series(100, 102, 101),
+			Benchmark: series(50, 51, 50, 52),
+			Error:     ErrInvalidDataSize,
+		},
+		"Invalid benchmark size": {
+			Beta:      Beta{valid: true, length: 3},
+			Data:      series(100, 102, 101, 104),
+			Benchmark: series(50, 51, 50),
+			Error:     ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			Beta:      Beta{valid: true, length: 3},
+			Data:      series(100, 102, 101, 104),
+			Benchmark: series(50, 50, 50, 50),
+			Result:    decimal.Zero,
+		},
+		"Successful calculation": {
+			Beta:      Beta{valid: true, length: 3},
+			Data:      series(100, 102, 101, 104),
+			Benchmark: series(50, 51, 50, 52),
+			Result:    decimal.RequireFromString("0.67539527"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Beta.CalcPair(c.Data, c.Benchmark)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_Beta_Count(t *testing.T) {
+	assert.Equal(t, 6, Beta{
+		length: 5,
+	}.Count())
+}
+
+func Test_Beta_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameBeta,
+		Input: InputPair,
+	}, Beta{}.Describe())
+}
+
+func Test_NewBOP(t *testing.T) {
+	bop, err := NewBOP(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, BOP{valid: true}, bop)
+
+	bop, err = NewBOP(SMA{valid: true, length: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, BOP{valid: true, ma: SMA{valid: true, length: 2}}, bop)
+}
+
+func Test_BOP_validate(t *testing.T) {
+	bop := BOP{}
+	assert.NoError(t, bop.validate())
+	assert.True(t, bop.valid)
+}
+
+func Test_BOP_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		BOP     BOP
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			BOP:     BOP{valid: true},
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
+		},
+		"Moving average returns an error": {
+			BOP:     BOP{valid: true, ma: SMA{length: 2}},
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidIndicator,
+		},
+		"Successfully handled division by 0": {
+			BOP:     BOP{valid: true},
+			Candles: []Candle{{}},
+			Result:  decimal.Zero,
+		},
+		"Successful calculation without smoothing": {
+			BOP:     BOP{valid: true},
+			Candles: testCandles(t)[4:],
+			Result:  _one,
+		},
+		"Successful calculation with smoothing": {
+			BOP:     BOP{valid: true, ma: SMA{valid: true, length: 2}},
+			Candles: testCandles(t)[1:3],
+			Result:  decimal.RequireFromString("0.41666667"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.BOP.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_BOP_Count(t *testing.T) {
+	assert.Equal(t, 1, BOP{}.Count())
+	assert.Equal(t, 3, BOP{ma: SMA{length: 3}}.Count())
+}
+
+func Test_BOP_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameBOP,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     _one.Neg(),
+		Max:     _one,
+	}, BOP{}.Describe())
+}
+
+func Test_NewCalmar(t *testing.T) {
+	cc := map[string]struct {
+		Length  int
+		Periods int
+		Result  Calmar
+		Error   error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Calmar": {
+			Length:  2,
+			Periods: 252,
+			Result: Calmar{
+				valid:   true,
+				length:  2,
+				periods: 252,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewCalmar(c.Length, c.Periods)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Calmar_validate(t *testing.T) {
+	cc := map[string]struct {
+		Calmar Calmar
+		Error  error
+	}{
+		"Invalid length": {
+			Calmar: Calmar{
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameCalmar,
+				Field:     "length",
+				Value:     1,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid periods": {
+			Calmar: Calmar{
+				length:  2,
+				periods: -1,
+			},
+			Error: &ConfigError{
+				Indicator: NameCalmar,
+				Field:     "periods",
+				Value:     -1,
+				Err:       errors.New("invalid periods"),
+			},
+		},
+		"Successfully validated": {
+			Calmar: Calmar{
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Calmar.validate())
+			if c.Error == nil {
+				assert.True(t, c.Calmar.valid)
+			}
+		})
+	}
+}
+
+func Test_Calmar_Calc(t *testing.T) {
+	cc := map[string]struct {
+		Calmar Calmar
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Calmar: Calmar{valid: true, length: 4},
+			Data:   series(100, 110, 99),
+			Error:  ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			Calmar: Calmar{valid: true, length: 4},
+			Data:   series(100, 110, 120, 130),
+			Result: decimal.Zero,
+		},
+		"Successful calculation": {
+			Calmar: Calmar{valid: true, length: 4},
+			Data:   series(100, 110, 99, 120),
+			Result: decimal.NewFromInt(2),
+		},
+		"Successful annualized calculation": {
+			Calmar: Calmar{valid: true, length: 4, periods: 6},
+			Data:   series(100, 110, 99, 120),
+			Result: decimal.RequireFromString("4.4"),
+		},
+		"Successful calculation of total loss": {
+			Calmar: Calmar{valid: true, length: 4, periods: 250},
+			Data:   series(10, 20, 5, -5),
+			Result: decimal.RequireFromString("-0.8"),
+		},
+		"Annualized return overflow": {
+			Calmar: Calmar{valid: true, length: 3, periods: 252},
+			Data:   series(2, 1, 2000),
+			Error:  ErrOverflow,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Calmar.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_Calmar_Count(t *testing.T) {
+	assert.Equal(t, 5, Calmar{
+		length: 5,
+	}.Count())
+}
+
+func Test_Calmar_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameCalmar,
+		Input: InputClose,
+	}, Calmar{}.Describe())
+}
+
+func Test_NewCandleStats(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result CandleStats
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new CandleStats": {
+			Length: 2,
+			Result: CandleStats{
+				valid:  true,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewCandleStats(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_CandleStats_validate(t *testing.T) {
+	cc := map[string]struct {
+		CandleStats CandleStats
+		Error       error
+	}{
+		"Invalid length": {
+			Error: &ConfigError{
+				Indicator: NameCandleStats,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			CandleStats: CandleStats{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.CandleStats.validate())
+			if c.Error == nil {
+				assert.True(t, c.CandleStats.valid)
+			}
+		})
+	}
+}
+
+func Test_CandleStats_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		CandleStats CandleStats
+		Candles     []Candle
+		Result      decimal.Decimal
+		Error       error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			CandleStats: CandleStats{
+				valid:  true,
+				length: 5,
+			},
+			Candles: testCandles(t),
+			Result:  decimal.RequireFromString("0.58333333"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.CandleStats.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_CandleStats_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		CandleStats CandleStats
+		Candles     []Candle
+		Result      CandleStatsLines
+		Error       error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			CandleStats: CandleStats{
+				valid:  true,
+				length: 5,
+			},
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			CandleStats: CandleStats{
+				valid:  true,
+				length: 5,
+			},
+			Candles: make([]Candle, 5),
+			Result: CandleStatsLines{
+				Body:  decimal.Zero,
+				Range: decimal.Zero,
+				Ratio: decimal.Zero,
+			},
+		},
+		"Successful calculation": {
+			CandleStats: CandleStats{
+				valid:  true,
+				length: 5,
+			},
+			Candles: testCandles(t),
+			Result: CandleStatsLines{
+				Body:  decimal.RequireFromString("1.4"),
+				Range: decimal.RequireFromString("2.4"),
+				Ratio: decimal.RequireFromString("0.58333333"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.CandleStats.CalcLines(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Body.Round(8).String(), res.Body.Round(8).String())
+			assert.Equal(t, c.Result.Range.Round(8).String(), res.Range.Round(8).String())
+			assert.Equal(t, c.Result.Ratio.Round(8).String(), res.Ratio.Round(8).String())
+		})
+	}
+}
+
+func Test_CandleStats_Count(t *testing.T) {
+	assert.Equal(t, 5, CandleStats{
+		length: 5,
+	}.Count())
+}
+
+func Test_CandleStats_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameCandleStats,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     decimal.NewFromInt(1),
+	}, CandleStats{}.Describe())
+}
+
+func Test_NewCCI(t *testing.T) {
+	cc := map[string]struct {
+		Type   MAType
+		Length int
+		Factor decimal.Decimal
+		Result CCI
+		Error  error
+	}{
+		"NewSMA returns an error": {
+			Error: assert.AnError,
+		},
+		"Invalid provided moving average type": {
+			Length: 1,
+			Error: &ConfigError{
+				Indicator: NameCCI,
+				Field:     "length",
+				Err:       ErrInvalidMA,
+			},
+		},
+		"Invalid factor": {
+			Type:   MATypeSMA,
+			Length: 1,
+			Factor: decimal.RequireFromString("-1"),
+			Error: &ConfigError{
+				Indicator: NameCCI,
+				Field:     "factor",
+				Value:     decimal.NewFromInt(-1),
+				Err:       errors.New("invalid factor"),
+			},
+		},
+		"Successfully created new CCI with default factor": {
+			Type:   MATypeSMA,
+			Length: 10,
+			Factor: decimal.Zero,
+			Result: CCI{
+				valid: true,
+				ma: SMA{
+					length: 10,
+					valid:  true,
+				},
+				factor: decimal.RequireFromString("0.015"),
+			},
+		},
+		"Successfully created new CCI": {
+			Type:   MATypeSMA,
+			Length: 10,
+			Factor: _hundred,
+			Result: CCI{
+				valid: true,
+				ma: SMA{
+					length: 10,
+					valid:  true,
+				},
+				factor: _hundred,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewCCI(c.Type, c.Length, c.Factor)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_CCI_validate(t *testing.T) {
+	cc := map[string]struct {
+		CCI   CCI
+		Error error
+	}{
+		"Invalid factor": {
+			CCI: CCI{
+				valid: false,
+				ma: SMA{
+					length: 1,
+				},
+				factor: decimal.NewFromInt(-1),
+			},
+			Error: &ConfigError{
+				Indicator: NameCCI,
+				Field:     "factor",
+				Value:     decimal.NewFromInt(-1),
+				Err:       errors.New("invalid factor"),
+			},
+		},
+		"Successfully validated": {
+			CCI: CCI{
+				valid: false,
+				ma: SMA{
+					length: 1,
+				},
+				factor: decimal.RequireFromString("1"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.CCI.validate())
+			if c.Error == nil {
+				assert.True(t, c.CCI.valid)
+			}
+		})
+	}
+}
+
+func Test_CCI_Calc(t *testing.T) {
+	cc := map[string]struct {
+		CCI    CCI
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			CCI:   CCI{},
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			CCI: CCI{
+				valid: true,
+				ma: SMA{
+					length: 31,
+				},
+				factor: decimal.RequireFromString("0.015"),
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Invalid SMA calc": {
+			CCI: CCI{
+				valid:  true,
+				ma:     SMA{},
+				factor: decimal.RequireFromString("0.015"),
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: assert.AnError,
+		},
+		"Successful handled division by 0": {
+			CCI: CCI{
+				valid: true,
+				ma: SMA{
+					length: 1,
+					valid:  true,
+				},
+				factor: decimal.RequireFromString("0.015"),
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(3),
+			},
+			Result: decimal.Zero,
+		},
+		"Successful calculation": {
+			CCI: CCI{
+				valid: true,
+				ma: SMA{
+					length: 3,
+					valid:  true,
+				},
+				factor: decimal.RequireFromString("0.015"),
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(6),
+				decimal.NewFromInt(9),
+			},
+			Result: decimal.NewFromInt(100),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.CCI.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_CCI_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		CCI     CCI
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			CCI: CCI{
+				valid:  true,
+				ma:     SMA{valid: true, length: 5},
+				factor: decimal.RequireFromString("0.015"),
+			},
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			CCI: CCI{
+				valid:  true,
+				ma:     SMA{valid: true, length: 5},
+				factor: decimal.RequireFromString("0.015"),
+			},
+			Candles: testCandles(t),
+			Result:  decimal.RequireFromString("166.66666667"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.CCI.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_CCI_Count(t *testing.T) {
+	assert.Equal(t, 10, CCI{
+		ma: SMA{
+			length: 10,
+		},
+	}.Count())
+}
+
+func Test_CCI_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameCCI,
+		Input: InputClose,
+	}, CCI{}.Describe())
+}
+
+func Test_NewCMF(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result CMF
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new CMF": {
+			Length: 2,
+			Result: CMF{
+				valid:  true,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewCMF(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_CMF_validate(t *testing.T) {
+	cc := map[string]struct {
+		CMF   CMF
+		Error error
+	}{
+		"Invalid length": {
+			CMF: CMF{
+				length: 0,
+			},
+			Error: &ConfigError{
+				Indicator: NameCMF,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			CMF: CMF{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.CMF.validate())
+			if c.Error == nil {
+				assert.True(t, c.CMF.valid)
+			}
+		})
+	}
+}
+
+func Test_CMF_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		CMF     CMF
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			CMF: CMF{
+				valid:  true,
+				length: 3,
+			},
+			Candles: testCandles(t)[:1],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			CMF: CMF{
+				valid:  true,
+				length: 2,
+			},
+			Candles: []Candle{{}, {}},
+			Result:  decimal.Zero,
+		},
+		"Successful calculation": {
+			CMF: CMF{
+				valid:  true,
+				length: 3,
+			},
+			Candles: testCandles(t)[2:],
+			Result:  decimal.RequireFromString("-0.17857143"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.CMF.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_CMF_Count(t *testing.T) {
+	assert.Equal(t, 5, CMF{
+		length: 5,
+	}.Count())
+}
+
+func Test_CMF_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameCMF,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.NewFromInt(-1),
+		Max:     decimal.NewFromInt(1),
+	}, CMF{}.Describe())
+}
+
+func Test_NewCMO(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result CMO
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new CMO": {
+			Length: 2,
+			Result: CMO{
+				valid:  true,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewCMO(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_CMO_validate(t *testing.T) {
+	cc := map[string]struct {
+		CMO   CMO
+		Error error
+	}{
+		"Invalid length": {
+			CMO: CMO{
+				length: 0,
+			},
+			Error: &ConfigError{
+				Indicator: NameCMO,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			CMO: CMO{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.CMO.validate())
+			if c.Error == nil {
+				assert.True(t, c.CMO.valid)
+			}
+		})
+	}
+}
+
+func Test_CMO_Calc(t *testing.T) {
+	cc := map[string]struct {
+		CMO    CMO
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			CMO: CMO{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			CMO: CMO{
+				valid:  true,
+				length: 2,
+			},
+			Data:   series(4, 4, 4),
+			Result: decimal.Zero,
+		},
+		"Successful calculation": {
+			CMO: CMO{
+				valid:  true,
+				length: 4,
+			},
+			Data:   series(1, 3, 2, 2, 6),
+			Result: decimal.RequireFromString("71.42857143"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.CMO.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_CMO_Count(t *testing.T) {
+	assert.Equal(t, 6, CMO{
+		length: 5,
+	}.Count())
+}
+
+func Test_CMO_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameCMO,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.NewFromInt(100).Neg(),
+		Max:     decimal.NewFromInt(100),
+	}, CMO{}.Describe())
+}
+
+func Test_NewCorrelation(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result Correlation
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Correlation": {
+			Length: 2,
+			Result: Correlation{
+				valid:  true,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewCorrelation(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Correlation_validate(t *testing.T) {
+	cc := map[string]struct {
+		Correlation Correlation
+		Error       error
+	}{
+		"Invalid length": {
+			Correlation: Correlation{
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameCorrelation,
+				Field:     "length",
+				Value:     1,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			Correlation: Correlation{
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Correlation.validate())
+			if c.Error == nil {
+				assert.True(t, c.Correlation.valid)
+			}
+		})
+	}
+}
+
+func Test_Correlation_CalcPair(t *testing.T) {
+	cc := map[string]struct {
+		Correlation Correlation
+		Data        []decimal.Decimal
+		Benchmark   []decimal.Decimal
+		Result      decimal.Decimal
+		Error       error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Correlation: Correlation{valid: true, length: 3},
+			Data:        series(1, 2),
+			Benchmark:   series(1, 2, 3),
+			Error:       ErrInvalidDataSize,
+		},
+		"Invalid benchmark size": {
+			Correlation: Correlation{valid: true, length: 3},
+			Data:        series(1, 2, 3),
+			Benchmark:   series(1, 2),
+			Error:       ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			Correlation: Correlation{valid: true, length: 4},
+			Data:        series(1, 2, 3, 4),
+			Benchmark:   series(2, 1, 4, 3),
+			Result:      decimal.RequireFromString("0.6"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Correlation.CalcPair(c.Data, c.Benchmark)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_Correlation_Count(t *testing.T) {
+	assert.Equal(t, 5, Correlation{
+		length: 5,
+	}.Count())
+}
+
+func Test_Correlation_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameCorrelation,
+		Input:   InputPair,
+		Bounded: true,
+		Min:     decimal.NewFromInt(-1),
+		Max:     decimal.NewFromInt(1),
+	}, Correlation{}.Describe())
+}
+
+func Test_NewDecycler(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result Decycler
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Decycler": {
+			Length: 3,
+			Result: Decycler{
+				valid:  true,
+				length: 3,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewDecycler(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Decycler_validate(t *testing.T) {
+	cc := map[string]struct {
+		Decycler Decycler
+		Error    error
+	}{
+		"Invalid length": {
+			Decycler: Decycler{
+				length: 2,
+			},
+			Error: &ConfigError{
+				Indicator: NameDecycler,
+				Field:     "length",
+				Value:     2,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			Decycler: Decycler{
+				length: 3,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Decycler.validate())
+			if c.Error == nil {
+				assert.True(t, c.Decycler.valid)
+			}
+		})
+	}
+}
+
+func Test_Decycler_Calc(t *testing.T) {
+	cc := map[string]struct {
+		Decycler Decycler
+		Data     []decimal.Decimal
+		Result   decimal.Decimal
+		Error    error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Decycler: Decycler{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			Decycler: Decycler{
+				valid:  true,
+				length: 3,
+			},
+			Data:   series(1, 2, 4, 7, 11),
+			Result: decimal.RequireFromString("10.70082679"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Decycler.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_Decycler_Count(t *testing.T) {
+	assert.Equal(t, 9, Decycler{
+		length: 5,
+	}.Count())
+}
+
+func Test_Decycler_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameDecycler,
+		Input:   InputClose,
+		Overlay: true,
+	}, Decycler{}.Describe())
+}
+
+func Test_NewDEMA(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result DEMA
+		Error  error
+	}{
+		"NewEMA returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new DEMA": {
+			Length: 1,
+			Result: DEMA{
+				valid: true,
+				ema: EMA{
+					sma: SMA{
+						length: 1,
+						valid:  true,
+					},
+					valid: true,
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewDEMA(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_DEMA_Calc(t *testing.T) {
+	cc := map[string]struct {
+		DEMA   DEMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			DEMA:  DEMA{},
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			DEMA: DEMA{
+				valid: true,
+				ema: EMA{
+					sma: SMA{
+						length: 3,
+						valid:  true,
+					},
+					valid: true,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			DEMA: DEMA{
+				valid: true,
+				ema: EMA{
+					sma: SMA{
+						length: 3,
+						valid:  true,
+					},
+					valid: true,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(31),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(3),
+			},
+			Result: decimal.RequireFromString("6.75"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.DEMA.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_DEMA_Count(t *testing.T) {
+	assert.Equal(t, 29, DEMA{
+		valid: false,
+		ema: EMA{
+			sma: SMA{
+				length: 15,
+			},
+		},
+	}.Count())
+}
+
+func Test_DEMA_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameDEMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.Zero,
+	}, DEMA{}.Describe())
+}
+
+func Test_NewDMI(t *testing.T) {
+	cc := map[string]struct {
+		Trend     Trend
+		Length    int
+		Smoothing Smoothing
+		Result    DMI
+		Error     error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new DMI": {
+			Trend:     TrendDown,
+			Length:    5,
+			Smoothing: SmoothingWilder,
+			Result: DMI{
+				valid:     true,
+				trend:     TrendDown,
+				length:    5,
+				smoothing: SmoothingWilder,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewDMI(c.Trend, c.Length, c.Smoothing)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_DMI_validate(t *testing.T) {
+	cc := map[string]struct {
+		DMI   DMI
+		Error error
+	}{
+		"Invalid trend": {
+			DMI: DMI{
+				length:    1,
+				smoothing: SmoothingSMA,
+			},
+			Error: &ConfigError{
+				Indicator: NameDMI,
+				Field:     "trend",
+				Value:     Trend(0),
+				Err:       ErrInvalidTrend,
+			},
+		},
+		"Invalid length": {
+			DMI: DMI{
+				trend:     TrendUp,
+				smoothing: SmoothingSMA,
+			},
+			Error: &ConfigError{
+				Indicator: NameDMI,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid smoothing": {
+			DMI: DMI{
+				trend:  TrendUp,
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameDMI,
+				Field:     "smoothing",
+				Value:     Smoothing(0),
+				Err:       ErrInvalidSmoothing,
+			},
+		},
+		"Successfully validated": {
+			DMI: DMI{
+				trend:     TrendUp,
+				length:    1,
+				smoothing: SmoothingEMA,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.DMI.validate())
+			if c.Error == nil {
+				assert.True(t, c.DMI.valid)
+			}
+		})
+	}
+}
+
+func Test_DMI_CalcCandles(t *testing.T) {
+	candles := []Candle{
+		{High: decimal.NewFromInt(11), Low: decimal.NewFromInt(7), Close: decimal.NewFromInt(8)},
+		{High: decimal.NewFromInt(10), Low: decimal.NewFromInt(5), Close: decimal.NewFromInt(6)},
+		{High: decimal.NewFromInt(13), Low: decimal.NewFromInt(6), Close: decimal.NewFromInt(12)},
+	}
+
+	cc := map[string]struct {
+		DMI     DMI
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"CalcLines returns an error": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation with TrendUp": {
+			DMI: DMI{
+				valid:     true,
+				trend:     TrendUp,
+				length:    2,
+				smoothing: SmoothingSMA,
+			},
+			Candles: candles,
+			Result:  decimal.NewFromInt(25),
+		},
+		"Successful calculation with TrendDown": {
+			DMI: DMI{
+				valid:     true,
+				trend:     TrendDown,
+				length:    2,
+				smoothing: SmoothingSMA,
+			},
+			Candles: candles,
+			Result:  decimal.RequireFromString("16.66666667"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.DMI.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_DMI_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		DMI     DMI
+		Candles []Candle
+		Plus    decimal.Decimal
+		Minus   decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			DMI: DMI{
+				valid:     true,
+				trend:     TrendUp,
+				length:    2,
+				smoothing: SmoothingSMA,
+			},
+			Candles: testCandles(t)[:1],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			DMI: DMI{
+				valid:     true,
+				trend:     TrendUp,
+				length:    2,
+				smoothing: SmoothingSMA,
+			},
+			Candles: []Candle{
+				{High: decimal.NewFromInt(11), Low: decimal.NewFromInt(7), Close: decimal.NewFromInt(8)},
+				{High: decimal.NewFromInt(10), Low: decimal.NewFromInt(5), Close: decimal.NewFromInt(6)},
+				{High: decimal.NewFromInt(13), Low: decimal.NewFromInt(6), Close: decimal.NewFromInt(12)},
+			},
+			Plus:  decimal.NewFromInt(25),
+			Minus: decimal.RequireFromString("16.66666667"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.DMI.CalcLines(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Plus.Round(8).String(), res.Plus.Round(8).String())
+			assert.Equal(t, c.Minus.Round(8).String(), res.Minus.Round(8).String())
+		})
+	}
+}
+
+func Test_DMI_Count(t *testing.T) {
+	assert.Equal(t, 6, DMI{
+		length:    5,
+		smoothing: SmoothingSMA,
+	}.Count())
+
+	assert.Equal(t, 10, DMI{
+		length:    5,
+		smoothing: SmoothingWilder,
+	}.Count())
+}
+
+func Test_DMI_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameDMI,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     decimal.NewFromInt(100),
+	}, DMI{}.Describe())
+}
+
+func Test_NewDonchian(t *testing.T) {
+	cc := map[string]struct {
+		Band   Band
+		Length int
+		Result Donchian
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Donchian": {
+			Band:   BandUpper,
+			Length: 5,
+			Result: Donchian{
+				valid:  true,
+				band:   BandUpper,
+				length: 5,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewDonchian(c.Band, c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Donchian_validate(t *testing.T) {
+	cc := map[string]struct {
+		Donchian Donchian
+		Error    error
+	}{
+		"Invalid band": {
+			Donchian: Donchian{
+				length: 5,
+			},
+			Error: &ConfigError{
+				Indicator: NameDonchian,
+				Field:     "band",
+				Value:     Band(0),
+				Err:       ErrInvalidBand,
+			},
+		},
+		"Invalid length": {
+			Donchian: Donchian{
+				band: BandUpper,
+			},
+			Error: &ConfigError{
+				Indicator: NameDonchian,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			Donchian: Donchian{
+				band:   BandUpper,
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Donchian.validate())
+			if c.Error == nil {
+				assert.True(t, c.Donchian.valid)
+			}
+		})
+	}
+}
+
+func Test_Donchian_Calc(t *testing.T) {
+	cc := map[string]struct {
+		Donchian Donchian
+		Data     []decimal.Decimal
+		Result   string
+		Error    error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Donchian: Donchian{valid: true, band: BandUpper, length: 3},
+			Data:     series(3, 7),
+			Error:    ErrInvalidDataSize,
+		},
+		"Successful calculation with BandUpper": {
+			Donchian: Donchian{valid: true, band: BandUpper, length: 3},
+			Data:     series(3, 7, 5),
+			Result:   "7",
+		},
+		"Successful calculation with BandLower": {
+			Donchian: Donchian{valid: true, band: BandLower, length: 3},
+			Data:     series(3, 7, 5),
+			Result:   "3",
+		},
+		"Successful calculation with BandWidth": {
+			Donchian: Donchian{valid: true, band: BandWidth, length: 3},
+			Data:     series(3, 7, 5),
+			Result:   "80",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Donchian.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, res.String())
+		})
+	}
+}
+
+func Test_Donchian_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		Donchian Donchian
+		Candles  []Candle
+		Result   string
+		Error    error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation with BandUpper": {
+			Donchian: Donchian{valid: true, band: BandUpper, length: 5},
+			Candles:  testCandles(t),
+			Result:   "15",
+		},
+		"Successful calculation with BandLower": {
+			Donchian: Donchian{valid: true, band: BandLower, length: 5},
+			Candles:  testCandles(t),
+			Result:   "8",
+		},
+		"Successful calculation with BandWidth": {
+			Donchian: Donchian{valid: true, band: BandWidth, length: 5},
+			Candles:  testCandles(t),
+			Result:   "60.8695652173913",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Donchian.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, res.String())
+		})
+	}
+}
+
+func Test_Donchian_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		Donchian Donchian
+		Candles  []Candle
+		Result   DonchianLines
+		Error    error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Donchian: Donchian{valid: true, band: BandUpper, length: 5},
+			Candles:  testCandles(t)[:2],
+			Error:    ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			Donchian: Donchian{valid: true, band: BandUpper, length: 5},
+			Candles:  testCandles(t),
+			Result: DonchianLines{
+				Upper:  decimal.NewFromInt(15),
+				Middle: decimal.RequireFromString("11.5"),
+				Lower:  decimal.NewFromInt(8),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Donchian.CalcLines(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Upper.String(), res.Upper.String())
+			assert.Equal(t, c.Result.Middle.String(), res.Middle.String())
+			assert.Equal(t, c.Result.Lower.String(), res.Lower.String())
+		})
+	}
+}
+
+func Test_Donchian_Count(t *testing.T) {
+	assert.Equal(t, 5, Donchian{length: 5}.Count())
+}
+
+func Test_Donchian_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameDonchian,
+		Input:   InputClose,
+		Overlay: true,
+	}, Donchian{band: BandLower}.Describe())
+
+	assertEqualDescription(t, Description{
+		Name:  NameDonchian,
+		Input: InputClose,
+	}, Donchian{band: BandWidth}.Describe())
+}
+
+func Test_NewDonchianWidth(t *testing.T) {
+	cc := map[string]struct {
+		Length    int
+		Normalize bool
+		Result    DonchianWidth
+		Error     error
+	}{
+		"Invalid Donchian": {
+			Error: &ConfigError{
+				Indicator: NameDonchianWidth,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully created new DonchianWidth": {
+			Length:    2,
+			Normalize: true,
+			Result: DonchianWidth{
+				valid:     true,
+				normalize: true,
+				donchian:  Donchian{valid: true, band: BandWidth, length: 2},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewDonchianWidth(c.Length, c.Normalize)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_DonchianWidth_validate(t *testing.T) {
+	cc := map[string]struct {
+		DonchianWidth DonchianWidth
+		Error         error
+	}{
+		"Invalid Donchian": {
+			Error: &ConfigError{
+				Indicator: NameDonchianWidth,
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Successfully validated": {
+			DonchianWidth: DonchianWidth{
+				donchian: Donchian{valid: true, band: BandWidth, length: 1},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.DonchianWidth.validate())
+			if c.Error == nil {
+				assert.True(t, c.DonchianWidth.valid)
+			}
+		})
+	}
+}
+
+func Test_DonchianWidth_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		DonchianWidth DonchianWidth
+		Candles       []Candle
+		Result        decimal.Decimal
+		Error         error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			DonchianWidth: DonchianWidth{
+				valid:    true,
+				donchian: Donchian{valid: true, band: BandWidth, length: 5},
+			},
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			DonchianWidth: DonchianWidth{
+				valid:     true,
+				normalize: true,
+				donchian:  Donchian{valid: true, band: BandWidth, length: 5},
+			},
+			Candles: make([]Candle, 5),
+			Result:  decimal.Zero,
+		},
+		"Successful calculation": {
+			DonchianWidth: DonchianWidth{
+				valid:    true,
+				donchian: Donchian{valid: true, band: BandWidth, length: 5},
+			},
+			Candles: testCandles(t),
+			Result:  decimal.NewFromInt(7),
+		},
+		"Successful calculation using normalization": {
+			DonchianWidth: DonchianWidth{
+				valid:     true,
+				normalize: true,
+				donchian:  Donchian{valid: true, band: BandWidth, length: 5},
+			},
+			Candles: testCandles(t),
+			Result:  decimal.RequireFromString("0.60869565"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.DonchianWidth.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_DonchianWidth_Count(t *testing.T) {
+	assert.Equal(t, 5, DonchianWidth{
+		donchian: Donchian{length: 5},
+	}.Count())
+}
+
+func Test_DonchianWidth_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameDonchianWidth,
+		Input: InputCandle,
+	}, DonchianWidth{}.Describe())
+}
+
+func Test_NewDYMI(t *testing.T) {
+	cc := map[string]struct {
+		Length  int
+		Stdev   int
+		Average int
+		Min     int
+		Max     int
+		Result  DYMI
+		Error   error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new DYMI": {
+			Length:  14,
+			Stdev:   5,
+			Average: 10,
+			Min:     5,
+			Max:     30,
+			Result: DYMI{
+				valid:   true,
+				length:  14,
+				stdev:   5,
+				average: 10,
+				min:     5,
+				max:     30,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewDYMI(c.Length, c.Stdev, c.Average, c.Min, c.Max)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_DYMI_validate(t *testing.T) {
+	cc := map[string]struct {
+		DYMI  DYMI
+		Error error
+	}{
+		"Invalid length": {
+			DYMI: DYMI{
+				stdev:   2,
+				average: 1,
+				min:     2,
+				max:     2,
+			},
+			Error: &ConfigError{
+				Indicator: NameDYMI,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid standard deviation length": {
+			DYMI: DYMI{
+				length:  1,
+				stdev:   1,
+				average: 1,
+				min:     2,
+				max:     2,
+			},
+			Error: &ConfigError{
+				Indicator: NameDYMI,
+				Field:     "stdev",
+				Value:     1,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid average length": {
+			DYMI: DYMI{
+				length: 1,
+				stdev:  2,
+				min:    2,
+				max:    2,
+			},
+			Error: &ConfigError{
+				Indicator: NameDYMI,
+				Field:     "average",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid min bound": {
+			DYMI: DYMI{
+				length:  1,
+				stdev:   2,
+				average: 1,
+				min:     1,
+				max:     2,
+			},
+			Error: &ConfigError{
+				Indicator: NameDYMI,
+				Field:     "min",
+				Value:     1,
+				Err:       errors.New("invalid bounds"),
+			},
+		},
+		"Invalid max bound": {
+			DYMI: DYMI{
+				length:  1,
+				stdev:   2,
+				average: 1,
+				min:     3,
+				max:     2,
+			},
+			Error: &ConfigError{
+				Indicator: NameDYMI,
+				Field:     "max",
+				Value:     2,
+				Err:       errors.New("invalid bounds"),
+			},
+		},
+		"Successfully validated": {
+			DYMI: DYMI{
+				length:  1,
+				stdev:   2,
+				average: 1,
+				min:     2,
+				max:     2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.DYMI.validate())
+			if c.Error == nil {
+				assert.True(t, c.DYMI.valid)
+			}
+		})
+	}
+}
+
+func Test_DYMI_Calc(t *testing.T) {
+	cc := map[string]struct {
+		DYMI   DYMI
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			DYMI: DYMI{
+				valid:   true,
+				length:  3,
+				stdev:   2,
+				average: 2,
+				min:     2,
+				max:     4,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation without volatility": {
+			DYMI: DYMI{
+				valid:   true,
+				length:  3,
+				stdev:   2,
+				average: 2,
+				min:     2,
+				max:     4,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(4),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(1),
+			},
+			Result: decimal.Zero,
+		},
+		"Successful calculation with length limited by max bound": {
+			DYMI: DYMI{
+				valid:   true,
+				length:  3,
+				stdev:   2,
+				average: 2,
+				min:     2,
+				max:     4,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(4),
+				decimal.NewFromInt(3),
+			},
+			Result: decimal.NewFromInt(75),
+		},
+		"Successful calculation with length limited by min bound": {
+			DYMI: DYMI{
+				valid:   true,
+				length:  3,
+				stdev:   2,
+				average: 2,
+				min:     2,
+				max:     4,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(4),
+			},
+			Result: decimal.NewFromInt(100),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.DYMI.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_DYMI_Count(t *testing.T) {
+	assert.Equal(t, 30, DYMI{
+		stdev:   5,
+		average: 10,
+		max:     30,
+	}.Count())
+
+	assert.Equal(t, 14, DYMI{
+		stdev:   5,
+		average: 10,
+		max:     8,
+	}.Count())
+}
+
+func Test_DYMI_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameDYMI,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     decimal.NewFromInt(100),
+	}, DYMI{}.Describe())
+}
+
+func Test_NewElderRay(t *testing.T) {
+	cc := map[string]struct {
+		Trend  Trend
+		Length int
+		Result ElderRay
+		Error  error
+	}{
+		"NewEMA returns an error": {
+			Trend: TrendUp,
+			Error: assert.AnError,
+		},
+		"Validate returns an error": {
+			Length: 1,
+			Error: &ConfigError{
+				Indicator: NameElderRay,
+				Field:     "trend",
+				Value:     Trend(0),
+				Err:       ErrInvalidTrend,
+			},
+		},
+		"Successfully created new ElderRay": {
+			Trend:  TrendDown,
+			Length: 1,
+			Result: ElderRay{
+				valid: true,
+				trend: TrendDown,
+				ema: EMA{
+					sma: SMA{
+						length: 1,
+						valid:  true,
+					},
+					valid: true,
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewElderRay(c.Trend, c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ElderRay_validate(t *testing.T) {
+	cc := map[string]struct {
+		ElderRay ElderRay
+		Error    error
+	}{
+		"Invalid trend": {
+			Error: &ConfigError{
+				Indicator: NameElderRay,
+				Field:     "trend",
+				Value:     Trend(0),
+				Err:       ErrInvalidTrend,
+			},
+		},
+		"Successfully validated": {
+			ElderRay: ElderRay{
+				trend: TrendUp,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.ElderRay.validate())
+			if c.Error == nil {
+				assert.True(t, c.ElderRay.valid)
+			}
+		})
+	}
+}
+
+func Test_ElderRay_CalcCandles(t *testing.T) {
+	ema := EMA{
+		sma: SMA{
+			length: 2,
+			valid:  true,
+		},
+		valid: true,
+	}
+
+	cc := map[string]struct {
+		ElderRay ElderRay
+		Candles  []Candle
+		Result   decimal.Decimal
+		Error    error
+	}{
+		"CalcLines returns an error": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation with TrendUp": {
+			ElderRay: ElderRay{
+				valid: true,
+				trend: TrendUp,
+				ema:   ema,
+			},
+			Candles: testCandles(t)[2:],
+			Result:  decimal.RequireFromString("2.16666667"),
+		},
+		"Successful calculation with TrendDown": {
+			ElderRay: ElderRay{
+				valid: true,
+				trend: TrendDown,
+				ema:   ema,
+			},
+			Candles: testCandles(t)[2:],
+			Result:  decimal.RequireFromString("-1.83333333"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ElderRay.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_ElderRay_CalcLines(t *testing.T) {
+	ema := EMA{
+		sma: SMA{
+			length: 2,
+			valid:  true,
+		},
+		valid: true,
+	}
+
+	cc := map[string]struct {
+		ElderRay ElderRay
+		Candles  []Candle
+		Bull     decimal.Decimal
+		Bear     decimal.Decimal
+		Error    error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			ElderRay: ElderRay{
+				valid: true,
+				trend: TrendUp,
+				ema:   ema,
+			},
+			Candles: testCandles(t)[:1],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			ElderRay: ElderRay{
+				valid: true,
+				trend: TrendUp,
+				ema:   ema,
+			},
+			Candles: testCandles(t)[2:],
+			Bull:    decimal.RequireFromString("2.16666667"),
+			Bear:    decimal.RequireFromString("-1.83333333"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ElderRay.CalcLines(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Bull.Round(8).String(), res.Bull.Round(8).String())
+			assert.Equal(t, c.Bear.Round(8).String(), res.Bear.Round(8).String())
+		})
+	}
+}
+
+func Test_ElderRay_Count(t *testing.T) {
+	assert.Equal(t, 9, ElderRay{
+		ema: EMA{
+			sma: SMA{
+				length: 5,
+			},
+		},
+	}.Count())
+}
+
+func Test_ElderRay_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameElderRay,
+		Input: InputCandle,
+	}, ElderRay{}.Describe())
+}
+
+func Test_NewEMA(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result EMA
+		Error  error
+	}{
+		"Invalid parameters": {
+			Error: assert.AnError,
+		},
+		"Successfully created new EMA": {
+			Length: 1,
+			Result: EMA{
+				valid: true,
+				sma: SMA{
+					length: 1,
+					valid:  true,
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewEMA(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_EMA_Calc(t *testing.T) {
+	cc := map[string]struct {
+		EMA    EMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			EMA:   EMA{},
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			EMA: EMA{
+				valid: true,
+				sma: SMA{
+					length: 3,
+					valid:  true,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			EMA: EMA{
+				valid: true,
+				sma: SMA{
+					length: 3,
+					valid:  true,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(31),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(3),
+			},
+			Result: decimal.RequireFromString("4.75"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.EMA.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_EMA_CalcNext(t *testing.T) {
+	cc := map[string]struct {
+		EMA    EMA
+		Last   decimal.Decimal
+		Next   decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			EMA:   EMA{},
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			EMA: EMA{
+				valid: true,
+				sma: SMA{
+					length: 3,
+					valid:  true,
+				},
+			},
+			Last:   decimal.NewFromInt(5),
+			Next:   decimal.NewFromInt(5),
+			Result: decimal.NewFromInt(5),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.EMA.CalcNext(c.Last, c.Next)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_EMA_Count(t *testing.T) {
+	assert.Equal(t, 29, EMA{
+		sma: SMA{
+			length: 15,
+		},
+	}.Count())
+}
+
+func Test_EMA_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameEMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.NewFromInt(2),
+	}, EMA{
+		sma: SMA{
+			length: 5,
+		},
+	}.Describe())
+}
+
+func Test_EMA_series(t *testing.T) {
+	ema := EMA{
+		valid: true,
+		sma: SMA{
+			valid:  true,
+			length: 2,
+		},
+	}
+
+	cc := map[string]struct {
+		EMA    EMA
+		Data   []decimal.Decimal
+		Result []string
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			EMA:   ema,
+			Data:  series(1),
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			EMA:    ema,
+			Data:   series(1, 2, 3, 4, 5),
+			Result: []string{"1.5", "2.5", "3.5", "4.5"},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.EMA.series(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			for i := range res {
+				res[i] = res[i].Round(8)
+			}
+
+			assert.Equal(t, c.Result, decimalStrings(res))
+		})
+	}
+}
+
+func Test_EMA_multiplier(t *testing.T) {
+	assert.Equal(t, decimal.RequireFromString("0.5").String(), EMA{
+		sma: SMA{
+			length: 3,
+		},
+	}.multiplier().String())
+}
+
+func Test_NewER(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result ER
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new ER": {
+			Length: 2,
+			Result: ER{
+				valid:  true,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewER(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ER_validate(t *testing.T) {
+	cc := map[string]struct {
+		ER    ER
+		Error error
+	}{
+		"Invalid length": {
+			ER: ER{
+				length: 0,
+			},
+			Error: &ConfigError{
+				Indicator: NameER,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			ER: ER{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.ER.validate())
+			if c.Error == nil {
+				assert.True(t, c.ER.valid)
+			}
+		})
+	}
+}
+
+func Test_ER_Calc(t *testing.T) {
+	cc := map[string]struct {
+		ER     ER
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			ER: ER{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			ER: ER{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(2),
+			},
+			Result: decimal.Zero,
+		},
+		"Successful calculation": {
+			ER: ER{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(4),
+			},
+			Result: decimal.RequireFromString("0.6"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ER.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_ER_Count(t *testing.T) {
+	assert.Equal(t, 6, ER{
+		length: 5,
+	}.Count())
+}
+
+func Test_ER_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameER,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     decimal.NewFromInt(1),
+	}, ER{}.Describe())
+}
+
+func Test_NewErgodic(t *testing.T) {
+	cc := map[string]struct {
+		Long   int
+		Short  int
+		Signal int
+		Result Ergodic
+		Error  error
+	}{
+		"NewTSI returns an error": {
+			Short:  2,
+			Signal: 2,
+			Error:  assert.AnError,
+		},
+		"Successfully created new Ergodic": {
+			Long:   2,
+			Short:  2,
+			Signal: 2,
+			Result: Ergodic{
+				valid: true,
+				tsi:   testTSI(),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewErgodic(c.Long, c.Short, c.Signal)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Ergodic_validate(t *testing.T) {
+	cc := map[string]struct {
+		Ergodic Ergodic
+		Error   error
+	}{
+		"Invalid TSI": {
+			Error: &ConfigError{
+				Indicator: NameErgodic,
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Successfully validated": {
+			Ergodic: Ergodic{
+				tsi: testTSI(),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Ergodic.validate())
+			if c.Error == nil {
+				assert.True(t, c.Ergodic.valid)
+			}
+		})
+	}
+}
+
+func Test_Ergodic_Calc(t *testing.T) {
+	cc := map[string]struct {
+		Ergodic Ergodic
+		Data    []decimal.Decimal
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			Ergodic: Ergodic{valid: true, tsi: testTSI()},
+			Data:    series(1, 3, 2, 5, 4, 8),
+			Result:  decimal.RequireFromString("8.45679012"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Ergodic.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_Ergodic_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		Ergodic Ergodic
+		Data    []decimal.Decimal
+		Result  ErgodicLines
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Ergodic: Ergodic{valid: true, tsi: testTSI()},
+			Data:    series(1, 2, 3),
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			Ergodic: Ergodic{valid: true, tsi: testTSI()},
+			Data:    series(1, 3, 2, 5, 4, 8),
+			Result: ErgodicLines{
+				Ergodic:    decimal.RequireFromString("73.14814815"),
+				Signal:     decimal.RequireFromString("64.69135802"),
+				Oscillator: decimal.RequireFromString("8.45679012"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Ergodic.CalcLines(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Ergodic.Round(8).String(), res.Ergodic.Round(8).String())
+			assert.Equal(t, c.Result.Signal.Round(8).String(), res.Signal.Round(8).String())
+			assert.Equal(t, c.Result.Oscillator.Round(8).String(), res.Oscillator.Round(8).String())
+		})
+	}
+}
+
+func Test_Ergodic_Count(t *testing.T) {
+	assert.Equal(t, 6, Ergodic{tsi: testTSI()}.Count())
+}
+
+func Test_Ergodic_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameErgodic,
+		Input: InputClose,
+	}, Ergodic{}.Describe())
+}
+
+func Test_NewForceIndex(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result ForceIndex
+		Error  error
+	}{
+		"NewEMA returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new ForceIndex": {
+			Length: 2,
+			Result: ForceIndex{
+				valid: true,
+				ema: EMA{
+					sma: SMA{
+						length: 2,
+						valid:  true,
+					},
+					valid: true,
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewForceIndex(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ForceIndex_validate(t *testing.T) {
+	cc := map[string]struct {
+		ForceIndex ForceIndex
+		Error      error
+	}{
+		"Invalid EMA": {
+			Error: &ConfigError{
+				Indicator: NameForceIndex,
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Successfully validated": {
+			ForceIndex: ForceIndex{
+				ema: EMA{valid: true},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.ForceIndex.validate())
+			if c.Error == nil {
+				assert.True(t, c.ForceIndex.valid)
+			}
+		})
+	}
+}
+
+func Test_ForceIndex_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		ForceIndex ForceIndex
+		Candles    []Candle
+		Result     decimal.Decimal
+		Error      error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			ForceIndex: ForceIndex{
+				valid: true,
+				ema: EMA{
+					sma:   SMA{valid: true, length: 2},
+					valid: true,
+				},
+			},
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation of raw force index": {
+			ForceIndex: ForceIndex{
+				valid: true,
+				ema: EMA{
+					sma:   SMA{valid: true, length: 1},
+					valid: true,
+				},
+			},
+			Candles: testCandles(t)[3:],
+			Result:  decimal.NewFromInt(1000),
+		},
+		"Successful calculation": {
+			ForceIndex: ForceIndex{
+				valid: true,
+				ema: EMA{
+					sma:   SMA{valid: true, length: 2},
+					valid: true,
+				},
+			},
+			Candles: testCandles(t)[1:],
+			Result:  decimal.RequireFromString("641.66666667"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ForceIndex.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_ForceIndex_Count(t *testing.T) {
+	assert.Equal(t, 10, ForceIndex{
+		ema: EMA{
+			sma: SMA{
+				length: 5,
+			},
+		},
+	}.Count())
+}
+
+func Test_ForceIndex_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameForceIndex,
+		Input: InputCandle,
+	}, ForceIndex{}.Describe())
+}
+
+func Test_NewFRAMA(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result FRAMA
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new FRAMA": {
+			Length: 4,
+			Result: FRAMA{
+				valid:  true,
+				length: 4,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewFRAMA(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_FRAMA_validate(t *testing.T) {
+	cc := map[string]struct {
+		FRAMA FRAMA
+		Error error
+	}{
+		"Invalid length": {
+			FRAMA: FRAMA{
+				length: 2,
+			},
+			Error: &ConfigError{
+				Indicator: NameFRAMA,
+				Field:     "length",
+				Value:     2,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Odd length": {
+			FRAMA: FRAMA{
+				length: 5,
+			},
+			Error: &ConfigError{
+				Indicator: NameFRAMA,
+				Field:     "length",
+				Value:     5,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			FRAMA: FRAMA{
+				length: 4,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.FRAMA.validate())
+			if c.Error == nil {
+				assert.True(t, c.FRAMA.valid)
+			}
+		})
+	}
+}
+
+func Test_FRAMA_Calc(t *testing.T) {
+	cc := map[string]struct {
+		FRAMA  FRAMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			FRAMA: FRAMA{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successfully handled flat window": {
+			FRAMA: FRAMA{
+				valid:  true,
+				length: 4,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(9),
+			},
+			Result: decimal.NewFromInt(9),
+		},
+		"Successful calculation": {
+			FRAMA: FRAMA{
+				valid:  true,
+				length: 4,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(4),
+				decimal.NewFromInt(6),
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(7),
+			},
+			Result: decimal.RequireFromString("5.29640595"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.FRAMA.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_FRAMA_Count(t *testing.T) {
+	assert.Equal(t, 9, FRAMA{
+		length: 5,
+	}.Count())
+}
+
+func Test_FRAMA_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameFRAMA,
+		Input:   InputClose,
+		Overlay: true,
+	}, FRAMA{}.Describe())
+}
+
+func Test_NewGap(t *testing.T) {
+	cc := map[string]struct {
+		Threshold decimal.Decimal
+		Result    Gap
+		Error     error
+	}{
+		"Validate returns an error": {
+			Threshold: decimal.NewFromInt(-1),
+			Error:     assert.AnError,
+		},
+		"Successfully created new Gap": {
+			Threshold: decimal.NewFromInt(2),
+			Result: Gap{
+				valid:     true,
+				threshold: decimal.NewFromInt(2),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewGap(c.Threshold)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Gap_validate(t *testing.T) {
+	cc := map[string]struct {
+		Gap   Gap
+		Error error
+	}{
+		"Invalid threshold": {
+			Gap: Gap{
+				threshold: decimal.NewFromInt(-1),
+			},
+			Error: &ConfigError{
+				Indicator: NameGap,
+				Field:     "threshold",
+				Value:     decimal.NewFromInt(-1),
+				Err:       errors.New("invalid threshold"),
+			},
+		},
+		"Successfully validated": {},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Gap.validate())
+			if c.Error == nil {
+				assert.True(t, c.Gap.valid)
+			}
+		})
+	}
+}
+
+func Test_Gap_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		Gap     Gap
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			Gap: Gap{
+				valid: true,
+			},
+			Candles: []Candle{
+				{Close: decimal.NewFromInt(10)},
+				{Open: decimal.RequireFromString("9.5")},
+			},
+			Result: decimal.NewFromInt(-5),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Gap.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_Gap_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		Gap     Gap
+		Candles []Candle
+		Result  GapLines
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Gap: Gap{
+				valid: true,
+			},
+			Candles: testCandles(t),
+			Error:   ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			Gap: Gap{
+				valid: true,
+			},
+			Candles: []Candle{
+				{},
+				{Open: decimal.NewFromInt(10)},
+			},
+			Result: GapLines{
+				Percent: decimal.Zero,
+			},
+		},
+		"Successful calculation with gap below threshold": {
+			Gap: Gap{
+				valid:     true,
+				threshold: decimal.NewFromInt(10),
+			},
+			Candles: []Candle{
+				{Close: decimal.NewFromInt(10)},
+				{Open: decimal.NewFromInt(11)},
+			},
+			Result: GapLines{
+				Percent: decimal.NewFromInt(10),
+			},
+		},
+		"Successful calculation with gap above threshold": {
+			Gap: Gap{
+				valid:     true,
+				threshold: decimal.NewFromInt(2),
+			},
+			Candles: []Candle{
+				{Close: decimal.NewFromInt(10)},
+				{Open: decimal.RequireFromString("9.5")},
+			},
+			Result: GapLines{
+				Percent: decimal.NewFromInt(-5),
+				Flagged: true,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Gap.CalcLines(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Flagged, res.Flagged)
+			assert.Equal(t, c.Result.Percent.Round(8).String(), res.Percent.Round(8).String())
+		})
+	}
+}
+
+func Test_Gap_Count(t *testing.T) {
+	assert.Equal(t, 2, Gap{}.Count())
+}
+
+func Test_Gap_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameGap,
+		Input: InputCandle,
+	}, Gap{}.Describe())
+}
+
+func Test_NewGator(t *testing.T) {
+	cc := map[string]struct {
+		Jaw    Indicator
+		Teeth  Indicator
+		Lips   Indicator
+		Result Gator
+		Error  error
+	}{
+		"NewAlligator returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Gator": {
+			Jaw:   testAlligator().jaw,
+			Teeth: testAlligator().teeth,
+			Lips:  testAlligator().lips,
+			Result: Gator{
+				valid:     true,
+				alligator: testAlligator(),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewGator(c.Jaw, c.Teeth, c.Lips)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Gator_validate(t *testing.T) {
+	cc := map[string]struct {
+		Gator Gator
+		Error error
+	}{
+		"Invalid Alligator": {
+			Error: &ConfigError{
+				Indicator: NameGator,
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Successfully validated": {
+			Gator: Gator{
+				alligator: testAlligator(),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Gator.validate())
+			if c.Error == nil {
+				assert.True(t, c.Gator.valid)
+			}
+		})
+	}
+}
+
+func Test_Gator_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		Gator   Gator
+		Candles []Candle
+		Result  GatorLines
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Alligator returns an error": {
+			Gator:   Gator{valid: true, alligator: testAlligator()},
+			Candles: testCandles(t)[:4],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			Gator:   Gator{valid: true, alligator: testAlligator()},
+			Candles: testCandles(t),
+			Result: GatorLines{
+				Upper: decimal.RequireFromString("0.66666667"),
+				Lower: decimal.RequireFromString("-2.5"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Gator.CalcLines(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Upper.Round(8).String(), res.Upper.Round(8).String())
+			assert.Equal(t, c.Result.Lower.Round(8).String(), res.Lower.Round(8).String())
+		})
+	}
+}
+
+func Test_Gator_Count(t *testing.T) {
+	assert.Equal(t, 5, Gator{alligator: testAlligator()}.Count())
+}
+
+func Test_Gator_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameGator,
+		Input: InputCandle,
+	}, Gator{}.Describe())
+}
+
+func Test_NewGMMA(t *testing.T) {
+	gmma, err := NewGMMA()
+	assert.NoError(t, err)
+	assert.True(t, gmma.valid)
+	assert.True(t, gmma.short.valid)
+	assert.Len(t, gmma.short.mas, 6)
+	assert.Equal(t, EMA{valid: true, sma: SMA{valid: true, length: 3}}, gmma.short.mas[0])
+	assert.Equal(t, EMA{valid: true, sma: SMA{valid: true, length: 15}}, gmma.short.mas[5])
+	assert.True(t, gmma.long.valid)
+	assert.Len(t, gmma.long.mas, 6)
+	assert.Equal(t, EMA{valid: true, sma: SMA{valid: true, length: 30}}, gmma.long.mas[0])
+	assert.Equal(t, EMA{valid: true, sma: SMA{valid: true, length: 60}}, gmma.long.mas[5])
+}
+
+func Test_GMMA_validate(t *testing.T) {
+	cc := map[string]struct {
+		GMMA  GMMA
+		Error error
+	}{
+		"Invalid short ribbon": {
+			GMMA: GMMA{
+				long: Ribbon{valid: true},
+			},
+			Error: &ConfigError{
+				Indicator: NameGMMA,
+				Field:     "short",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Invalid long ribbon": {
+			GMMA: GMMA{
+				short: Ribbon{valid: true},
+			},
+			Error: &ConfigError{
+				Indicator: NameGMMA,
+				Field:     "long",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Successfully validated": {
+			GMMA: GMMA{
+				short: Ribbon{valid: true},
+				long:  Ribbon{valid: true},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.GMMA.validate())
+			if c.Error == nil {
+				assert.True(t, c.GMMA.valid)
+			}
+		})
+	}
+}
+
+func Test_GMMA_Calc(t *testing.T) {
+	gmma, err := NewGMMA()
+	require.NoError(t, err)
+
+	dd := make([]decimal.Decimal, gmma.Count())
+	for i := range dd {
+		dd[i] = decimal.NewFromInt(int64(i))
+	}
+
+	cc := map[string]struct {
+		GMMA   GMMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			GMMA:   gmma,
+			Data:   dd,
+			Result: decimal.RequireFromString("17.25"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.GMMA.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_GMMA_CalcLines(t *testing.T) {
+	gmma, err := NewGMMA()
+	require.NoError(t, err)
+
+	dd := make([]decimal.Decimal, gmma.Count())
+	for i := range dd {
+		dd[i] = decimal.NewFromInt(int64(i))
+	}
+
+	cc := map[string]struct {
+		GMMA   GMMA
+		Data   []decimal.Decimal
+		Short  []string
+		Long   []string
+		Result string
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			GMMA:  gmma,
+			Data:  dd[1:],
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			GMMA:   gmma,
+			Data:   dd,
+			Short:  []string{"117", "116", "114.5", "113.5", "112.5", "111"},
+			Long:   []string{"103.5", "101", "98.5", "96", "93.5", "88.5"},
+			Result: "17.25",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.GMMA.CalcLines(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			for i := range res.Short {
+				assert.Equal(t, c.Short[i], res.Short[i].Round(8).String())
+				assert.Equal(t, c.Long[i], res.Long[i].Round(8).String())
+			}
+
+			assert.Equal(t, c.Result, res.Separation.Round(8).String())
+		})
+	}
+}
+
+func Test_GMMA_Count(t *testing.T) {
+	gmma, err := NewGMMA()
+	require.NoError(t, err)
+	assert.Equal(t, 119, gmma.Count())
+}
+
+func Test_GMMA_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameGMMA,
+		Input: InputClose,
+	}, GMMA{}.Describe())
+}
+
+func Test_NewHighest(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result Highest
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Highest": {
+			Length: 2,
+			Result: Highest{
+				valid:  true,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewHighest(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Highest_validate(t *testing.T) {
+	cc := map[string]struct {
+		Highest Highest
+		Error   error
+	}{
+		"Invalid length": {
+			Highest: Highest{
+				length: 0,
+			},
+			Error: &ConfigError{
+				Indicator: NameHighest,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			Highest: Highest{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Highest.validate())
+			if c.Error == nil {
+				assert.True(t, c.Highest.valid)
+			}
+		})
+	}
+}
+
+func Test_Highest_Calc(t *testing.T) {
+	cc := map[string]struct {
+		Highest Highest
+		Data    []decimal.Decimal
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Highest: Highest{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			Highest: Highest{
+				valid:  true,
+				length: 3,
+			},
+			Data:   series(2, 5, 1),
+			Result: decimal.NewFromInt(5),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Highest.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_Highest_Series(t *testing.T) {
+	cc := map[string]struct {
+		Highest Highest
+		Data    []decimal.Decimal
+		Result  []string
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Highest: Highest{
+				valid:  true,
+				length: 3,
+			},
+			Data:  series(1, 2),
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			Highest: Highest{
+				valid:  true,
+				length: 3,
+			},
+			Data:   series(1, 3, 2, 2, 1, 5, 4),
+			Result: []string{"3", "3", "2", "5", "5"},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Highest.Series(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, decimalStrings(res))
+		})
+	}
+}
+
+func Test_Highest_Count(t *testing.T) {
+	assert.Equal(t, 5, Highest{
+		length: 5,
+	}.Count())
+}
+
+func Test_Highest_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameHighest,
+		Input:   InputClose,
+		Overlay: true,
+	}, Highest{}.Describe())
+}
+
+func Test_NewHilbertPeriod(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result HilbertPeriod
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new HilbertPeriod": {
+			Length: 7,
+			Result: HilbertPeriod{
+				valid:  true,
+				length: 7,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewHilbertPeriod(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_HilbertPeriod_validate(t *testing.T) {
+	cc := map[string]struct {
+		HilbertPeriod HilbertPeriod
+		Error         error
+	}{
+		"Invalid length": {
+			HilbertPeriod: HilbertPeriod{
+				length: 6,
+			},
+			Error: &ConfigError{
+				Indicator: NameHilbertPeriod,
+				Field:     "length",
+				Value:     6,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			HilbertPeriod: HilbertPeriod{
+				length: 7,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.HilbertPeriod.validate())
+			if c.Error == nil {
+				assert.True(t, c.HilbertPeriod.valid)
+			}
+		})
+	}
+}
+
+func Test_HilbertPeriod_Calc(t *testing.T) {
+	cc := map[string]struct {
+		HilbertPeriod HilbertPeriod
+		Data          []decimal.Decimal
+		Result        decimal.Decimal
+		Error         error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			HilbertPeriod: HilbertPeriod{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			HilbertPeriod: HilbertPeriod{
+				valid:  true,
+				length: 20,
+			},
+			Data:   series(1, 3, 5, 7, 9, 7, 5, 3, 1, 3, 5, 7, 9, 7, 5, 3, 1, 3, 5, 7),
+			Result: decimal.RequireFromString("8.28327745"),
+		},
+		"Successful calculation with shifted price level": {
+			HilbertPeriod: HilbertPeriod{
+				valid:  true,
+				length: 20,
+			},
+			Data:   series(101, 103, 105, 107, 109, 107, 105, 103, 101, 103, 105, 107, 109, 107, 105, 103, 101, 103, 105, 107),
+			Result: decimal.RequireFromString("8.28327745"),
+		},
+		"Successful calculation with flat data points at zero": {
+			HilbertPeriod: HilbertPeriod{
+				valid:  true,
+				length: 20,
+			},
+			Data:   series(0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0),
+			Result: decimal.NewFromInt(6),
+		},
+		"Successful calculation with flat data points at five": {
+			HilbertPeriod: HilbertPeriod{
+				valid:  true,
+				length: 20,
+			},
+			Data:   series(5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5),
+			Result: decimal.NewFromInt(6),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.HilbertPeriod.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_HilbertPeriod_Count(t *testing.T) {
+	assert.Equal(t, 5, HilbertPeriod{
+		length: 5,
+	}.Count())
+}
+
+func Test_HilbertPeriod_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameHilbertPeriod,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.NewFromInt(6),
+		Max:     decimal.NewFromInt(50),
+	}, HilbertPeriod{}.Describe())
+}
+
+func Test_NewHMA(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result HMA
+		Error  error
+	}{
+		"NewWMA returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new HMA": {
+			Length: 2,
+			Result: HMA{
+				valid: true,
+				wma: WMA{
+					length: 2,
+					valid:  true,
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewHMA(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_HMA_Calc(t *testing.T) {
+	cc := map[string]struct {
+		HMA    HMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			HMA:   HMA{},
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			HMA: HMA{
+				valid: true,
+				wma: WMA{
+					length: 5,
+					valid:  true,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			HMA: HMA{
+				valid: true,
+				wma: WMA{
+					length: 4,
+					valid:  true,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(32),
+				decimal.NewFromInt(29),
+				decimal.NewFromInt(38),
+				decimal.NewFromInt(34),
+				decimal.NewFromInt(29),
+			},
+			Result: decimal.RequireFromString("33.8"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.HMA.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_HMA_Count(t *testing.T) {
+	assert.Equal(t, 17, HMA{
+		wma: WMA{
+			length: 15,
+		},
+	}.Count())
+}
+
+func Test_HMA_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameHMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.Zero,
+	}, HMA{}.Describe())
+}
+
+func Test_NewIchimoku(t *testing.T) {
+	cc := map[string]struct {
+		Tenkan int
+		Kijun  int
+		Senkou int
+		Result Ichimoku
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Ichimoku": {
+			Tenkan: 9,
+			Kijun:  26,
+			Senkou: 52,
+			Result: Ichimoku{
+				valid:  true,
+				tenkan: 9,
+				kijun:  26,
+				senkou: 52,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewIchimoku(c.Tenkan, c.Kijun, c.Senkou)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Ichimoku_validate(t *testing.T) {
+	cc := map[string]struct {
+		Ichimoku Ichimoku
+		Error    error
+	}{
+		"Invalid tenkan length": {
+			Ichimoku: Ichimoku{
+				kijun:  1,
+				senkou: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameIchimoku,
+				Field:     "tenkan",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid kijun length": {
+			Ichimoku: Ichimoku{
+				tenkan: 1,
+				senkou: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameIchimoku,
+				Field:     "kijun",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid senkou length": {
+			Ichimoku: Ichimoku{
+				tenkan: 1,
+				kijun:  1,
+			},
+			Error: &ConfigError{
+				Indicator: NameIchimoku,
+				Field:     "senkou",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			Ichimoku: Ichimoku{
+				tenkan: 1,
+				kijun:  1,
+				senkou: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Ichimoku.validate())
+			if c.Error == nil {
+				assert.True(t, c.Ichimoku.valid)
+			}
+		})
+	}
+}
+
+func Test_Ichimoku_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		Ichimoku Ichimoku
+		Candles  []Candle
+		Result   IchimokuLines
+		Error    error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Ichimoku: Ichimoku{
+				valid:  true,
+				tenkan: 1,
+				kijun:  2,
+				senkou: 3,
+			},
+			Candles: testCandles(t)[:1],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			Ichimoku: Ichimoku{
+				valid:  true,
+				tenkan: 1,
+				kijun:  2,
+				senkou: 3,
+			},
+			Candles: testCandles(t),
+			Result: IchimokuLines{
+				Tenkan:           decimal.NewFromInt(13),
+				Kijun:            decimal.RequireFromString("12.5"),
+				SenkouA:          decimal.RequireFromString("10.5"),
+				SenkouB:          decimal.NewFromInt(10),
+				Chikou:           decimal.NewFromInt(14),
+				ProjectedSenkouA: decimal.RequireFromString("12.75"),
+				ProjectedSenkouB: decimal.NewFromInt(12),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Ichimoku.CalcLines(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Tenkan.String(), res.Tenkan.String())
+			assert.Equal(t, c.Result.Kijun.String(), res.Kijun.String())
+			assert.Equal(t, c.Result.SenkouA.String(), res.SenkouA.String())
+			assert.Equal(t, c.Result.SenkouB.String(), res.SenkouB.String())
+			assert.Equal(t, c.Result.Chikou.String(), res.Chikou.String())
+			assert.Equal(t, c.Result.ProjectedSenkouA.String(), res.ProjectedSenkouA.String())
+			assert.Equal(t, c.Result.ProjectedSenkouB.String(), res.ProjectedSenkouB.String())
+		})
+	}
+}
+
+func Test_Ichimoku_Count(t *testing.T) {
+	assert.Equal(t, 78, Ichimoku{
+		tenkan: 9,
+		kijun:  26,
+		senkou: 52,
+	}.Count())
+
+	assert.Equal(t, 12, Ichimoku{
+		tenkan: 10,
+		kijun:  2,
+		senkou: 5,
+	}.Count())
+
+	assert.Equal(t, 10, Ichimoku{
+		tenkan: 1,
+		kijun:  5,
+		senkou: 1,
+	}.Count())
+}
+
+func Test_Ichimoku_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameIchimoku,
+		Input:   InputCandle,
+		Overlay: true,
+	}, Ichimoku{}.Describe())
+}
+
+// testKeltner returns a small valid Keltner used in calculation tests.
+func testKeltner(band Band) Keltner {
+	return Keltner{
+		valid:      true,
+		band:       band,
+		ma:         SMA{valid: true, length: 2},
+		atr:        ATR{valid: true, length: 2, smoothing: SmoothingSMA},
+		multiplier: decimal.NewFromInt(2),
+	}
+}
+
+func Test_NewKeltner(t *testing.T) {
+	cc := map[string]struct {
+		Band       Band
+		MA         Indicator
+		Length     int
+		Multiplier decimal.Decimal
+		Result     Keltner
+		Error      error
+	}{
+		"Invalid ATR": {
+			Band: BandUpper,
+			Error: &ConfigError{
+				Indicator: NameKeltner,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Validate returns an error": {
+			Length: 2,
+			Error:  assert.AnError,
+		},
+		"Successfully created new Keltner with default options": {
+			Band:   BandUpper,
+			Length: 2,
+			Result: Keltner{
+				valid: true,
+				band:  BandUpper,
+				ma: EMA{
+					valid: true,
+					sma:   SMA{valid: true, length: 2},
+				},
+				atr:        ATR{valid: true, length: 2, smoothing: SmoothingEMA},
+				multiplier: decimal.NewFromInt(2),
+			},
+		},
+		"Successfully created new Keltner": {
+			Band:       BandLower,
+			MA:         SMA{valid: true, length: 3},
+			Length:     2,
+			Multiplier: decimal.NewFromInt(3),
+			Result: Keltner{
+				valid:      true,
+				band:       BandLower,
+				ma:         SMA{valid: true, length: 3},
+				atr:        ATR{valid: true, length: 2, smoothing: SmoothingEMA},
+				multiplier: decimal.NewFromInt(3),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewKeltner(c.Band, c.MA, c.Length, c.Multiplier)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Keltner_validate(t *testing.T) {
+	cc := map[string]struct {
+		Keltner Keltner
+		Error   error
+	}{
+		"Invalid band": {
+			Keltner: Keltner{
+				ma:  SMA{},
+				atr: ATR{valid: true},
+			},
+			Error: &ConfigError{
+				Indicator: NameKeltner,
+				Field:     "band",
+				Value:     Band(0),
+				Err:       ErrInvalidBand,
+			},
+		},
+		"Invalid moving average": {
+			Keltner: Keltner{
+				band: BandUpper,
+				atr:  ATR{valid: true},
+			},
+			Error: &ConfigError{
+				Indicator: NameKeltner,
+				Field:     "ma",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Invalid ATR": {
+			Keltner: Keltner{
+				band: BandUpper,
+				ma:   SMA{},
+			},
+			Error: &ConfigError{
+				Indicator: NameKeltner,
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Invalid multiplier": {
+			Keltner: Keltner{
+				band:       BandUpper,
+				ma:         SMA{},
+				atr:        ATR{valid: true},
+				multiplier: decimal.NewFromInt(-1),
+			},
+			Error: assert.AnError,
+		},
+		"Successfully validated": {
+			Keltner: Keltner{
+				band:       BandUpper,
+				ma:         SMA{},
+				atr:        ATR{valid: true},
+				multiplier: decimal.NewFromInt(2),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Keltner.validate())
+			if c.Error == nil {
+				assert.True(t, c.Keltner.valid)
+			}
+		})
+	}
+}
+
+func Test_Keltner_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		Keltner Keltner
+		Candles []Candle
+		Result  string
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation with BandUpper": {
+			Keltner: testKeltner(BandUpper),
+			Candles: testCandles(t)[2:],
+			Result:  "18",
+		},
+		"Successful calculation with BandLower": {
+			Keltner: testKeltner(BandLower),
+			Candles: testCandles(t)[2:],
+			Result:  "6",
+		},
+		"Successful calculation with BandWidth": {
+			Keltner: testKeltner(BandWidth),
+			Candles: testCandles(t)[2:],
+			Result:  "100",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Keltner.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, res.String())
+		})
+	}
+}
+
+func Test_Keltner_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		Keltner Keltner
+		Candles []Candle
+		Result  KeltnerLines
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Keltner: testKeltner(BandUpper),
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
+		},
+		"Moving average returns an error": {
+			Keltner: Keltner{
+				valid:      true,
+				band:       BandUpper,
+				ma:         SMA{length: 2},
+				atr:        ATR{valid: true, length: 2, smoothing: SmoothingSMA},
+				multiplier: decimal.NewFromInt(2),
+			},
+			Candles: testCandles(t)[2:],
+			Error:   ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			Keltner: testKeltner(BandUpper),
+			Candles: testCandles(t)[2:],
+			Result: KeltnerLines{
+				Upper:  decimal.NewFromInt(18),
+				Middle: decimal.NewFromInt(12),
+				Lower:  decimal.NewFromInt(6),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Keltner.CalcLines(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Upper.String(), res.Upper.String())
+			assert.Equal(t, c.Result.Middle.String(), res.Middle.String())
+			assert.Equal(t, c.Result.Lower.String(), res.Lower.String())
+		})
+	}
+}
+
+func Test_Keltner_Count(t *testing.T) {
+	assert.Equal(t, 3, testKeltner(BandUpper).Count())
+
+	assert.Equal(t, 5, Keltner{
+		ma:  SMA{length: 5},
+		atr: ATR{length: 2, smoothing: SmoothingSMA},
+	}.Count())
+}
+
+func Test_Keltner_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameKeltner,
+		Input:   InputCandle,
+		Overlay: true,
+	}, Keltner{band: BandUpper}.Describe())
+
+	assertEqualDescription(t, Description{
+		Name:  NameKeltner,
+		Input: InputCandle,
+	}, Keltner{band: BandWidth}.Describe())
+}
+
+func Test_NewKeltnerWidth(t *testing.T) {
+	cc := map[string]struct {
+		Length     int
+		Multiplier decimal.Decimal
+		Normalize  bool
+		Result     KeltnerWidth
+		Error      error
+	}{
+		"Invalid Keltner": {
+			Error: &ConfigError{
+				Indicator: NameKeltnerWidth,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully created new KeltnerWidth with default multiplier": {
+			Length: 2,
+			Result: KeltnerWidth{
+				valid: true,
+				keltner: Keltner{
+					valid: true,
+					band:  BandWidth,
+					ma: EMA{
+						valid: true,
+						sma:   SMA{valid: true, length: 2},
+					},
+					atr:        ATR{valid: true, length: 2, smoothing: SmoothingEMA},
+					multiplier: decimal.NewFromInt(2),
+				},
+			},
+		},
+		"Successfully created new KeltnerWidth": {
+			Length:     2,
+			Multiplier: decimal.NewFromInt(3),
+			Normalize:  true,
+			Result: KeltnerWidth{
+				valid:     true,
+				normalize: true,
+				keltner: Keltner{
+					valid: true,
+					band:  BandWidth,
+					ma: EMA{
+						valid: true,
+						sma:   SMA{valid: true, length: 2},
+					},
+					atr:        ATR{valid: true, length: 2, smoothing: SmoothingEMA},
+					multiplier: decimal.NewFromInt(3),
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewKeltnerWidth(c.Length, c.Multiplier, c.Normalize)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_KeltnerWidth_validate(t *testing.T) {
+	cc := map[string]struct {
+		KeltnerWidth KeltnerWidth
+		Error        error
+	}{
+		"Invalid Keltner": {
+			Error: &ConfigError{
+				Indicator: NameKeltnerWidth,
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Successfully validated": {
+			KeltnerWidth: KeltnerWidth{
+				keltner: testKeltner(BandWidth),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.KeltnerWidth.validate())
+			if c.Error == nil {
+				assert.True(t, c.KeltnerWidth.valid)
+			}
+		})
+	}
+}
+
+func Test_KeltnerWidth_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		KeltnerWidth KeltnerWidth
+		Candles      []Candle
+		Result       decimal.Decimal
+		Error        error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			KeltnerWidth: KeltnerWidth{
+				valid:   true,
+				keltner: testKeltner(BandWidth),
+			},
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			KeltnerWidth: KeltnerWidth{
+				valid:     true,
+				normalize: true,
+				keltner:   testKeltner(BandWidth),
+			},
+			Candles: make([]Candle, 3),
+			Result:  decimal.Zero,
+		},
+		"Successful calculation": {
+			KeltnerWidth: KeltnerWidth{
+				valid:   true,
+				keltner: testKeltner(BandWidth),
+			},
+			Candles: testCandles(t)[2:],
+			Result:  decimal.NewFromInt(12),
+		},
+		"Successful calculation using normalization": {
+			KeltnerWidth: KeltnerWidth{
+				valid:     true,
+				normalize: true,
+				keltner:   testKeltner(BandWidth),
+			},
+			Candles: testCandles(t)[2:],
+			Result:  decimal.NewFromInt(1),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.KeltnerWidth.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_KeltnerWidth_Count(t *testing.T) {
+	assert.Equal(t, 3, KeltnerWidth{
+		keltner: testKeltner(BandWidth),
+	}.Count())
+}
+
+func Test_KeltnerWidth_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameKeltnerWidth,
+		Input: InputCandle,
+	}, KeltnerWidth{}.Describe())
+}
+
+// testKST returns a small valid KST used in calculation tests.
+func testKST(t *testing.T) KST {
+	t.Helper()
+
+	kst, err := NewKST([4]int{2, 3, 2, 2}, [4]int{1, 1, 2, 1}, 2)
+	require.NoError(t, err)
+
+	return kst
+}
+
+func Test_NewIntradayIntensity(t *testing.T) {
+	cc := map[string]struct {
+		Length  int
+		Percent bool
+		Result  IntradayIntensity
+		Error   error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new IntradayIntensity": {
+			Length:  2,
+			Percent: true,
+			Result: IntradayIntensity{
+				valid:   true,
+				percent: true,
+				length:  2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewIntradayIntensity(c.Length, c.Percent)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_IntradayIntensity_validate(t *testing.T) {
+	cc := map[string]struct {
+		IntradayIntensity IntradayIntensity
+		Error             error
+	}{
+		"Invalid length": {
+			Error: &ConfigError{
+				Indicator: NameIntradayIntensity,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			IntradayIntensity: IntradayIntensity{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.IntradayIntensity.validate())
+			if c.Error == nil {
+				assert.True(t, c.IntradayIntensity.valid)
+			}
+		})
+	}
+}
+
+func Test_IntradayIntensity_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		IntradayIntensity IntradayIntensity
+		Candles           []Candle
+		Result            decimal.Decimal
+		Error             error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			IntradayIntensity: IntradayIntensity{
+				valid:  true,
+				length: 5,
+			},
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			IntradayIntensity: IntradayIntensity{
+				valid:   true,
+				percent: true,
+				length:  5,
+			},
+			Candles: make([]Candle, 5),
+			Result:  decimal.Zero,
+		},
+		"Successful calculation": {
+			IntradayIntensity: IntradayIntensity{
+				valid:  true,
+				length: 5,
+			},
+			Candles: testCandles(t),
+			Result:  decimal.NewFromInt(-125),
+		},
+		"Successful calculation using percent": {
+			IntradayIntensity: IntradayIntensity{
+				valid:   true,
+				percent: true,
+				length:  5,
+			},
+			Candles: testCandles(t),
+			Result:  decimal.RequireFromString("-12.5"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.IntradayIntensity.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_IntradayIntensity_Count(t *testing.T) {
+	assert.Equal(t, 5, IntradayIntensity{
+		length: 5,
+	}.Count())
+}
+
+func Test_IntradayIntensity_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameIntradayIntensity,
+		Input: InputCandle,
+	}, IntradayIntensity{}.Describe())
+
+	assertEqualDescription(t, Description{
+		Name:    NameIntradayIntensity,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.NewFromInt(-100),
+		Max:     decimal.NewFromInt(100),
+	}, IntradayIntensity{percent: true}.Describe())
+}
+
+func Test_NewKST(t *testing.T) {
+	cc := map[string]struct {
+		ROCs   [4]int
+		SMAs   [4]int
+		Signal int
+		Result KST
+		Error  error
+	}{
+		"NewROC returns an error": {
+			SMAs:   [4]int{1, 1, 1, 1},
+			Signal: 1,
+			Error:  assert.AnError,
+		},
+		"NewSMA returns an error": {
+			ROCs:   [4]int{1, 1, 1, 1},
+			Signal: 1,
+			Error:  assert.AnError,
+		},
+		"NewSMA returns an error for signal": {
+			ROCs:  [4]int{1, 1, 1, 1},
+			SMAs:  [4]int{1, 1, 1, 1},
+			Error: assert.AnError,
+		},
+		"Successfully created new KST": {
+			ROCs:   [4]int{1, 2, 3, 4},
+			SMAs:   [4]int{5, 6, 7, 8},
+			Signal: 9,
+			Result: KST{
+				valid: true,
+				roc: [4]ROC{
+					{valid: true, length: 1},
+					{valid: true, length: 2},
+					{valid: true, length: 3},
+					{valid: true, length: 4},
+				},
+				sma: [4]SMA{
+					{valid: true, length: 5},
+					{valid: true, length: 6},
+					{valid: true, length: 7},
+					{valid: true, length: 8},
+				},
+				signal: SMA{valid: true, length: 9},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewKST(c.ROCs, c.SMAs, c.Signal)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_KST_Calc(t *testing.T) {
+	cc := map[string]struct {
+		KST    KST
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			KST:   testKST(t),
+			Data:  series(1, 2, 4),
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			KST:    testKST(t),
+			Data:   series(1, 2, 4, 5),
+			Result: decimal.RequireFromString("612.5"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.KST.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_KST_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		KST    KST
+		Data   []decimal.Decimal
+		Result KSTLines
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			KST:   testKST(t),
+			Data:  series(1, 2, 4),
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			KST:  testKST(t),
+			Data: series(1, 2, 4, 5),
+			Result: KSTLines{
+				KST:    decimal.RequireFromString("612.5"),
+				Signal: decimal.RequireFromString("1006.25"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.KST.CalcLines(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.KST.String(), res.KST.String())
+			assert.Equal(t, c.Result.Signal.String(), res.Signal.String())
+		})
+	}
+}
+
+func Test_KST_line(t *testing.T) {
+	_, err := testKST(t).line(series(1, 2))
+	assert.Equal(t, ErrInvalidDataSize, err)
+
+	kst := testKST(t)
+	kst.sma[0] = SMA{length: 1}
+
+	_, err = kst.line(series(1, 2, 4))
+	assert.Equal(t, ErrInvalidIndicator, err)
+
+	res, err := testKST(t).line(series(1, 2, 4))
+	assert.NoError(t, err)
+	assert.Equal(t, "1400", res.String())
+}
+
+func Test_KST_Count(t *testing.T) {
+	assert.Equal(t, 4, testKST(t).Count())
+}
+
+func Test_KST_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameKST,
+		Input: InputClose,
+	}, KST{}.Describe())
+}
+
+func Test_NewKurtosis(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result Kurtosis
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Kurtosis": {
+			Length: 2,
+			Result: Kurtosis{
+				valid:  true,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewKurtosis(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Kurtosis_validate(t *testing.T) {
+	cc := map[string]struct {
+		Kurtosis Kurtosis
+		Error    error
+	}{
+		"Invalid length": {
+			Kurtosis: Kurtosis{
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameKurtosis,
+				Field:     "length",
+				Value:     1,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			Kurtosis: Kurtosis{
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Kurtosis.validate())
+			if c.Error == nil {
+				assert.True(t, c.Kurtosis.valid)
+			}
+		})
+	}
+}
+
+func Test_Kurtosis_Calc(t *testing.T) {
+	cc := map[string]struct {
+		Kurtosis Kurtosis
+		Data     []decimal.Decimal
+		Result   decimal.Decimal
+		Error    error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Kurtosis: Kurtosis{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			Kurtosis: Kurtosis{
+				valid:  true,
+				length: 3,
+			},
+			Data:   series(2, 2, 2),
+			Result: decimal.Zero,
+		},
+		"Successful calculation": {
+			Kurtosis: Kurtosis{
+				valid:  true,
+				length: 4,
+			},
+			Data:   series(1, 2, 3, 10),
+			Result: decimal.RequireFromString("-0.7696"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Kurtosis.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_Kurtosis_Count(t *testing.T) {
+	assert.Equal(t, 5, Kurtosis{
+		length: 5,
+	}.Count())
+}
+
+func Test_Kurtosis_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameKurtosis,
+		Input: InputClose,
+	}, Kurtosis{}.Describe())
+}
+
+func Test_NewLinReg(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result LinReg
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new LinReg": {
+			Length: 2,
+			Result: LinReg{
+				valid:  true,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewLinReg(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_LinReg_validate(t *testing.T) {
+	cc := map[string]struct {
+		LinReg LinReg
+		Error  error
+	}{
+		"Invalid length": {
+			LinReg: LinReg{
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameLinReg,
+				Field:     "length",
+				Value:     1,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			LinReg: LinReg{
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.LinReg.validate())
+			if c.Error == nil {
+				assert.True(t, c.LinReg.valid)
+			}
+		})
+	}
+}
+
+func Test_LinReg_Calc(t *testing.T) {
+	cc := map[string]struct {
+		LinReg LinReg
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			LinReg: LinReg{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			LinReg: LinReg{
+				valid:  true,
+				length: 4,
+			},
+			Data:   series(1, 3, 2, 4),
+			Result: decimal.RequireFromString("0.8"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.LinReg.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_LinReg_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		LinReg LinReg
+		Data   []decimal.Decimal
+		Result LinRegLines
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			LinReg: LinReg{
+				valid:  true,
+				length: 3,
+			},
+			Data:  series(1),
+			Error: ErrInvalidDataSize,
+		},
+		"Successfully handled equal data points": {
+			LinReg: LinReg{
+				valid:  true,
+				length: 3,
+			},
+			Data: series(2, 2, 2),
+			Result: LinRegLines{
+				Slope:     decimal.Zero,
+				Intercept: decimal.NewFromInt(2),
+				R2:        decimal.Zero,
+				Forecast:  decimal.NewFromInt(2),
+			},
+		},
+		"Successful calculation": {
+			LinReg: LinReg{
+				valid:  true,
+				length: 4,
+			},
+			Data: series(1, 3, 2, 4),
+			Result: LinRegLines{
+				Slope:     decimal.RequireFromString("0.8"),
+				Intercept: decimal.RequireFromString("1.3"),
+				R2:        decimal.RequireFromString("0.64"),
+				Forecast:  decimal.RequireFromString("3.7"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.LinReg.CalcLines(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Slope.String(), res.Slope.String())
+			assert.Equal(t, c.Result.Intercept.String(), res.Intercept.String())
+			assert.Equal(t, c.Result.R2.String(), res.R2.String())
+			assert.Equal(t, c.Result.Forecast.String(), res.Forecast.String())
+		})
+	}
+}
+
+func Test_LinReg_Count(t *testing.T) {
+	assert.Equal(t, 5, LinReg{
+		length: 5,
+	}.Count())
+}
+
+func Test_LinReg_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameLinReg,
+		Input: InputClose,
+	}, LinReg{}.Describe())
+}
+
+func Test_NewLowest(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result Lowest
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Lowest": {
+			Length: 2,
+			Result: Lowest{
+				valid:  true,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewLowest(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Lowest_validate(t *testing.T) {
+	cc := map[string]struct {
+		Lowest Lowest
+		Error  error
+	}{
+		"Invalid length": {
+			Lowest: Lowest{
+				length: 0,
+			},
+			Error: &ConfigError{
+				Indicator: NameLowest,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			Lowest: Lowest{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Lowest.validate())
+			if c.Error == nil {
+				assert.True(t, c.Lowest.valid)
+			}
+		})
+	}
+}
+
+func Test_Lowest_Calc(t *testing.T) {
+	cc := map[string]struct {
+		Lowest Lowest
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Lowest: Lowest{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			Lowest: Lowest{
+				valid:  true,
+				length: 3,
+			},
+			Data:   series(2, 5, 1),
+			Result: decimal.NewFromInt(1),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Lowest.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_Lowest_Series(t *testing.T) {
+	cc := map[string]struct {
+		Lowest Lowest
+		Data   []decimal.Decimal
+		Result []string
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Lowest: Lowest{
+				valid:  true,
+				length: 3,
+			},
+			Data:  series(1, 2),
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			Lowest: Lowest{
+				valid:  true,
+				length: 3,
+			},
+			Data:   series(1, 3, 2, 2, 1, 5, 4),
+			Result: []string{"1", "2", "1", "1", "1"},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Lowest.Series(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, decimalStrings(res))
+		})
+	}
+}
+
+func Test_Lowest_Count(t *testing.T) {
+	assert.Equal(t, 5, Lowest{
+		length: 5,
+	}.Count())
+}
+
+func Test_Lowest_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameLowest,
+		Input:   InputClose,
+		Overlay: true,
+	}, Lowest{}.Describe())
+}
+
+func Test_NewLSMA(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result LSMA
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new LSMA": {
+			Length: 2,
+			Result: LSMA{
+				valid:  true,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewLSMA(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_LSMA_validate(t *testing.T) {
+	cc := map[string]struct {
+		LSMA  LSMA
+		Error error
+	}{
+		"Invalid length": {
+			LSMA: LSMA{
+				length: 0,
+			},
+			Error: &ConfigError{
+				Indicator: NameLSMA,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			LSMA: LSMA{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.LSMA.validate())
+			if c.Error == nil {
+				assert.True(t, c.LSMA.valid)
+			}
+		})
+	}
+}
+
+func Test_LSMA_Calc(t *testing.T) {
+	cc := map[string]struct {
+		LSMA   LSMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			LSMA: LSMA{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			LSMA: LSMA{
+				valid:  true,
+				length: 4,
+			},
+			Data:   series(1, 3, 2, 4),
+			Result: decimal.RequireFromString("3.7"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.LSMA.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_LSMA_Count(t *testing.T) {
+	assert.Equal(t, 5, LSMA{
+		length: 5,
+	}.Count())
+}
+
+func Test_LSMA_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameLSMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.Zero,
+	}, LSMA{}.Describe())
+}
+
+// testMACD returns a small valid MACD used in calculation tests.
+func testMACD() MACD {
+	return MACD{
+		valid:  true,
+		ma1:    SMA{valid: true, length: 2},
+		ma2:    SMA{valid: true, length: 3},
+		signal: SMA{valid: true, length: 2},
+	}
+}
+
+func Test_NewMACD(t *testing.T) {
+	cc := map[string]struct {
+		MA1    Indicator
+		MA2    Indicator
+		Signal Indicator
+		Result MACD
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new MACD with default signal": {
+			MA1: SMA{valid: true, length: 2},
+			MA2: SMA{valid: true, length: 3},
+			Result: MACD{
+				valid: true,
+				ma1:   SMA{valid: true, length: 2},
+				ma2:   SMA{valid: true, length: 3},
+				signal: EMA{
+					valid: true,
+					sma:   SMA{valid: true, length: 9},
+				},
+			},
+		},
+		"Successfully created new MACD": {
+			MA1:    SMA{valid: true, length: 2},
+			MA2:    SMA{valid: true, length: 3},
+			Signal: SMA{valid: true, length: 2},
+			Result: testMACD(),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewMACD(c.MA1, c.MA2, c.Signal)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_MACD_validate(t *testing.T) {
+	cc := map[string]struct {
+		MACD  MACD
+		Error error
+	}{
+		"Invalid first moving average": {
+			MACD: MACD{
+				ma2:    SMA{},
+				signal: SMA{},
+			},
+			Error: &ConfigError{
+				Indicator: NameMACD,
+				Field:     "ma1",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Invalid second moving average": {
+			MACD: MACD{
+				ma1:    SMA{},
+				signal: SMA{},
+			},
+			Error: &ConfigError{
+				Indicator: NameMACD,
+				Field:     "ma2",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Invalid signal": {
+			MACD: MACD{
+				ma1: SMA{},
+				ma2: SMA{},
+			},
+			Error: &ConfigError{
+				Indicator: NameMACD,
+				Field:     "signal",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Successfully validated": {
+			MACD: MACD{
+				ma1:    SMA{},
+				ma2:    SMA{},
+				signal: SMA{},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.MACD.validate())
+			if c.Error == nil {
+				assert.True(t, c.MACD.valid)
+			}
+		})
+	}
+}
+
+func Test_MACD_Calc(t *testing.T) {
+	cc := map[string]struct {
+		MACD   MACD
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			MACD:  testMACD(),
+			Data:  series(1, 2),
+			Error: ErrInvalidDataSize,
+		},
+		"Moving average returns an error": {
+			MACD: MACD{
+				valid:  true,
+				ma1:    SMA{length: 2},
+				ma2:    SMA{valid: true, length: 3},
+				signal: SMA{valid: true, length: 2},
+			},
+			Data:  series(2, 4, 5),
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			MACD:   testMACD(),
+			Data:   series(2, 4, 5),
+			Result: decimal.RequireFromString("0.83333333"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.MACD.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_MACD_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		MACD   MACD
+		Data   []decimal.Decimal
+		Result MACDLines
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			MACD:  testMACD(),
+			Data:  series(1, 2, 3),
+			Error: ErrInvalidDataSize,
+		},
+		"Moving average returns an error": {
+			MACD: MACD{
+				valid:  true,
+				ma1:    SMA{length: 2},
+				ma2:    SMA{valid: true, length: 3},
+				signal: SMA{valid: true, length: 2},
+			},
+			Data:  series(1, 2, 4, 5),
+			Error: ErrInvalidIndicator,
+		},
+		"Signal returns an error": {
+			MACD: MACD{
+				valid:  true,
+				ma1:    SMA{valid: true, length: 2},
+				ma2:    SMA{valid: true, length: 3},
+				signal: SMA{length: 2},
+			},
+			Data:  series(1, 2, 4, 5),
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			MACD: testMACD(),
+			Data: series(1, 2, 4, 5),
+			Result: MACDLines{
+				MACD:      decimal.RequireFromString("0.83333333"),
+				Signal:    decimal.RequireFromString("0.75"),
+				Histogram: decimal.RequireFromString("0.08333333"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.MACD.CalcLines(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.MACD.Round(8).String(), res.MACD.Round(8).String())
+			assert.Equal(t, c.Result.Signal.Round(8).String(), res.Signal.Round(8).String())
+			assert.Equal(t, c.Result.Histogram.Round(8).String(), res.Histogram.Round(8).String())
+		})
+	}
+}
+
+func Test_MACD_line(t *testing.T) {
+	macd := testMACD()
+	macd.ma2 = SMA{length: 3}
+
+	_, err := macd.line(series(2, 4, 5))
+	assert.Equal(t, ErrInvalidIndicator, err)
+
+	res, err := testMACD().line(series(2, 4, 5))
+	assert.NoError(t, err)
+	assert.Equal(t, "0.83333333", res.Round(8).String())
+}
+
+func Test_MACD_averages(t *testing.T) {
+	cc := map[string]struct {
+		MACD  MACD
+		Data  []decimal.Decimal
+		R1    string
+		R2    string
+		Error error
+	}{
+		"First moving average returns an error": {
+			MACD: MACD{
+				ma1: SMA{length: 2},
+				ma2: SMA{valid: true, length: 3},
+			},
+			Data:  series(2, 4, 5),
+			Error: ErrInvalidIndicator,
+		},
+		"Second moving average returns an error": {
+			MACD: MACD{
+				ma1: SMA{valid: true, length: 2},
+				ma2: SMA{length: 3},
+			},
+			Data:  series(2, 4, 5),
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			MACD: MACD{
+				ma1: SMA{valid: true, length: 3},
+				ma2: SMA{valid: true, length: 2},
+			},
+			Data: series(2, 4, 6),
+			R1:   "4",
+			R2:   "5",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			r1, r2, err := c.MACD.averages(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.R1, r1.String())
+			assert.Equal(t, c.R2, r2.String())
+		})
+	}
+}
+
+func Test_MACD_Count(t *testing.T) {
+	assert.Equal(t, 5, MACD{
+		ma1:    SMA{length: 5},
+		ma2:    SMA{length: 3},
+		signal: SMA{length: 2},
+	}.Count())
+
+	assert.Equal(t, 5, MACD{
+		ma1:    SMA{length: 3},
+		ma2:    SMA{length: 5},
+		signal: SMA{length: 2},
+	}.Count())
+}
+
+func Test_MACD_CountLines(t *testing.T) {
+	assert.Equal(t, 6, MACD{
+		ma1:    SMA{length: 5},
+		ma2:    SMA{length: 3},
+		signal: SMA{length: 2},
+	}.CountLines())
+
+	assert.Equal(t, 5, MACD{
+		ma1:    SMA{length: 3},
+		ma2:    SMA{length: 5},
+		signal: SMA{length: 1},
+	}.CountLines())
+}
+
+func Test_MACD_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameMACD,
+		Input: InputClose,
+	}, MACD{}.Describe())
+}
+
+func Test_NewMcGinley(t *testing.T) {
+	cc := map[string]struct {
+		Length   int
+		Constant decimal.Decimal
+		Result   McGinley
+		Error    error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new McGinley with default constant": {
+			Length: 5,
+			Result: McGinley{
+				valid:    true,
+				length:   5,
+				constant: decimal.RequireFromString("0.6"),
+			},
+		},
+		"Successfully created new McGinley": {
+			Length:   5,
+			Constant: decimal.NewFromInt(1),
+			Result: McGinley{
+				valid:    true,
+				length:   5,
+				constant: decimal.NewFromInt(1),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewMcGinley(c.Length, c.Constant)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_McGinley_validate(t *testing.T) {
+	cc := map[string]struct {
+		McGinley McGinley
+		Error    error
+	}{
+		"Invalid length": {
+			McGinley: McGinley{
+				constant: decimal.NewFromInt(1),
+			},
+			Error: &ConfigError{
+				Indicator: NameMcGinley,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid constant": {
+			McGinley: McGinley{
+				length:   5,
+				constant: decimal.NewFromInt(-1),
+			},
+			Error: assert.AnError,
+		},
+		"Successfully validated": {
+			McGinley: McGinley{
+				length:   5,
+				constant: decimal.NewFromInt(1),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.McGinley.validate())
+			if c.Error == nil {
+				assert.True(t, c.McGinley.valid)
+			}
+		})
+	}
+}
+
+func Test_McGinley_Calc(t *testing.T) {
+	cc := map[string]struct {
+		McGinley McGinley
+		Data     []decimal.Decimal
+		Result   decimal.Decimal
+		Error    error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			McGinley: McGinley{
+				valid:    true,
+				length:   2,
+				constant: decimal.NewFromInt(1),
+			},
+			Data:  series(2, 4),
+			Error: ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			McGinley: McGinley{
+				valid:    true,
+				length:   2,
+				constant: decimal.NewFromInt(1),
+			},
+			Data:   series(0, 0, 5),
+			Result: decimal.NewFromInt(5),
+		},
+		"Successful calculation": {
+			McGinley: McGinley{
+				valid:    true,
+				length:   2,
+				constant: decimal.NewFromInt(1),
+			},
+			Data:   series(2, 4, 8),
+			Result: decimal.RequireFromString("3.04943848"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.McGinley.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_McGinley_CalcNext(t *testing.T) {
+	cc := map[string]struct {
+		McGinley McGinley
+		Last     decimal.Decimal
+		Next     decimal.Decimal
+		Result   decimal.Decimal
+		Error    error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			McGinley: McGinley{
+				valid:    true,
+				length:   2,
+				constant: decimal.NewFromInt(1),
+			},
+			Last:   decimal.NewFromInt(3),
+			Next:   decimal.NewFromInt(8),
+			Result: decimal.RequireFromString("3.04943848"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.McGinley.CalcNext(c.Last, c.Next)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_McGinley_next(t *testing.T) {
+	mcg := McGinley{
+		valid:    true,
+		length:   2,
+		constant: decimal.NewFromInt(1),
+	}
+
+	assert.Equal(t, "5", mcg.next(decimal.Zero, decimal.NewFromInt(5)).String())
+	assert.Equal(t, "3", mcg.next(decimal.NewFromInt(3), decimal.Zero).String())
+}
+
+func Test_McGinley_Count(t *testing.T) {
+	assert.Equal(t, 9, McGinley{length: 5}.Count())
+}
+
+func Test_McGinley_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameMcGinley,
+		Input:   InputClose,
+		Overlay: true,
+	}, McGinley{}.Describe())
+}
+
+func Test_NewMedianPrice(t *testing.T) {
+	mp, err := NewMedianPrice(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, MedianPrice{valid: true, src: Source{valid: true, price: PriceHL2}}, mp)
+
+	mp, err = NewMedianPrice(SMA{valid: true, length: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, MedianPrice{valid: true, src: Source{valid: true, price: PriceHL2, ind: SMA{valid: true, length: 2}}}, mp)
+}
+
+func Test_MedianPrice_validate(t *testing.T) {
+	mp := MedianPrice{}
+	assert.Equal(t, &ConfigError{
+		Indicator: NameMedianPrice,
+		Err:       ErrInvalidIndicator,
+	}, mp.validate())
+
+	mp = MedianPrice{src: Source{valid: true, price: PriceHL2}}
+	assert.NoError(t, mp.validate())
+	assert.True(t, mp.valid)
+}
+
+func Test_MedianPrice_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		MedianPrice MedianPrice
+		Candles     []Candle
+		Result      decimal.Decimal
+		Error       error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			MedianPrice: MedianPrice{valid: true, src: Source{valid: true, price: PriceHL2}},
+			Candles:     testCandles(t)[:2],
+			Error:       ErrInvalidDataSize,
+		},
+		"Successful calculation without indicator": {
+			MedianPrice: MedianPrice{valid: true, src: Source{valid: true, price: PriceHL2}},
+			Candles:     testCandles(t)[4:],
+			Result:      decimal.RequireFromString("13"),
+		},
+		"Successful calculation with indicator": {
+			MedianPrice: MedianPrice{valid: true, src: Source{valid: true, price: PriceHL2, ind: SMA{valid: true, length: 2}}},
+			Candles:     testCandles(t)[3:],
+			Result:      decimal.RequireFromString("11.75"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.MedianPrice.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_MedianPrice_Count(t *testing.T) {
+	assert.Equal(t, 1, MedianPrice{}.Count())
+	assert.Equal(t, 3, MedianPrice{src: Source{ind: SMA{length: 3}}}.Count())
+}
+
+func Test_MedianPrice_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameMedianPrice,
+		Input:   InputCandle,
+		Overlay: true,
+	}, MedianPrice{}.Describe())
+}
+
+func Test_NewMFI(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result MFI
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new MFI": {
+			Length: 2,
+			Result: MFI{
+				valid:  true,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewMFI(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_MFI_validate(t *testing.T) {
+	cc := map[string]struct {
+		MFI   MFI
+		Error error
+	}{
+		"Invalid length": {
+			MFI: MFI{
+				length: 0,
+			},
+			Error: &ConfigError{
+				Indicator: NameMFI,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			MFI: MFI{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.MFI.validate())
+			if c.Error == nil {
+				assert.True(t, c.MFI.valid)
+			}
+		})
+	}
+}
+
+func Test_MFI_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		MFI     MFI
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			MFI: MFI{
+				valid:  true,
+				length: 3,
+			},
+			Candles: testCandles(t)[:1],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation with no positive money flow": {
+			MFI: MFI{
+				valid:  true,
+				length: 1,
+			},
+			Candles: testCandles(t)[2:4],
+			Result:  decimal.Zero,
+		},
+		"Successful calculation with no negative money flow": {
+			MFI: MFI{
+				valid:  true,
+				length: 2,
+			},
+			Candles: testCandles(t)[:3],
+			Result:  decimal.NewFromInt(100),
+		},
+		"Successful calculation": {
+			MFI: MFI{
+				valid:  true,
+				length: 4,
+			},
+			Candles: testCandles(t),
+			Result:  decimal.RequireFromString("69.10299003"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.MFI.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_MFI_Count(t *testing.T) {
+	assert.Equal(t, 6, MFI{
+		length: 5,
+	}.Count())
+}
+
+func Test_MFI_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameMFI,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     decimal.NewFromInt(100),
+	}, MFI{}.Describe())
+}
+
+func Test_NewNormalize(t *testing.T) {
+	cc := map[string]struct {
+		Source  Indicator
+		Window  int
+		Scaling Scaling
+		Result  Normalize
+		Error   error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Normalize": {
+			Source:  SMA{valid: true, length: 2},
+			Window:  3,
+			Scaling: ScalingMinMax,
+			Result: Normalize{
+				valid:   true,
+				source:  SMA{valid: true, length: 2},
+				window:  3,
+				scaling: ScalingMinMax,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewNormalize(c.Source, c.Window, c.Scaling)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Normalize_validate(t *testing.T) {
+	cc := map[string]struct {
+		Normalize Normalize
+		Error     error
+	}{
+		"Invalid source": {
+			Normalize: Normalize{
+				window:  2,
+				scaling: ScalingMinMax,
+			},
+			Error: &ConfigError{
+				Indicator: NameNormalize,
+				Field:     "source",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Invalid window": {
+			Normalize: Normalize{
+				source:  SMA{},
+				window:  1,
+				scaling: ScalingMinMax,
+			},
+			Error: &ConfigError{
+				Indicator: NameNormalize,
+				Field:     "window",
+				Value:     1,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid scaling": {
+			Normalize: Normalize{
+				source: SMA{},
+				window: 2,
+			},
+			Error: &ConfigError{
+				Indicator: NameNormalize,
+				Field:     "scaling",
+				Value:     Scaling(0),
+				Err:       ErrInvalidScaling,
+			},
+		},
+		"Successfully validated": {
+			Normalize: Normalize{
+				source:  SMA{},
+				window:  2,
+				scaling: ScalingPercentRank,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Normalize.validate())
+			if c.Error == nil {
+				assert.True(t, c.Normalize.valid)
+			}
+		})
+	}
+}
+
+func Test_Normalize_Calc(t *testing.T) {
+	cc := map[string]struct {
+		Normalize Normalize
+		Data      []decimal.Decimal
+		Result    decimal.Decimal
+		Error     error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Normalize: Normalize{
+				valid:   true,
+				source:  SMA{valid: true, length: 2},
+				window:  3,
+				scaling: ScalingMinMax,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Source indicator returns an error": {
+			Normalize: Normalize{
+				valid:   true,
+				source:  SMA{length: 2},
+				window:  3,
+				scaling: ScalingMinMax,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(2),
+			},
+			Error: ErrInvalidIndicator,
+		},
+		"Successfully handled division by 0": {
+			Normalize: Normalize{
+				valid:   true,
+				source:  SMA{valid: true, length: 2},
+				window:  3,
+				scaling: ScalingMinMax,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(1),
+			},
+			Result: decimal.Zero,
+		},
+		"Successful calculation with ScalingMinMax": {
+			Normalize: Normalize{
+				valid:   true,
+				source:  SMA{valid: true, length: 2},
+				window:  3,
+				scaling: ScalingMinMax,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(2),
+			},
+			Result: decimal.NewFromInt(75),
+		},
+		"Successful calculation with ScalingPercentRank": {
+			Normalize: Normalize{
+				valid:   true,
+				source:  SMA{valid: true, length: 2},
+				window:  3,
+				scaling: ScalingPercentRank,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(2),
+			},
+			Result: decimal.NewFromInt(50),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Normalize.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_Normalize_Count(t *testing.T) {
+	assert.Equal(t, 6, Normalize{
+		source: SMA{length: 3},
+		window: 4,
+	}.Count())
+}
+
+func Test_Normalize_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameNormalize,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}, Normalize{
+		source: SMA{},
+	}.Describe())
+}
+
+func Test_NewNVI(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Signal int
+		Result NVI
+		Error  error
+	}{
+		"Invalid signal": {
+			Length: 2,
+			Signal: -1,
+			Error:  assert.AnError,
+		},
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new NVI without signal": {
+			Length: 2,
+			Result: NVI{
+				valid:  true,
+				length: 2,
+			},
+		},
+		"Successfully created new NVI with signal": {
+			Length: 2,
+			Signal: 2,
+			Result: NVI{
+				valid:  true,
+				length: 2,
+				signal: EMA{valid: true, sma: SMA{valid: true, length: 2}},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewNVI(c.Length, c.Signal)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_NVI_validate(t *testing.T) {
+	cc := map[string]struct {
+		NVI   NVI
+		Error error
+	}{
+		"Invalid length": {
+			Error: &ConfigError{
+				Indicator: NameNVI,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			NVI: NVI{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.NVI.validate())
+			if c.Error == nil {
+				assert.True(t, c.NVI.valid)
+			}
+		})
+	}
+}
+
+func Test_NVI_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		NVI     NVI
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			NVI:     NVI{valid: true, length: 4},
+			Candles: testCandles(t),
+			Result:  decimal.RequireFromString("1540"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.NVI.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_NVI_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		NVI     NVI
+		Candles []Candle
+		Result  VolumeIndexLines
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			NVI:     NVI{valid: true, length: 4},
+			Candles: testCandles(t)[:4],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			NVI: NVI{
+				valid:  true,
+				length: 2,
+				signal: EMA{valid: true, sma: SMA{valid: true, length: 2}},
+			},
+			Candles: testCandles(t),
+			Result: VolumeIndexLines{
+				Index:  decimal.RequireFromString("1540"),
+				Signal: decimal.RequireFromString("1393.33333333"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.NVI.CalcLines(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Index.Round(8).String(), res.Index.Round(8).String())
+			assert.Equal(t, c.Result.Signal.Round(8).String(), res.Signal.Round(8).String())
+		})
+	}
+}
+
+func Test_NVI_Count(t *testing.T) {
+	assert.Equal(t, 6, NVI{length: 5}.Count())
+	assert.Equal(t, 8, NVI{
+		length: 5,
+		signal: EMA{valid: true, sma: SMA{valid: true, length: 2}},
+	}.Count())
+}
+
+func Test_NVI_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameNVI,
+		Input: InputCandle,
+	}, NVI{}.Describe())
+}
+
+func Test_NewOBV(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result OBV
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new OBV": {
+			Length: 5,
+			Result: OBV{
+				valid:  true,
+				length: 5,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewOBV(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_OBV_validate(t *testing.T) {
+	cc := map[string]struct {
+		OBV   OBV
+		Error error
+	}{
+		"Invalid length": {
+			Error: &ConfigError{
+				Indicator: NameOBV,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			OBV: OBV{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.OBV.validate())
+			if c.Error == nil {
+				assert.True(t, c.OBV.valid)
+			}
+		})
+	}
+}
+
+func Test_OBV_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		OBV     OBV
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			OBV: OBV{
+				valid:  true,
+				length: 2,
+			},
+			Candles: testCandles(t)[:1],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			OBV: OBV{
+				valid:  true,
+				length: 4,
+			},
+			Candles: testCandles(t),
+			Result:  decimal.NewFromInt(300),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.OBV.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_OBV_Count(t *testing.T) {
+	assert.Equal(t, 6, OBV{length: 5}.Count())
+}
+
+func Test_OBV_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameOBV,
+		Input: InputCandle,
+	}, OBV{}.Describe())
+}
+
+func Test_NewOffset(t *testing.T) {
+	cc := map[string]struct {
+		Source Indicator
+		Shift  int
+		Result Offset
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Offset": {
+			Source: SMA{valid: true, length: 2},
+			Shift:  1,
+			Result: Offset{
+				valid:  true,
+				source: SMA{valid: true, length: 2},
+				shift:  1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewOffset(c.Source, c.Shift)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Offset_validate(t *testing.T) {
+	cc := map[string]struct {
+		Offset Offset
+		Error  error
+	}{
+		"Invalid source": {
+			Error: &ConfigError{
+				Indicator: NameOffset,
+				Field:     "source",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Invalid shift": {
+			Offset: Offset{
+				source: SMA{},
+				shift:  -1,
+			},
+			Error: &ConfigError{
+				Indicator: NameOffset,
+				Field:     "shift",
+				Value:     -1,
+				Err:       ErrInvalidShift,
+			},
+		},
+		"Successfully validated": {
+			Offset: Offset{
+				source: SMA{},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Offset.validate())
+			if c.Error == nil {
+				assert.True(t, c.Offset.valid)
+			}
+		})
+	}
+}
+
+func Test_Offset_Calc(t *testing.T) {
+	cc := map[string]struct {
+		Offset Offset
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Offset: Offset{
+				valid:  true,
+				source: SMA{valid: true, length: 2},
+				shift:  1,
+			},
+			Data:  series(1, 2),
+			Error: ErrInvalidDataSize,
+		},
+		"Source returns an error": {
+			Offset: Offset{
+				valid:  true,
+				source: SMA{length: 2},
+				shift:  1,
+			},
+			Data:  series(1, 2, 4),
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			Offset: Offset{
+				valid:  true,
+				source: SMA{valid: true, length: 2},
+				shift:  1,
+			},
+			Data:   series(1, 2, 4),
+			Result: decimal.RequireFromString("1.5"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Offset.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_Offset_Count(t *testing.T) {
+	assert.Equal(t, 5, Offset{source: SMA{length: 3}, shift: 2}.Count())
+}
+
+func Test_Offset_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameOffset,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.NewFromInt(3),
+	}, Offset{source: SMA{length: 3}, shift: 2}.Describe())
+
+	assertEqualDescription(t, Description{
+		Name:    NameOffset,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}, Offset{source: RSI{length: 3}, shift: 2}.Describe())
+}
+
+func Test_NewPercentB(t *testing.T) {
+	cc := map[string]struct {
+		StdDev decimal.Decimal
+		Length int
+		Result PercentB
+		Error  error
+	}{
+		"NewBB returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new PercentB": {
+			StdDev: decimal.NewFromInt(2),
+			Length: 5,
+			Result: PercentB{
+				valid: true,
+				bb: BB{
+					valid:  true,
+					band:   BandWidth,
+					stdDev: decimal.NewFromInt(2),
+					sma: SMA{
+						valid:  true,
+						length: 5,
+					},
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewPercentB(c.StdDev, c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_PercentB_Calc(t *testing.T) {
+	bb := BB{
+		valid:  true,
+		band:   BandWidth,
+		stdDev: decimal.NewFromInt(2),
+		sma: SMA{
+			valid:  true,
+			length: 2,
+		},
+	}
+
+	cc := map[string]struct {
+		PercentB PercentB
+		Data     []decimal.Decimal
+		Result   decimal.Decimal
+		Error    error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			PercentB: PercentB{
+				valid: true,
+				bb:    bb,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"BB returns an error": {
+			PercentB: PercentB{
+				valid: true,
+				bb: BB{
+					sma: SMA{
+						length: 2,
+					},
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(5),
+			},
+			Error: ErrInvalidIndicator,
+		},
+		"Successfully handled division by 0": {
+			PercentB: PercentB{
+				valid: true,
+				bb:    bb,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(3),
+			},
+			Result: decimal.Zero,
+		},
+		"Successful calculation": {
+			PercentB: PercentB{
+				valid: true,
+				bb:    bb,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(5),
+			},
+			Result: decimal.RequireFromString("0.75"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.PercentB.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_PercentB_Count(t *testing.T) {
+	assert.Equal(t, 5, PercentB{
+		bb: BB{
+			sma: SMA{
+				length: 5,
+			},
+		},
+	}.Count())
+}
+
+func Test_PercentB_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NamePercentB,
+		Input: InputClose,
+	}, PercentB{}.Describe())
+}
+
+func Test_NewPeriodogram(t *testing.T) {
+	cc := map[string]struct {
+		Min    int
+		Max    int
+		Length int
+		Result Periodogram
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Periodogram": {
+			Min:    2,
+			Max:    5,
+			Length: 6,
+			Result: Periodogram{
+				valid:  true,
+				min:    2,
+				max:    5,
+				length: 6,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewPeriodogram(c.Min, c.Max, c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Periodogram_validate(t *testing.T) {
+	cc := map[string]struct {
+		Periodogram Periodogram
+		Error       error
+	}{
+		"Invalid min": {
+			Periodogram: Periodogram{
+				max:    5,
+				length: 6,
+			},
+			Error: &ConfigError{
+				Indicator: NamePeriodogram,
+				Field:     "min",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid max": {
+			Periodogram: Periodogram{
+				min:    5,
+				max:    4,
+				length: 6,
+			},
+			Error: &ConfigError{
+				Indicator: NamePeriodogram,
+				Field:     "max",
+				Value:     4,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid length": {
+			Periodogram: Periodogram{
+				min:    2,
+				max:    5,
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NamePeriodogram,
+				Field:     "length",
+				Value:     1,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			Periodogram: Periodogram{
+				min:    2,
+				max:    2,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Periodogram.validate())
+			if c.Error == nil {
+				assert.True(t, c.Periodogram.valid)
+			}
+		})
+	}
+}
+
+func Test_Periodogram_Calc(t *testing.T) {
+	cc := map[string]struct {
+		Periodogram Periodogram
+		Data        []decimal.Decimal
+		Result      decimal.Decimal
+		Error       error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			Periodogram: Periodogram{
+				valid:  true,
+				min:    2,
+				max:    5,
+				length: 6,
+			},
+			Data:   series(2, 3, 2, 1, 2, 3, 2, 1, 2, 3, 2, 1, 2, 3, 2, 1),
+			Result: decimal.NewFromInt(4),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Periodogram.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_Periodogram_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		Periodogram Periodogram
+		Data        []decimal.Decimal
+		Result      PeriodogramLines
+		Error       error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Periodogram: Periodogram{
+				valid:  true,
+				min:    2,
+				max:    5,
+				length: 6,
+			},
+			Data:  series(1, 2, 3),
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation without cycles": {
+			Periodogram: Periodogram{
+				valid:  true,
+				min:    2,
+				max:    3,
+				length: 2,
+			},
+			Data: series(1, 1, 1, 1, 1, 1, 1, 1),
+			Result: PeriodogramLines{
+				Period: decimal.NewFromInt(2),
+				Power:  decimal.Zero,
+			},
+		},
+		"Successful calculation": {
+			Periodogram: Periodogram{
+				valid:  true,
+				min:    2,
+				max:    5,
+				length: 6,
+			},
+			Data: series(2, 3, 2, 1, 2, 3, 2, 1, 2, 3, 2, 1, 2, 3, 2, 1),
+			Result: PeriodogramLines{
+				Period: decimal.NewFromInt(4),
+				Power:  decimal.RequireFromString("0.3771446"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Periodogram.CalcLines(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Period.String(), res.Period.String())
+			assert.Equal(t, c.Result.Power.Round(8).String(), res.Power.Round(8).String())
+		})
+	}
+}
+
+func Test_Periodogram_Count(t *testing.T) {
+	assert.Equal(t, 16, Periodogram{max: 5, length: 6}.Count())
+}
+
+func Test_Periodogram_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NamePeriodogram,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.NewFromInt(2),
+		Max:     decimal.NewFromInt(5),
+	}, Periodogram{min: 2, max: 5}.Describe())
+}
+
+func Test_NewPivots(t *testing.T) {
+	cc := map[string]struct {
+		Method PivotMethod
+		Result Pivots
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Pivots": {
+			Method: PivotFibonacci,
+			Result: Pivots{
+				valid:  true,
+				method: PivotFibonacci,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewPivots(c.Method)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Pivots_validate(t *testing.T) {
+	cc := map[string]struct {
+		Pivots Pivots
+		Error  error
+	}{
+		"Invalid method": {
+			Error: &ConfigError{
+				Indicator: NamePivots,
+				Field:     "method",
+				Value:     PivotMethod(0),
+				Err:       ErrInvalidPivotMethod,
+			},
+		},
+		"Successfully validated": {
+			Pivots: Pivots{
+				method: PivotClassic,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Pivots.validate())
+			if c.Error == nil {
+				assert.True(t, c.Pivots.valid)
+			}
+		})
+	}
+}
+
+func Test_Pivots_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		Pivots  Pivots
+		Candles []Candle
+		Result  []string
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Pivots: Pivots{
+				valid:  true,
+				method: PivotClassic,
+			},
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation with PivotClassic": {
+			Pivots: Pivots{
+				valid:  true,
+				method: PivotClassic,
+			},
+			Candles: testCandles(t)[4:],
+			Result: []string{
+				"13.33333333",
+				"15.66666667", "17.33333333", "19.66666667",
+				"11.66666667", "9.33333333", "7.66666667",
+			},
+		},
+		"Successful calculation with PivotFibonacci": {
+			Pivots: Pivots{
+				valid:  true,
+				method: PivotFibonacci,
+			},
+			Candles: testCandles(t)[4:],
+			Result: []string{
+				"13.33333333",
+				"14.86133333", "15.80533333", "17.33333333",
+				"11.80533333", "10.86133333", "9.33333333",
+			},
+		},
+		"Successful calculation with PivotCamarilla": {
+			Pivots: Pivots{
+				valid:  true,
+				method: PivotCamarilla,
+			},
+			Candles: testCandles(t)[4:],
+			Result: []string{
+				"13.33333333",
+				"14.36666667", "14.73333333", "15.1",
+				"13.63333333", "13.26666667", "12.9",
+			},
+		},
+		"Successful calculation with PivotWoodie": {
+			Pivots: Pivots{
+				valid:  true,
+				method: PivotWoodie,
+			},
+			Candles: testCandles(t)[4:],
+			Result: []string{
+				"13.5",
+				"16", "17.5", "20",
+				"12", "9.5", "8",
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Pivots.CalcLines(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			dd := []decimal.Decimal{res.PP, res.R1, res.R2, res.R3, res.S1, res.S2, res.S3}
+			for i := range dd {
+				dd[i] = dd[i].Round(8)
+			}
+
+			assert.Equal(t, c.Result, decimalStrings(dd))
+		})
+	}
+}
+
+func Test_Pivots_Count(t *testing.T) {
+	assert.Equal(t, 1, Pivots{}.Count())
+}
+
+func Test_Pivots_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NamePivots,
+		Input:   InputCandle,
+		Overlay: true,
+	}, Pivots{}.Describe())
+}
+
+// testPPO returns a small valid PPO used in calculation tests.
+func testPPO() PPO {
+	return PPO{
+		valid: true,
+		macd: MACD{
+			valid:  true,
+			ma1:    SMA{valid: true, length: 2},
+			ma2:    SMA{valid: true, length: 3},
+			signal: SMA{valid: true, length: 2},
+		},
+	}
+}
+
+func Test_NewPPO(t *testing.T) {
+	cc := map[string]struct {
+		Fast   Indicator
+		Slow   Indicator
+		Signal Indicator
+		Result PPO
+		Error  error
+	}{
+		"NewMACD returns an error": {
+			Signal: SMA{valid: true, length: 2},
+			Error:  assert.AnError,
+		},
+		"Successfully created new PPO with default signal": {
+			Fast: SMA{valid: true, length: 2},
+			Slow: SMA{valid: true, length: 3},
+			Result: PPO{
+				valid: true,
+				macd: MACD{
+					valid: true,
+					ma1:   SMA{valid: true, length: 2},
+					ma2:   SMA{valid: true, length: 3},
+					signal: EMA{
+						valid: true,
+						sma:   SMA{valid: true, length: 9},
+					},
+				},
+			},
+		},
+		"Successfully created new PPO": {
+			Fast:   SMA{valid: true, length: 2},
+			Slow:   SMA{valid: true, length: 3},
+			Signal: SMA{valid: true, length: 2},
+			Result: testPPO(),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewPPO(c.Fast, c.Slow, c.Signal)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_PPO_validate(t *testing.T) {
+	cc := map[string]struct {
+		PPO   PPO
+		Error error
+	}{
+		"Invalid MACD": {
+			Error: &ConfigError{
+				Indicator: NamePPO,
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Successfully validated": {
+			PPO: PPO{
+				macd: MACD{valid: true},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.PPO.validate())
+			if c.Error == nil {
+				assert.True(t, c.PPO.valid)
+			}
+		})
+	}
+}
+
+func Test_PPO_Calc(t *testing.T) {
+	cc := map[string]struct {
+		PPO    PPO
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			PPO:   testPPO(),
+			Data:  series(1),
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			PPO:    testPPO(),
+			Data:   series(2, 4, 5),
+			Result: decimal.RequireFromString("22.72727273"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.PPO.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_PPO_CalcLines(t *testing.T) {
+	invalidSignal := testPPO()
+	invalidSignal.macd.signal = SMA{length: 2}
+
+	invalidMA := testPPO()
+	invalidMA.macd.ma1 = SMA{length: 2}
+
+	cc := map[string]struct {
+		PPO    PPO
+		Data   []decimal.Decimal
+		Result PPOLines
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			PPO:   testPPO(),
+			Data:  series(1),
+			Error: ErrInvalidDataSize,
+		},
+		"Moving average returns an error": {
+			PPO:   invalidMA,
+			Data:  series(1, 2, 4, 5),
+			Error: ErrInvalidIndicator,
+		},
+		"Signal returns an error": {
+			PPO:   invalidSignal,
+			Data:  series(1, 2, 4, 5),
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			PPO:  testPPO(),
+			Data: series(1, 2, 4, 5),
+			Result: PPOLines{
+				PPO:       decimal.RequireFromString("22.72727273"),
+				Signal:    decimal.RequireFromString("25.64935065"),
+				Histogram: decimal.RequireFromString("-2.92207792"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.PPO.CalcLines(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.PPO.Round(8).String(), res.PPO.Round(8).String())
+			assert.Equal(t, c.Result.Signal.Round(8).String(), res.Signal.Round(8).String())
+			assert.Equal(t, c.Result.Histogram.Round(8).String(), res.Histogram.Round(8).String())
+		})
+	}
+}
+
+func Test_PPO_line(t *testing.T) {
+	ppo := testPPO()
+	ppo.macd.ma1 = SMA{length: 2}
+
+	_, err := ppo.line(series(1, 2, 4))
+	assert.Equal(t, ErrInvalidIndicator, err)
+
+	res, err := testPPO().line(series(0, 0, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, "0", res.String())
+}
+
+func Test_PPO_Count(t *testing.T) {
+	assert.Equal(t, 3, testPPO().Count())
+}
+
+func Test_PPO_CountLines(t *testing.T) {
+	assert.Equal(t, 4, testPPO().CountLines())
+}
+
+func Test_PPO_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NamePPO,
+		Input: InputClose,
+	}, PPO{}.Describe())
+}
+
+func Test_NewProjectionBands(t *testing.T) {
+	cc := map[string]struct {
+		Band   Band
+		Length int
+		Result ProjectionBands
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new ProjectionBands": {
+			Band:   BandLower,
+			Length: 2,
+			Result: ProjectionBands{
+				valid:  true,
+				band:   BandLower,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewProjectionBands(c.Band, c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ProjectionBands_validate(t *testing.T) {
+	cc := map[string]struct {
+		ProjectionBands ProjectionBands
+		Error           error
+	}{
+		"Invalid band": {
+			ProjectionBands: ProjectionBands{
+				length: 2,
+			},
+			Error: &ConfigError{
+				Indicator: NameProjectionBands,
+				Field:     "band",
+				Value:     Band(0),
+				Err:       ErrInvalidBand,
+			},
+		},
+		"Invalid length": {
+			ProjectionBands: ProjectionBands{
+				band:   BandUpper,
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameProjectionBands,
+				Field:     "length",
+				Value:     1,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			ProjectionBands: ProjectionBands{
+				band:   BandUpper,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.ProjectionBands.validate())
+			if c.Error == nil {
+				assert.True(t, c.ProjectionBands.valid)
+			}
+		})
+	}
+}
+
+func Test_ProjectionBands_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		ProjectionBands ProjectionBands
+		Candles         []Candle
+		Result          string
+		Error           error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation with BandUpper": {
+			ProjectionBands: ProjectionBands{
+				valid:  true,
+				band:   BandUpper,
+				length: 5,
+			},
+			Candles: testCandles(t),
+			Result:  "15",
+		},
+		"Successful calculation with BandLower": {
+			ProjectionBands: ProjectionBands{
+				valid:  true,
+				band:   BandLower,
+				length: 5,
+			},
+			Candles: testCandles(t),
+			Result:  "10.4",
+		},
+		"Successful calculation with BandWidth": {
+			ProjectionBands: ProjectionBands{
+				valid:  true,
+				band:   BandWidth,
+				length: 5,
+			},
+			Candles: testCandles(t),
+			Result:  "36.22047244094488",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ProjectionBands.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, res.String())
+		})
+	}
+}
+
+func Test_ProjectionBands_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		ProjectionBands ProjectionBands
+		Candles         []Candle
+		Result          ProjectionBandsLines
+		Error           error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			ProjectionBands: ProjectionBands{
+				valid:  true,
+				band:   BandUpper,
+				length: 5,
+			},
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			ProjectionBands: ProjectionBands{
+				valid:  true,
+				band:   BandUpper,
+				length: 5,
+			},
+			Candles: testCandles(t),
+			Result: ProjectionBandsLines{
+				Upper: decimal.NewFromInt(15),
+				Lower: decimal.RequireFromString("10.4"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ProjectionBands.CalcLines(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Upper.String(), res.Upper.String())
+			assert.Equal(t, c.Result.Lower.String(), res.Lower.String())
+		})
+	}
+}
+
+func Test_ProjectionBands_Count(t *testing.T) {
+	assert.Equal(t, 5, ProjectionBands{
+		length: 5,
+	}.Count())
+}
+
+func Test_ProjectionBands_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameProjectionBands,
+		Input:   InputCandle,
+		Overlay: true,
+	}, ProjectionBands{band: BandUpper}.Describe())
+
+	assertEqualDescription(t, Description{
+		Name:  NameProjectionBands,
+		Input: InputCandle,
+	}, ProjectionBands{band: BandWidth}.Describe())
+}
+
+func Test_NewProjectionOscillator(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result ProjectionOscillator
+		Error  error
+	}{
+		"NewProjectionBands returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new ProjectionOscillator": {
+			Length: 2,
+			Result: ProjectionOscillator{
+				valid: true,
+				pb: ProjectionBands{
+					valid:  true,
+					band:   BandWidth,
+					length: 2,
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewProjectionOscillator(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ProjectionOscillator_validate(t *testing.T) {
+	cc := map[string]struct {
+		ProjectionOscillator ProjectionOscillator
+		Error                error
+	}{
+		"Invalid projection bands": {
+			Error: &ConfigError{
+				Indicator: NameProjectionOscillator,
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Successfully validated": {
+			ProjectionOscillator: ProjectionOscillator{
+				pb: ProjectionBands{
+					valid: true,
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.ProjectionOscillator.validate())
+			if c.Error == nil {
+				assert.True(t, c.ProjectionOscillator.valid)
+			}
+		})
+	}
+}
+
+func Test_ProjectionOscillator_CalcCandles(t *testing.T) {
+	pb := ProjectionBands{
+		valid:  true,
+		band:   BandWidth,
+		length: 5,
+	}
+
+	cc := map[string]struct {
+		ProjectionOscillator ProjectionOscillator
+		Candles              []Candle
+		Result               decimal.Decimal
+		Error                error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			ProjectionOscillator: ProjectionOscillator{
+				valid: true,
+				pb:    pb,
+			},
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			ProjectionOscillator: ProjectionOscillator{
+				valid: true,
+				pb:    pb,
+			},
+			Candles: make([]Candle, 5),
+			Result:  decimal.Zero,
+		},
+		"Successful calculation": {
+			ProjectionOscillator: ProjectionOscillator{
+				valid: true,
+				pb:    pb,
+			},
+			Candles: testCandles(t),
+			Result:  decimal.RequireFromString("78.26086957"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ProjectionOscillator.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_ProjectionOscillator_Count(t *testing.T) {
+	assert.Equal(t, 5, ProjectionOscillator{
+		pb: ProjectionBands{
+			length: 5,
+		},
+	}.Count())
+}
+
+func Test_ProjectionOscillator_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameProjectionOscillator,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     decimal.NewFromInt(100),
+	}, ProjectionOscillator{}.Describe())
+}
+
+func Test_NewPVI(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Signal int
+		Result PVI
+		Error  error
+	}{
+		"Invalid signal": {
+			Length: 2,
+			Signal: -1,
+			Error:  assert.AnError,
+		},
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new PVI without signal": {
+			Length: 2,
+			Result: PVI{
+				valid:  true,
+				length: 2,
+			},
+		},
+		"Successfully created new PVI with signal": {
+			Length: 2,
+			Signal: 2,
+			Result: PVI{
+				valid:  true,
+				length: 2,
+				signal: EMA{valid: true, sma: SMA{valid: true, length: 2}},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewPVI(c.Length, c.Signal)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_PVI_validate(t *testing.T) {
+	cc := map[string]struct {
+		PVI   PVI
+		Error error
+	}{
+		"Invalid length": {
+			Error: &ConfigError{
+				Indicator: NamePVI,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			PVI: PVI{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.PVI.validate())
+			if c.Error == nil {
+				assert.True(t, c.PVI.valid)
+			}
+		})
+	}
+}
+
+func Test_PVI_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		PVI     PVI
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			PVI:     PVI{valid: true, length: 4},
+			Candles: testCandles(t),
+			Result:  decimal.RequireFromString("1010.1010101"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.PVI.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_PVI_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		PVI     PVI
+		Candles []Candle
+		Result  VolumeIndexLines
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			PVI:     PVI{valid: true, length: 4},
+			Candles: testCandles(t)[:4],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			PVI: PVI{
+				valid:  true,
+				length: 2,
+				signal: EMA{valid: true, sma: SMA{valid: true, length: 2}},
+			},
+			Candles: testCandles(t),
+			Result: VolumeIndexLines{
+				Index:  decimal.RequireFromString("1010.1010101"),
+				Signal: decimal.RequireFromString("1026.93602694"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.PVI.CalcLines(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Index.Round(8).String(), res.Index.Round(8).String())
+			assert.Equal(t, c.Result.Signal.Round(8).String(), res.Signal.Round(8).String())
+		})
+	}
+}
+
+func Test_PVI_Count(t *testing.T) {
+	assert.Equal(t, 6, PVI{length: 5}.Count())
+	assert.Equal(t, 8, PVI{
+		length: 5,
+		signal: EMA{valid: true, sma: SMA{valid: true, length: 2}},
+	}.Count())
+}
+
+func Test_PVI_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NamePVI,
+		Input: InputCandle,
+	}, PVI{}.Describe())
+}
+
+func Test_NewPVO(t *testing.T) {
+	cc := map[string]struct {
+		Fast   Indicator
+		Slow   Indicator
+		Signal Indicator
+		Result PVO
+		Error  error
+	}{
+		"NewPPO returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new PVO": {
+			Fast:   SMA{valid: true, length: 2},
+			Slow:   SMA{valid: true, length: 3},
+			Signal: SMA{valid: true, length: 2},
+			Result: PVO{
+				valid: true,
+				ppo:   testPPO(),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewPVO(c.Fast, c.Slow, c.Signal)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_PVO_validate(t *testing.T) {
+	cc := map[string]struct {
+		PVO   PVO
+		Error error
+	}{
+		"Invalid PPO": {
+			Error: &ConfigError{
+				Indicator: NamePVO,
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Successfully validated": {
+			PVO: PVO{
+				ppo: testPPO(),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.PVO.validate())
+			if c.Error == nil {
+				assert.True(t, c.PVO.valid)
+			}
+		})
+	}
+}
+
+func Test_PVO_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		PVO     PVO
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			PVO:     PVO{valid: true, ppo: testPPO()},
+			Candles: testCandles(t)[:1],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			PVO:     PVO{valid: true, ppo: testPPO()},
+			Candles: testCandles(t)[1:4],
+			Result:  decimal.RequireFromString("3.84615385"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.PVO.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_PVO_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		PVO     PVO
+		Candles []Candle
+		Result  PPOLines
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			PVO:     PVO{valid: true, ppo: testPPO()},
+			Candles: testCandles(t)[:1],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			PVO:     PVO{valid: true, ppo: testPPO()},
+			Candles: testCandles(t)[:4],
+			Result: PPOLines{
+				PPO:       decimal.RequireFromString("3.84615385"),
+				Signal:    decimal.RequireFromString("10.25641026"),
+				Histogram: decimal.RequireFromString("-6.41025641"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.PVO.CalcLines(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.PPO.Round(8).String(), res.PPO.Round(8).String())
+			assert.Equal(t, c.Result.Signal.Round(8).String(), res.Signal.Round(8).String())
+			assert.Equal(t, c.Result.Histogram.Round(8).String(), res.Histogram.Round(8).String())
+		})
+	}
+}
+
+func Test_PVO_Count(t *testing.T) {
+	assert.Equal(t, 3, PVO{ppo: testPPO()}.Count())
+}
+
+func Test_PVO_CountLines(t *testing.T) {
+	assert.Equal(t, 4, PVO{ppo: testPPO()}.CountLines())
+}
+
+func Test_PVO_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NamePVO,
+		Input: InputCandle,
+	}, PVO{}.Describe())
+}
+
+func Test_NewQstick(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result Qstick
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Qstick": {
+			Length: 2,
+			Result: Qstick{
+				valid:  true,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewQstick(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Qstick_validate(t *testing.T) {
+	cc := map[string]struct {
+		Qstick Qstick
+		Error  error
+	}{
+		"Invalid length": {
+			Qstick: Qstick{
+				length: 0,
+			},
+			Error: &ConfigError{
+				Indicator: NameQstick,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			Qstick: Qstick{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Qstick.validate())
+			if c.Error == nil {
+				assert.True(t, c.Qstick.valid)
+			}
+		})
+	}
+}
+
+func Test_Qstick_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		Qstick  Qstick
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Qstick: Qstick{
+				valid:  true,
+				length: 3,
+			},
+			Candles: testCandles(t)[:1],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			Qstick: Qstick{
+				valid:  true,
+				length: 3,
+			},
+			Candles: testCandles(t)[2:],
+			Result:  decimal.RequireFromString("1.33333333"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Qstick.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_Qstick_Count(t *testing.T) {
+	assert.Equal(t, 5, Qstick{
+		length: 5,
+	}.Count())
+}
+
+func Test_Qstick_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameQstick,
+		Input: InputCandle,
+	}, Qstick{}.Describe())
+}
+
+// testRainbowData returns data points used in Rainbow calculation
+// tests.
+func testRainbowData() []decimal.Decimal {
+	return []decimal.Decimal{
+		decimal.NewFromInt(1),
+		decimal.NewFromInt(3),
+		decimal.NewFromInt(2),
+		decimal.NewFromInt(4),
+		decimal.NewFromInt(6),
+		decimal.NewFromInt(5),
+		decimal.NewFromInt(7),
+		decimal.NewFromInt(9),
+		decimal.NewFromInt(8),
+		decimal.NewFromInt(10),
+		decimal.NewFromInt(12),
+	}
+}
+
+func Test_NewRainbow(t *testing.T) {
+	cc := map[string]struct {
+		Length   int
+		Lookback int
+		Result   Rainbow
+		Error    error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Rainbow": {
+			Length:   2,
+			Lookback: 10,
+			Result: Rainbow{
+				valid:    true,
+				length:   2,
+				lookback: 10,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewRainbow(c.Length, c.Lookback)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Rainbow_validate(t *testing.T) {
+	cc := map[string]struct {
+		Rainbow Rainbow
+		Error   error
+	}{
+		"Invalid length": {
+			Rainbow: Rainbow{
+				lookback: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameRainbow,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid lookback": {
+			Rainbow: Rainbow{
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameRainbow,
+				Field:     "lookback",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			Rainbow: Rainbow{
+				length:   1,
+				lookback: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Rainbow.validate())
+			if c.Error == nil {
+				assert.True(t, c.Rainbow.valid)
+			}
+		})
+	}
+}
+
+func Test_Rainbow_Calc(t *testing.T) {
+	cc := map[string]struct {
+		Rainbow Rainbow
+		Data    []decimal.Decimal
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			Rainbow: Rainbow{
+				valid:    true,
+				length:   2,
+				lookback: 3,
+			},
+			Data:   testRainbowData(),
+			Result: decimal.RequireFromString("92.91748047"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Rainbow.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_Rainbow_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		Rainbow Rainbow
+		Data    []decimal.Decimal
+		Result  RainbowLines
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Rainbow: Rainbow{
+				valid:    true,
+				length:   2,
+				lookback: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			Rainbow: Rainbow{
+				valid:    true,
+				length:   1,
+				lookback: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(1),
+			},
+			Result: RainbowLines{
+				Oscillator: decimal.Zero,
+				Bandwidth:  decimal.Zero,
+			},
+		},
+		"Successful calculation": {
+			Rainbow: Rainbow{
+				valid:    true,
+				length:   2,
+				lookback: 3,
+			},
+			Data: testRainbowData(),
+			Result: RainbowLines{
+				Oscillator: decimal.RequireFromString("92.91748047"),
+				Bandwidth:  decimal.RequireFromString("125.02441406"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Rainbow.CalcLines(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Oscillator.Round(8).String(), res.Oscillator.Round(8).String())
+			assert.Equal(t, c.Result.Bandwidth.Round(8).String(), res.Bandwidth.Round(8).String())
+		})
+	}
+}
+
+func Test_Rainbow_Count(t *testing.T) {
+	assert.Equal(t, 11, Rainbow{
+		length:   2,
+		lookback: 10,
+	}.Count())
+
+	assert.Equal(t, 12, Rainbow{
+		length:   1,
+		lookback: 12,
+	}.Count())
+}
+
+func Test_Rainbow_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameRainbow,
+		Input: InputClose,
+	}, Rainbow{}.Describe())
+}
+
+func Test_NewRibbon(t *testing.T) {
+	cc := map[string]struct {
+		MAs    []Indicator
+		Result Ribbon
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Ribbon": {
+			MAs: []Indicator{SMA{valid: true, length: 2}, SMA{valid: true, length: 3}},
+			Result: Ribbon{
+				valid: true,
+				mas:   []Indicator{SMA{valid: true, length: 2}, SMA{valid: true, length: 3}},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewRibbon(c.MAs...)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_newEMARibbon(t *testing.T) {
+	_, err := newEMARibbon(2, 0)
+	assert.Equal(t, &ConfigError{
+		Indicator: NameEMA,
+		Field:     "length",
+		Value:     0,
+		Err:       ErrInvalidLength,
+	}, err)
+
+	ribbon, err := newEMARibbon(2, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, Ribbon{
+		valid: true,
+		mas: []Indicator{
+			EMA{valid: true, sma: SMA{valid: true, length: 2}},
+			EMA{valid: true, sma: SMA{valid: true, length: 3}},
+		},
+	}, ribbon)
+}
+
+func Test_Ribbon_validate(t *testing.T) {
+	cc := map[string]struct {
+		Ribbon Ribbon
+		Error  error
+	}{
+		"No moving averages": {
+			Error: &ConfigError{
+				Indicator: NameRibbon,
+				Field:     "mas",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Nil moving average": {
+			Ribbon: Ribbon{
+				mas: []Indicator{SMA{}, nil},
+			},
+			Error: &ConfigError{
+				Indicator: NameRibbon,
+				Field:     "mas[1]",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Successfully validated": {
+			Ribbon: Ribbon{
+				mas: []Indicator{SMA{}},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Ribbon.validate())
+			if c.Error == nil {
+				assert.True(t, c.Ribbon.valid)
+			}
+		})
+	}
+}
+
+func Test_Ribbon_Calc(t *testing.T) {
+	ribbon := Ribbon{
+		valid: true,
+		mas:   []Indicator{SMA{valid: true, length: 2}, SMA{valid: true, length: 3}},
+	}
+
+	cc := map[string]struct {
+		Ribbon Ribbon
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"CalcLines returns an error": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			Ribbon: ribbon,
+			Data:   series(2, 4, 6),
+			Result: decimal.RequireFromString("4.5"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Ribbon.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_Ribbon_CalcLines(t *testing.T) {
+	ribbon := Ribbon{
+		valid: true,
+		mas:   []Indicator{SMA{valid: true, length: 2}, SMA{valid: true, length: 3}},
+	}
+
+	cc := map[string]struct {
+		Ribbon Ribbon
+		Data   []decimal.Decimal
+		Result []string
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Ribbon: ribbon,
+			Data:   series(1, 2),
+			Error:  ErrInvalidDataSize,
+		},
+		"Moving average returns an error": {
+			Ribbon: Ribbon{
+				valid: true,
+				mas:   []Indicator{SMA{length: 2}},
+			},
+			Data:  series(1, 2),
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			Ribbon: ribbon,
+			Data:   series(2, 4, 6),
+			Result: []string{"5", "4"},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Ribbon.CalcLines(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, decimalStrings(res))
+		})
+	}
+}
+
+func Test_Ribbon_Count(t *testing.T) {
+	assert.Equal(t, 3, Ribbon{
+		mas: []Indicator{SMA{length: 2}, SMA{length: 3}, SMA{length: 1}},
+	}.Count())
+}
+
+func Test_Ribbon_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameRibbon,
+		Input:   InputClose,
+		Overlay: true,
+	}, Ribbon{}.Describe())
+}
+
+func Test_NewROC(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result ROC
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new ROC": {
+			Length: 1,
+			Result: ROC{
+				valid:  true,
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewROC(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ROC_validate(t *testing.T) {
+	cc := map[string]struct {
+		ROC   ROC
+		Error error
+	}{
+		"Invalid length": {
+			ROC: ROC{
+				length: -1,
+			},
+			Error: &ConfigError{
+				Indicator: NameROC,
+				Field:     "length",
+				Value:     -1,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			ROC: ROC{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.ROC.validate())
+			if c.Error == nil {
+				assert.True(t, c.ROC.valid)
+			}
+		})
+	}
+}
+
+func Test_ROC_Calc(t *testing.T) {
+	cc := map[string]struct {
+		ROC    ROC
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			ROC:   ROC{},
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			ROC: ROC{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			ROC: ROC{
+				valid:  true,
+				length: 5,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(7),
+				decimal.NewFromInt(16),
+				decimal.NewFromInt(24),
+				decimal.NewFromInt(16),
+				decimal.NewFromInt(10),
+			},
+			Result: decimal.RequireFromString("-30"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ROC.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_ROC_Count(t *testing.T) {
+	assert.Equal(t, 15, ROC{
+		length: 15,
+	}.Count())
+}
+
+func Test_ROC_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameROC,
+		Input: InputClose,
+	}, ROC{}.Describe())
+}
+
+func Test_NewRSC(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result RSC
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new RSC": {
+			Length: 2,
+			Result: RSC{
+				valid:  true,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewRSC(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_RSC_validate(t *testing.T) {
+	cc := map[string]struct {
+		RSC   RSC
+		Error error
+	}{
+		"Invalid length": {
+			RSC: RSC{
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameRSC,
+				Field:     "length",
+				Value:     1,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			RSC: RSC{
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.RSC.validate())
+			if c.Error == nil {
+				assert.True(t, c.RSC.valid)
+			}
+		})
+	}
+}
+
+func Test_RSC_CalcPair(t *testing.T) {
+	cc := map[string]struct {
+		RSC       RSC
+		Data      []decimal.Decimal
+		Benchmark []decimal.Decimal
+		Result    decimal.Decimal
+		Error     error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			RSC:       RSC{valid: true, length: 3},
+			Data:      series(10, 12, 15),
+			Benchmark: series(5, 4, 5),
+			Result:    decimal.NewFromInt(3),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.RSC.CalcPair(c.Data, c.Benchmark)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_RSC_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		RSC       RSC
+		Data      []decimal.Decimal
+		Benchmark []decimal.Decimal
+		Result    RSCLines
+		Error     error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			RSC:       RSC{valid: true, length: 3},
+			Data:      series(10, 12),
+			Benchmark: series(5, 4, 5),
+			Error:     ErrInvalidDataSize,
+		},
+		"Invalid benchmark size": {
+			RSC:       RSC{valid: true, length: 3},
+			Data:      series(10, 12, 15),
+			Benchmark: series(4, 5),
+			Error:     ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			RSC:       RSC{valid: true, length: 3},
+			Data:      series(10, 12, 15),
+			Benchmark: series(0, 4, 0),
+			Result: RSCLines{
+				Ratio: decimal.Zero,
+				ROC:   decimal.Zero,
+			},
+		},
+		"Successful calculation": {
+			RSC:       RSC{valid: true, length: 3},
+			Data:      series(10, 12, 15),
+			Benchmark: series(5, 4, 5),
+			Result: RSCLines{
+				Ratio: decimal.NewFromInt(3),
+				ROC:   decimal.NewFromInt(50),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.RSC.CalcLines(c.Data, c.Benchmark)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Ratio.String(), res.Ratio.String())
+			assert.Equal(t, c.Result.ROC.String(), res.ROC.String())
+		})
+	}
+}
+
+func Test_RSC_Count(t *testing.T) {
+	assert.Equal(t, 5, RSC{
+		length: 5,
+	}.Count())
+}
+
+func Test_RSC_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameRSC,
+		Input: InputPair,
+	}, RSC{}.Describe())
+}
+
+func Test_NewRSI(t *testing.T) {
+	cc := map[string]struct {
+		Length    int
+		Smoothing Smoothing
+		Result    RSI
+		Error     error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new RSI": {
+			Length:    1,
+			Smoothing: SmoothingWilder,
+			Result: RSI{
+				valid:     true,
+				length:    1,
+				smoothing: SmoothingWilder,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewRSI(c.Length, c.Smoothing)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_RSI_validate(t *testing.T) {
+	cc := map[string]struct {
+		RSI   RSI
+		Error error
+	}{
+		"Invalid smoothing": {
+			RSI: RSI{
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameRSI,
+				Field:     "smoothing",
+				Value:     Smoothing(0),
+				Err:       ErrInvalidSmoothing,
+			},
+		},
+		"Invalid length": {
+			RSI: RSI{
+				length:    0,
+				smoothing: SmoothingSMA,
+			},
+			Error: &ConfigError{
+				Indicator: NameRSI,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			RSI: RSI{
+				length:    1,
+				smoothing: SmoothingSMA,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.RSI.validate())
+			if c.Error == nil {
+				assert.True(t, c.RSI.valid)
+			}
+		})
+	}
+}
+
+func Test_RSI_Calc(t *testing.T) {
+	cc := map[string]struct {
+		RSI    RSI
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			RSI:   RSI{},
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			RSI: RSI{
+				valid:     true,
+				length:    3,
+				smoothing: SmoothingSMA,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation when average gain 0": {
+			RSI: RSI{
+				valid:     true,
+				length:    3,
+				smoothing: SmoothingSMA,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(16),
+				decimal.NewFromInt(12),
+				decimal.NewFromInt(8),
+			},
+			Result: decimal.NewFromInt(0),
+		},
+		"Successful calculation when average loss 0": {
+			RSI: RSI{
+				valid:     true,
+				length:    3,
+				smoothing: SmoothingSMA,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(4),
+				decimal.NewFromInt(8),
+			},
+			Result: _hundred,
+		},
+		"Successful calculation": {
+			RSI: RSI{
+				valid:     true,
+				length:    3,
+				smoothing: SmoothingSMA,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(8),
+				decimal.NewFromInt(12),
+				decimal.NewFromInt(8),
+			},
+			Result: decimal.NewFromInt(50),
+		},
+		"Successful calculation when data points are flat": {
+			RSI: RSI{
+				valid:     true,
+				length:    3,
+				smoothing: SmoothingSMA,
+			},
+			Data:   series(8, 8, 8),
+			Result: decimal.NewFromInt(50),
+		},
+		"Successful calculation with SmoothingWilder when average gain 0": {
+			RSI: RSI{
+				valid:     true,
+				length:    2,
+				smoothing: SmoothingWilder,
+			},
+			Data:   series(16, 12, 8, 8),
+			Result: decimal.Zero,
+		},
+		"Successful calculation with SmoothingWilder when average loss 0": {
+			RSI: RSI{
+				valid:     true,
+				length:    2,
+				smoothing: SmoothingWilder,
+			},
+			Data:   series(2, 4, 8, 8),
+			Result: _hundred,
+		},
+		"Successful calculation with SmoothingWilder when data points are flat": {
+			RSI: RSI{
+				valid:     true,
+				length:    2,
+				smoothing: SmoothingWilder,
+			},
+			Data:   series(8, 8, 8, 8),
+			Result: decimal.NewFromInt(50),
+		},
+		"Successful calculation with SmoothingWilder": {
+			RSI: RSI{
+				valid:     true,
+				length:    2,
+				smoothing: SmoothingWilder,
+			},
+			Data:   series(8, 12, 8, 10),
+			Result: decimal.RequireFromString("66.66666667"),
+		},
+		"Successful calculation with SmoothingEMA": {
+			RSI: RSI{
+				valid:     true,
+				length:    2,
+				smoothing: SmoothingEMA,
+			},
+			Data:   series(8, 12, 8, 10),
+			Result: decimal.NewFromInt(75),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.RSI.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_RSI_Calc_reference(t *testing.T) {
+	// reference data and values of Wilder's RSI provided by stockcharts,
+	// which match TA-Lib.
+	// https://school.stockcharts.com/doku.php?id=technical_indicators:relative_strength_index_rsi.
+	dd := wilderReference(t)
+
+	rsi, err := NewRSI(14, SmoothingWilder)
+	require.NoError(t, err)
+
+	res, err := rsi.Calc(dd[:rsi.Count()])
+	require.NoError(t, err)
+	assert.Equal(t, "41.46", res.Round(2).String())
+}
+
+func Test_RSI_Count(t *testing.T) {
+	assert.Equal(t, 15, RSI{
+		length:    15,
+		smoothing: SmoothingSMA,
+	}.Count())
+
+	assert.Equal(t, 30, RSI{
+		length:    15,
+		smoothing: SmoothingWilder,
+	}.Count())
+}
+
+func Test_RSI_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameRSI,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}, RSI{}.Describe())
+}
+
+func Test_NewSavitzkyGolay(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Order  int
+		Result SavitzkyGolay
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new SavitzkyGolay": {
+			Length: 5,
+			Order:  2,
+			Result: SavitzkyGolay{
+				valid:  true,
+				length: 5,
+				order:  2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewSavitzkyGolay(c.Length, c.Order)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_SavitzkyGolay_validate(t *testing.T) {
+	cc := map[string]struct {
+		SavitzkyGolay SavitzkyGolay
+		Error         error
+	}{
+		"Invalid length": {
+			Error: &ConfigError{
+				Indicator: NameSavitzkyGolay,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Negative order": {
+			SavitzkyGolay: SavitzkyGolay{
+				length: 3,
+				order:  -1,
+			},
+			Error: &ConfigError{
+				Indicator: NameSavitzkyGolay,
+				Field:     "order",
+				Value:     -1,
+				Err:       errors.New("invalid order"),
+			},
+		},
+		"Too high order": {
+			SavitzkyGolay: SavitzkyGolay{
+				length: 3,
+				order:  3,
+			},
+			Error: &ConfigError{
+				Indicator: NameSavitzkyGolay,
+				Field:     "order",
+				Value:     3,
+				Err:       errors.New("invalid order"),
+			},
+		},
+		"Successfully validated": {
+			SavitzkyGolay: SavitzkyGolay{
+				length: 3,
+				order:  2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.SavitzkyGolay.validate())
+			if c.Error == nil {
+				assert.True(t, c.SavitzkyGolay.valid)
+			}
+		})
+	}
+}
+
+func Test_SavitzkyGolay_Calc(t *testing.T) {
+	cc := map[string]struct {
+		SavitzkyGolay SavitzkyGolay
+		Data          []decimal.Decimal
+		Result        decimal.Decimal
+		Error         error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			SavitzkyGolay: SavitzkyGolay{valid: true, length: 3},
+			Data:          series(1, 2),
+			Error:         ErrInvalidDataSize,
+		},
+		"Successful calculation with zero order": {
+			SavitzkyGolay: SavitzkyGolay{valid: true, length: 3},
+			Data:          series(1, 2, 6),
+			Result:        decimal.NewFromInt(3),
+		},
+		"Successful calculation with linear order": {
+			SavitzkyGolay: SavitzkyGolay{valid: true, length: 3, order: 1},
+			Data:          series(1, 2, 4),
+			Result:        decimal.RequireFromString("3.83333333"),
+		},
+		"Successful calculation with quadratic order": {
+			SavitzkyGolay: SavitzkyGolay{valid: true, length: 5, order: 2},
+			Data:          series(1, 4, 9, 16, 25),
+			Result:        decimal.NewFromInt(25),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.SavitzkyGolay.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_SavitzkyGolay_Count(t *testing.T) {
+	assert.Equal(t, 5, SavitzkyGolay{
+		length: 5,
+	}.Count())
+}
+
+func Test_SavitzkyGolay_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameSavitzkyGolay,
+		Input:   InputClose,
+		Overlay: true,
+	}, SavitzkyGolay{}.Describe())
+}
+
+func Test_NewSharpe(t *testing.T) {
+	cc := map[string]struct {
+		Length  int
+		Periods int
+		Result  Sharpe
+		Error   error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Sharpe": {
+			Length:  2,
+			Periods: 252,
+			Result: Sharpe{
+				valid:   true,
+				length:  2,
+				periods: 252,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewSharpe(c.Length, c.Periods)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Sharpe_validate(t *testing.T) {
+	cc := map[string]struct {
+		Sharpe Sharpe
+		Error  error
+	}{
+		"Invalid length": {
+			Sharpe: Sharpe{
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameSharpe,
+				Field:     "length",
+				Value:     1,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid periods": {
+			Sharpe: Sharpe{
+				length:  2,
+				periods: -1,
+			},
+			Error: &ConfigError{
+				Indicator: NameSharpe,
+				Field:     "periods",
+				Value:     -1,
+				Err:       errors.New("invalid periods"),
+			},
+		},
+		"Successfully validated": {
+			Sharpe: Sharpe{
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Sharpe.validate())
+			if c.Error == nil {
+				assert.True(t, c.Sharpe.valid)
+			}
+		})
+	}
+}
+
+func Test_Sharpe_Calc(t *testing.T) {
+	cc := map[string]struct {
+		Sharpe Sharpe
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Sharpe: Sharpe{valid: true, length: 3},
+			Data:   series(100, 105, 100),
+			Error:  ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			Sharpe: Sharpe{valid: true, length: 3},
+			Data:   series(100, 100, 100, 100),
+			Result: decimal.Zero,
+		},
+		"Successful calculation": {
+			Sharpe: Sharpe{valid: true, length: 3},
+			Data:   series(100, 105, 100, 110),
+			Result: decimal.RequireFromString("0.55670719"),
+		},
+		"Successful annualized calculation": {
+			Sharpe: Sharpe{valid: true, length: 3, periods: 252},
+			Data:   series(100, 105, 100, 110),
+			Result: decimal.RequireFromString("8.83745268"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Sharpe.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_Sharpe_Count(t *testing.T) {
+	assert.Equal(t, 6, Sharpe{
+		length: 5,
+	}.Count())
+}
+
+func Test_Sharpe_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameSharpe,
+		Input: InputClose,
+	}, Sharpe{}.Describe())
+}
+
+func Test_NewSkew(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result Skew
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Skew": {
+			Length: 2,
+			Result: Skew{
+				valid:  true,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewSkew(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Skew_validate(t *testing.T) {
+	cc := map[string]struct {
+		Skew  Skew
+		Error error
+	}{
+		"Invalid length": {
+			Skew: Skew{
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameSkew,
+				Field:     "length",
+				Value:     1,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			Skew: Skew{
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Skew.validate())
+			if c.Error == nil {
+				assert.True(t, c.Skew.valid)
+			}
+		})
+	}
+}
+
+func Test_Skew_Calc(t *testing.T) {
+	cc := map[string]struct {
+		Skew   Skew
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Skew: Skew{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			Skew: Skew{
+				valid:  true,
+				length: 3,
+			},
+			Data:   series(2, 2, 2),
+			Result: decimal.Zero,
+		},
+		"Successful calculation": {
+			Skew: Skew{
+				valid:  true,
+				length: 4,
+			},
+			Data:   series(1, 2, 3, 10),
+			Result: decimal.RequireFromString("1.01823376"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Skew.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_Skew_Count(t *testing.T) {
+	assert.Equal(t, 5, Skew{
+		length: 5,
+	}.Count())
+}
+
+func Test_Skew_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameSkew,
+		Input: InputClose,
+	}, Skew{}.Describe())
+}
+
+func Test_NewSMA(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result SMA
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new SMA": {
+			Length: 1,
+			Result: SMA{
+				valid:  true,
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewSMA(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_SMA_validate(t *testing.T) {
+	cc := map[string]struct {
+		SMA   SMA
+		Error error
+	}{
+		"Invalid length": {
+			SMA: SMA{
+				length: 0,
+			},
+			Error: &ConfigError{
+				Indicator: NameSMA,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			SMA: SMA{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.SMA.validate())
+			if c.Error == nil {
+				assert.True(t, c.SMA.valid)
+			}
+		})
+	}
+}
+
+func Test_SMA_Calc(t *testing.T) {
+	cc := map[string]struct {
+		SMA    SMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			SMA:   SMA{},
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			SMA: SMA{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			SMA: SMA{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+				decimal.NewFromInt(31),
+				decimal.NewFromInt(32),
+			},
+			Result: decimal.NewFromInt(31),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.SMA.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_SMA_Count(t *testing.T) {
+	assert.Equal(t, 15, SMA{
+		length: 15,
+	}.Count())
+}
+
+func Test_SMA_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameSMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.NewFromInt(2),
+	}, SMA{
+		length: 5,
+	}.Describe())
+}
+
+// testSMI returns a small valid SMI used in calculation tests.
+func testSMI() SMI {
+	return SMI{
+		valid:  true,
+		length: 2,
+		ema1:   EMA{valid: true, sma: SMA{valid: true, length: 1}},
+		ema2:   EMA{valid: true, sma: SMA{valid: true, length: 2}},
+		signal: EMA{valid: true, sma: SMA{valid: true, length: 2}},
+	}
+}
+
+func Test_NewSMI(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		First  int
+		Second int
+		Signal int
+		Result SMI
+		Error  error
+	}{
+		"Invalid first smoothing": {
+			Length: 2,
+			Second: 2,
+			Signal: 2,
+			Error:  assert.AnError,
+		},
+		"Invalid second smoothing": {
+			Length: 2,
+			First:  1,
+			Signal: 2,
+			Error:  assert.AnError,
+		},
+		"Invalid signal": {
+			Length: 2,
+			First:  1,
+			Second: 2,
+			Error:  assert.AnError,
+		},
+		"Validate returns an error": {
+			First:  1,
+			Second: 2,
+			Signal: 2,
+			Error:  assert.AnError,
+		},
+		"Successfully created new SMI": {
+			Length: 2,
+			First:  1,
+			Second: 2,
+			Signal: 2,
+			Result: testSMI(),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewSMI(c.Length, c.First, c.Second, c.Signal)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_SMI_validate(t *testing.T) {
+	cc := map[string]struct {
+		SMI   SMI
+		Error error
+	}{
+		"Invalid length": {
+			Error: &ConfigError{
+				Indicator: NameSMI,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			SMI: SMI{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.SMI.validate())
+			if c.Error == nil {
+				assert.True(t, c.SMI.valid)
+			}
+		})
+	}
+}
+
+func Test_SMI_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		SMI     SMI
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			SMI:     testSMI(),
+			Candles: testCandles(t)[:4],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			SMI:     testSMI(),
+			Candles: testCandles(t),
+			Result:  decimal.RequireFromString("43.58974359"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.SMI.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_SMI_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		SMI     SMI
+		Candles []Candle
+		Result  SMILines
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			SMI:     testSMI(),
+			Candles: testCandles(t)[:4],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			SMI:     testSMI(),
+			Candles: make([]Candle, 5),
+			Result: SMILines{
+				SMI:    decimal.Zero,
+				Signal: decimal.Zero,
+			},
+		},
+		"Successful calculation": {
+			SMI:     testSMI(),
+			Candles: testCandles(t),
+			Result: SMILines{
+				SMI:    decimal.RequireFromString("43.58974359"),
+				Signal: decimal.RequireFromString("32.76353276"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.SMI.CalcLines(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.SMI.Round(8).String(), res.SMI.Round(8).String())
+			assert.Equal(t, c.Result.Signal.Round(8).String(), res.Signal.Round(8).String())
+		})
+	}
+}
+
+func Test_SMI_smooth(t *testing.T) {
+	_, err := SMI{}.smooth(series(1, 2, 3))
+	assert.Equal(t, ErrInvalidIndicator, err)
+
+	smi := testSMI()
+	smi.ema2 = EMA{valid: true, sma: SMA{valid: true, length: 3}}
+
+	res, err := smi.smooth(series(1, 2, 3, 4))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2", "3"}, decimalStrings(res))
+}
+
+func Test_SMI_Count(t *testing.T) {
+	assert.Equal(t, 5, testSMI().Count())
+}
+
+func Test_SMI_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameSMI,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.NewFromInt(-100),
+		Max:     decimal.NewFromInt(100),
+	}, SMI{}.Describe())
+}
+
+func Test_NewSMMA(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result SMMA
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new SMMA": {
+			Length: 2,
+			Result: SMMA{
+				valid:  true,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewSMMA(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_SMMA_validate(t *testing.T) {
+	cc := map[string]struct {
+		SMMA  SMMA
+		Error error
+	}{
+		"Invalid length": {
+			Error: &ConfigError{
+				Indicator: NameSMMA,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			SMMA: SMMA{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.SMMA.validate())
+			if c.Error == nil {
+				assert.True(t, c.SMMA.valid)
+			}
+		})
+	}
+}
+
+func Test_SMMA_Calc(t *testing.T) {
+	cc := map[string]struct {
+		SMMA   SMMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			SMMA:  SMMA{valid: true, length: 3},
+			Data:  series(1, 2, 3),
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			SMMA:   SMMA{valid: true, length: 3},
+			Data:   series(1, 2, 3, 5, 8),
+			Result: decimal.RequireFromString("4.66666667"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.SMMA.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_SMMA_CalcNext(t *testing.T) {
+	cc := map[string]struct {
+		SMMA   SMMA
+		Last   decimal.Decimal
+		Next   decimal.Decimal
+		Result string
+		Error  error
+	}{
+		"Invalid indicator": {
+			SMMA:  SMMA{},
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			SMMA:   SMMA{valid: true, length: 3},
+			Last:   decimal.NewFromInt(4),
+			Next:   decimal.NewFromInt(7),
+			Result: "5",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.SMMA.CalcNext(c.Last, c.Next)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, res.String())
+		})
+	}
+}
+
+func Test_SMMA_Count(t *testing.T) {
+	assert.Equal(t, 5, SMMA{length: 3}.Count())
+}
+
+func Test_SMMA_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameSMMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.NewFromInt(2),
+	}, SMMA{length: 3}.Describe())
+}
+
+func Test_NewSortino(t *testing.T) {
+	cc := map[string]struct {
+		Length  int
+		Periods int
+		Result  Sortino
+		Error   error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Sortino": {
+			Length:  2,
+			Periods: 252,
+			Result: Sortino{
+				valid:   true,
+				length:  2,
+				periods: 252,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewSortino(c.Length, c.Periods)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Sortino_validate(t *testing.T) {
+	cc := map[string]struct {
+		Sortino Sortino
+		Error   error
+	}{
+		"Invalid length": {
+			Sortino: Sortino{
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameSortino,
+				Field:     "length",
+				Value:     1,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid periods": {
+			Sortino: Sortino{
+				length:  2,
+				periods: -1,
+			},
+			Error: &ConfigError{
+				Indicator: NameSortino,
+				Field:     "periods",
+				Value:     -1,
+				Err:       errors.New("invalid periods"),
+			},
+		},
+		"Successfully validated": {
+			Sortino: Sortino{
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Sortino.validate())
+			if c.Error == nil {
+				assert.True(t, c.Sortino.valid)
+			}
+		})
+	}
+}
+
+func Test_Sortino_Calc(t *testing.T) {
+	cc := map[string]struct {
+		Sortino Sortino
+		Data    []decimal.Decimal
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Sortino: Sortino{valid: true, length: 3},
+			Data:    series(100, 105, 100),
+			Error:   ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			Sortino: Sortino{valid: true, length: 3},
+			Data:    series(100, 105, 110, 120),
+			Result:  decimal.Zero,
+		},
+		"Successful calculation": {
+			Sortino: Sortino{valid: true, length: 3},
+			Data:    series(100, 105, 100, 110),
+			Result:  decimal.RequireFromString("1.24130308"),
+		},
+		"Successful annualized calculation": {
+			Sortino: Sortino{valid: true, length: 3, periods: 252},
+			Data:    series(100, 105, 100, 110),
+			Result:  decimal.RequireFromString("19.70507549"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Sortino.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_Sortino_Count(t *testing.T) {
+	assert.Equal(t, 6, Sortino{
+		length: 5,
+	}.Count())
+}
+
+func Test_Sortino_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameSortino,
+		Input: InputClose,
+	}, Sortino{}.Describe())
+}
+
+func Test_NewSource(t *testing.T) {
+	_, err := NewSource(0, nil)
+	assert.Equal(t, &ConfigError{
+		Indicator: NameSource,
+		Field:     "price",
+		Value:     Price(0),
+		Err:       ErrInvalidPrice,
+	}, err)
+
+	src, err := NewSource(PriceOpen, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, Source{valid: true, price: PriceOpen}, src)
+
+	src, err = NewSource(PriceHL2, SMA{valid: true, length: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, Source{valid: true, price: PriceHL2, ind: SMA{valid: true, length: 2}}, src)
+}
+
+func Test_Source_validate(t *testing.T) {
+	src := Source{}
+	assert.Equal(t, &ConfigError{
+		Indicator: NameSource,
+		Field:     "price",
+		Value:     Price(0),
+		Err:       ErrInvalidPrice,
+	}, src.validate())
+	assert.False(t, src.valid)
+
+	src = Source{price: PriceOHLC4}
+	assert.NoError(t, src.validate())
+	assert.True(t, src.valid)
+}
+
+func Test_Source_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		Source  Source
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Source:  Source{valid: true, price: PriceOpen},
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation without indicator": {
+			Source:  Source{valid: true, price: PriceOpen},
+			Candles: testCandles(t)[4:],
+			Result:  decimal.NewFromInt(10),
+		},
+		"Successful calculation with indicator": {
+			Source:  Source{valid: true, price: PriceOHLC4, ind: SMA{valid: true, length: 2}},
+			Candles: testCandles(t)[3:],
+			Result:  decimal.RequireFromString("11.5"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Source.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_Source_Count(t *testing.T) {
+	assert.Equal(t, 1, Source{}.Count())
+	assert.Equal(t, 3, Source{ind: SMA{length: 3}}.Count())
+}
+
+func Test_Source_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameSource,
+		Input:   InputCandle,
+		Overlay: true,
+	}, Source{}.Describe())
+}
+
+func Test_NewSRSI(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result SRSI
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new SRSI": {
+			Length: 1,
+			Result: SRSI{
+				valid: true,
+				rsi: RSI{
+					length:    1,
+					valid:     true,
+					smoothing: SmoothingSMA,
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewSRSI(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_SRSI_Calc(t *testing.T) {
+	cc := map[string]struct {
+		SRSI   SRSI
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			SRSI:  SRSI{},
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			SRSI: SRSI{
+				valid: true,
+				rsi: RSI{
+					length:    5,
+					valid:     true,
+					smoothing: SmoothingSMA,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: assert.AnError,
+		},
+		"Successfully handled division by 0": {
+			SRSI: SRSI{
+				valid: true,
+				rsi: RSI{
+					length:    3,
+					valid:     true,
+					smoothing: SmoothingSMA,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(8),
+				decimal.NewFromInt(12),
+				decimal.NewFromInt(8),
+				decimal.NewFromInt(12),
+				decimal.NewFromInt(8),
+			},
+			Result: decimal.Zero,
+		},
+		"Successful calculation": {
+			SRSI: SRSI{
+				valid: true,
+				rsi: RSI{
+					length:    3,
+					valid:     true,
+					smoothing: SmoothingSMA,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(11),
+				decimal.NewFromInt(12),
+				decimal.NewFromInt(11),
+				decimal.NewFromInt(11),
+				decimal.NewFromInt(11),
+			},
+			Result: decimal.RequireFromString("1"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.SRSI.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_SRSI_Count(t *testing.T) {
+	assert.Equal(t, 29, SRSI{
+		valid: false,
+		rsi: RSI{
+			length:    15,
+			smoothing: SmoothingSMA,
+		},
+	}.Count())
+}
+
+func Test_SRSI_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameSRSI,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _one,
+	}, SRSI{}.Describe())
+}
+
+func Test_NewStoch(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result Stoch
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new Stoch": {
+			Length: 1,
+			Result: Stoch{
+				valid:  true,
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewStoch(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Stoch_validate(t *testing.T) {
+	cc := map[string]struct {
+		Stoch Stoch
+		Error error
+	}{
+		"Invalid length": {
+			Stoch: Stoch{
+				length: 0,
+			},
+			Error: &ConfigError{
+				Indicator: NameStoch,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			Stoch: Stoch{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Stoch.validate())
+			if c.Error == nil {
+				assert.True(t, c.Stoch.valid)
+			}
+		})
+	}
+}
+
+func Test_Stoch_Calc(t *testing.T) {
+	cc := map[string]struct {
+		Stoch  Stoch
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Stoch: Stoch{},
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Stoch: Stoch{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation when new lows are reached": {
+			Stoch: Stoch{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(150),
+				decimal.NewFromInt(125),
+				decimal.NewFromInt(145),
+			},
+			Result: decimal.NewFromInt(80),
+		},
+		"Successfully handled division by 0": {
+			Stoch: Stoch{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(150),
+				decimal.NewFromInt(150),
+				decimal.NewFromInt(150),
+			},
+			Result: decimal.Zero,
+		},
+		"Successful calculation when new highs are reached": {
+			Stoch: Stoch{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(120),
+				decimal.NewFromInt(145),
+				decimal.NewFromInt(135),
+			},
+			Result: decimal.NewFromInt(60),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Stoch.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_Stoch_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		Stoch   Stoch
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Stoch: Stoch{
+				valid:  true,
+				length: 5,
+			},
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			Stoch: Stoch{
+				valid:  true,
+				length: 5,
+			},
+			Candles: make([]Candle, 5),
+			Result:  decimal.Zero,
+		},
+		"Successful calculation": {
+			Stoch: Stoch{
+				valid:  true,
+				length: 5,
+			},
+			Candles: testCandles(t),
+			Result:  decimal.RequireFromString("85.71428571"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Stoch.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_Stoch_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameStoch,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}, Stoch{}.Describe())
+}
+
+func Test_NewStochFull(t *testing.T) {
+	cc := map[string]struct {
+		Length    int
+		Smoothing Indicator
+		Signal    Indicator
+		Result    StochFull
+		Error     error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new StochFull with default indicators": {
+			Length: 5,
+			Result: StochFull{
+				valid:     true,
+				stoch:     Stoch{valid: true, length: 5},
+				smoothing: SMA{valid: true, length: 3},
+				signal:    SMA{valid: true, length: 3},
+			},
+		},
+		"Successfully created new StochFull": {
+			Length:    5,
+			Smoothing: SMA{valid: true, length: 1},
+			Signal:    EMA{valid: true, sma: SMA{valid: true, length: 2}},
+			Result: StochFull{
+				valid:     true,
+				stoch:     Stoch{valid: true, length: 5},
+				smoothing: SMA{valid: true, length: 1},
+				signal:    EMA{valid: true, sma: SMA{valid: true, length: 2}},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewStochFull(c.Length, c.Smoothing, c.Signal)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_StochFull_validate(t *testing.T) {
+	cc := map[string]struct {
+		StochFull StochFull
+		Error     error
+	}{
+		"Invalid smoothing": {
+			StochFull: StochFull{
+				stoch:  Stoch{length: 1},
+				signal: SMA{},
+			},
+			Error: &ConfigError{
+				Indicator: NameStochFull,
+				Field:     "smoothing",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Invalid signal": {
+			StochFull: StochFull{
+				stoch:     Stoch{length: 1},
+				smoothing: SMA{},
+			},
+			Error: &ConfigError{
+				Indicator: NameStochFull,
+				Field:     "signal",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Invalid length": {
+			StochFull: StochFull{
+				smoothing: SMA{},
+				signal:    SMA{},
+			},
+			Error: &ConfigError{
+				Indicator: NameStochFull,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			StochFull: StochFull{
+				stoch:     Stoch{length: 1},
+				smoothing: SMA{},
+				signal:    SMA{},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.StochFull.validate())
+			if c.Error == nil {
+				assert.True(t, c.StochFull.valid)
+				assert.True(t, c.StochFull.stoch.valid)
+			}
+		})
+	}
+}
+
+func Test_StochFull_Calc(t *testing.T) {
+	cc := map[string]struct {
+		StochFull StochFull
+		Data      []decimal.Decimal
+		Result    decimal.Decimal
+		Error     error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			StochFull: StochFull{
+				valid:     true,
+				stoch:     Stoch{valid: true, length: 2},
+				smoothing: SMA{valid: true, length: 2},
+				signal:    SMA{valid: true, length: 2},
+			},
+			Data:  series(1),
+			Error: ErrInvalidDataSize,
+		},
+		"Smoothing indicator returns an error": {
+			StochFull: StochFull{
+				valid:     true,
+				stoch:     Stoch{valid: true, length: 2},
+				smoothing: SMA{length: 2},
+				signal:    SMA{valid: true, length: 2},
+			},
+			Data:  series(10, 11, 10, 14),
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			StochFull: StochFull{
+				valid:     true,
+				stoch:     Stoch{valid: true, length: 2},
+				smoothing: SMA{valid: true, length: 2},
+				signal:    SMA{valid: true, length: 2},
+			},
+			Data:   series(10, 11, 10, 14),
+			Result: decimal.NewFromInt(50),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.StochFull.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_StochFull_CalcCandles(t *testing.T) {
+	_, err := StochFull{}.CalcCandles(nil)
+	assert.Equal(t, ErrInvalidIndicator, err)
+
+	res, err := StochFull{
+		valid:     true,
+		stoch:     Stoch{valid: true, length: 2},
+		smoothing: SMA{valid: true, length: 2},
+		signal:    SMA{valid: true, length: 2},
+	}.CalcCandles(testCandles(t)[1:])
+	assert.NoError(t, err)
+	assert.Equal(t, "56.66666667", res.Round(8).String())
+}
+
+func Test_StochFull_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		StochFull StochFull
+		Candles   []Candle
+		Result    StochFullLines
+		Error     error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			StochFull: StochFull{
+				valid:     true,
+				stoch:     Stoch{valid: true, length: 2},
+				smoothing: SMA{valid: true, length: 2},
+				signal:    SMA{valid: true, length: 2},
+			},
+			Candles: testCandles(t),
+			Error:   ErrInvalidDataSize,
+		},
+		"Signal indicator returns an error": {
+			StochFull: StochFull{
+				valid:     true,
+				stoch:     Stoch{valid: true, length: 2},
+				smoothing: SMA{valid: true, length: 2},
+				signal:    SMA{length: 2},
+			},
+			Candles: testCandles(t)[1:],
+			Error:   ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			StochFull: StochFull{
+				valid:     true,
+				stoch:     Stoch{valid: true, length: 2},
+				smoothing: SMA{valid: true, length: 2},
+				signal:    SMA{valid: true, length: 2},
+			},
+			Candles: testCandles(t)[1:],
+			Result: StochFullLines{
+				K: decimal.RequireFromString("56.66666667"),
+				D: decimal.RequireFromString("53.33333333"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.StochFull.CalcLines(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.K.String(), res.K.Round(8).String())
+			assert.Equal(t, c.Result.D.String(), res.D.Round(8).String())
+		})
+	}
+}
+
+func Test_StochFull_Count(t *testing.T) {
+	assert.Equal(t, 8, StochFull{
+		stoch:     Stoch{length: 4},
+		smoothing: SMA{length: 3},
+		signal:    SMA{length: 3},
+	}.Count())
+}
+
+func Test_StochFull_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameStochFull,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     _hundred,
+	}, StochFull{}.Describe())
+}
+
+func Test_NewStochOf(t *testing.T) {
+	cc := map[string]struct {
+		Source    Indicator
+		Length    int
+		Smoothing int
+		Result    StochOf
+		Error     error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new StochOf": {
+			Source:    SMA{valid: true, length: 2},
+			Length:    3,
+			Smoothing: 2,
+			Result: StochOf{
+				valid:     true,
+				source:    SMA{valid: true, length: 2},
+				length:    3,
+				smoothing: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewStochOf(c.Source, c.Length, c.Smoothing)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_StochOf_validate(t *testing.T) {
+	cc := map[string]struct {
+		StochOf StochOf
+		Error   error
+	}{
+		"Invalid source": {
+			StochOf: StochOf{
+				length:    1,
+				smoothing: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameStochOf,
+				Field:     "source",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Invalid length": {
+			StochOf: StochOf{
+				source:    SMA{},
+				smoothing: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameStochOf,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid smoothing": {
+			StochOf: StochOf{
+				source: SMA{},
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameStochOf,
+				Field:     "smoothing",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			StochOf: StochOf{
+				source:    SMA{},
+				length:    1,
+				smoothing: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.StochOf.validate())
+			if c.Error == nil {
+				assert.True(t, c.StochOf.valid)
+			}
+		})
+	}
+}
+
+func Test_StochOf_Calc(t *testing.T) {
+	cc := map[string]struct {
+		StochOf StochOf
+		Data    []decimal.Decimal
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			StochOf: StochOf{
+				valid:     true,
+				source:    SMA{valid: true, length: 2},
+				length:    3,
+				smoothing: 2,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Source indicator returns an error": {
+			StochOf: StochOf{
+				valid:     true,
+				source:    SMA{length: 2},
+				length:    3,
+				smoothing: 2,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(4),
+			},
+			Error: ErrInvalidIndicator,
+		},
+		"Successfully handled division by 0": {
+			StochOf: StochOf{
+				valid:     true,
+				source:    SMA{valid: true, length: 2},
+				length:    3,
+				smoothing: 2,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(1),
+			},
+			Result: decimal.Zero,
+		},
+		"Successful calculation without smoothing": {
+			StochOf: StochOf{
+				valid:     true,
+				source:    SMA{valid: true, length: 2},
+				length:    3,
+				smoothing: 1,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(2),
+			},
+			Result: decimal.NewFromInt(75),
+		},
+		"Successful calculation": {
+			StochOf: StochOf{
+				valid:     true,
+				source:    SMA{valid: true, length: 2},
+				length:    3,
+				smoothing: 2,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(4),
+			},
+			Result: decimal.RequireFromString("37.5"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.StochOf.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_StochOf_Count(t *testing.T) {
+	assert.Equal(t, 7, StochOf{
+		source:    SMA{length: 3},
+		length:    4,
+		smoothing: 2,
+	}.Count())
+}
+
+func Test_StochOf_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameStochOf,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     decimal.NewFromInt(100),
+	}, StochOf{source: SMA{}}.Describe())
+}
+
+func Test_NewSuperSmoother(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result SuperSmoother
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new SuperSmoother": {
+			Length: 2,
+			Result: SuperSmoother{
+				valid:  true,
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewSuperSmoother(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_SuperSmoother_validate(t *testing.T) {
+	cc := map[string]struct {
+		SuperSmoother SuperSmoother
+		Error         error
+	}{
+		"Invalid length": {
+			SuperSmoother: SuperSmoother{
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameSuperSmoother,
+				Field:     "length",
+				Value:     1,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			SuperSmoother: SuperSmoother{
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.SuperSmoother.validate())
+			if c.Error == nil {
+				assert.True(t, c.SuperSmoother.valid)
+			}
+		})
+	}
+}
+
+func Test_SuperSmoother_Calc(t *testing.T) {
+	cc := map[string]struct {
+		SuperSmoother SuperSmoother
+		Data          []decimal.Decimal
+		Result        decimal.Decimal
+		Error         error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			SuperSmoother: SuperSmoother{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			SuperSmoother: SuperSmoother{
+				valid:  true,
+				length: 2,
+			},
+			Data:   series(1, 2, 4),
+			Result: decimal.RequireFromString("3.15490349"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.SuperSmoother.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_SuperSmoother_Count(t *testing.T) {
+	assert.Equal(t, 9, SuperSmoother{
+		length: 5,
+	}.Count())
+}
+
+func Test_SuperSmoother_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameSuperSmoother,
+		Input:   InputClose,
+		Overlay: true,
+	}, SuperSmoother{}.Describe())
+}
+
+func Test_NewT3(t *testing.T) {
+	cc := map[string]struct {
+		Length  int
+		VFactor decimal.Decimal
+		Result  T3
+		Error   error
+	}{
+		"NewEMA returns an error": {
+			Error: assert.AnError,
+		},
+		"Validate returns an error": {
+			Length:  1,
+			VFactor: decimal.NewFromInt(2),
+			Error: &ConfigError{
+				Indicator: NameT3,
+				Field:     "vfactor",
+				Value:     decimal.NewFromInt(2),
+				Err:       ErrInvalidFactor,
+			},
+		},
+		"Successfully created new T3 with default volume factor": {
+			Length: 1,
+			Result: T3{
+				valid: true,
+				ema: EMA{
+					sma: SMA{
+						length: 1,
+						valid:  true,
+					},
+					valid: true,
+				},
+				vfactor: decimal.RequireFromString("0.7"),
+			},
+		},
+		"Successfully created new T3": {
+			Length:  1,
+			VFactor: decimal.RequireFromString("0.5"),
+			Result: T3{
+				valid: true,
+				ema: EMA{
+					sma: SMA{
+						length: 1,
+						valid:  true,
+					},
+					valid: true,
+				},
+				vfactor: decimal.RequireFromString("0.5"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewT3(c.Length, c.VFactor)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_T3_validate(t *testing.T) {
+	cc := map[string]struct {
+		T3    T3
+		Error error
+	}{
+		"Volume factor is too low": {
+			T3: T3{
+				vfactor: decimal.NewFromInt(-1),
+			},
+			Error: &ConfigError{
+				Indicator: NameT3,
+				Field:     "vfactor",
+				Value:     decimal.NewFromInt(-1),
+				Err:       ErrInvalidFactor,
+			},
+		},
+		"Volume factor is too high": {
+			T3: T3{
+				vfactor: decimal.RequireFromString("1.1"),
+			},
+			Error: &ConfigError{
+				Indicator: NameT3,
+				Field:     "vfactor",
+				Value:     decimal.RequireFromString("1.1"),
+				Err:       ErrInvalidFactor,
+			},
+		},
+		"Successfully validated": {
+			T3: T3{
+				vfactor: decimal.RequireFromString("0.7"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.T3.validate())
+			if c.Error == nil {
+				assert.True(t, c.T3.valid)
+			}
+		})
+	}
+}
+
+func Test_T3_Calc(t *testing.T) {
+	cc := map[string]struct {
+		T3     T3
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			T3: T3{
+				valid: true,
+				ema: EMA{
+					sma: SMA{
+						length: 2,
+						valid:  true,
+					},
+					valid: true,
+				},
+				vfactor: decimal.RequireFromString("0.7"),
+			},
+			Data:  series(1, 2, 3),
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			T3: T3{
+				valid: true,
+				ema: EMA{
+					sma: SMA{
+						length: 2,
+						valid:  true,
+					},
+					valid: true,
+				},
+				vfactor: decimal.RequireFromString("0.7"),
+			},
+			Data:   series(1, 2, 3, 4, 5, 6, 7, 8),
+			Result: decimal.RequireFromString("7.55"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.T3.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_T3_Count(t *testing.T) {
+	assert.Equal(t, 29, T3{
+		ema: EMA{
+			sma: SMA{
+				length: 5,
+			},
+		},
+	}.Count())
+}
+
+func Test_T3_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameT3,
+		Input:   InputClose,
+		Overlay: true,
+	}, T3{}.Describe())
+}
+
+// testTDCandles returns candles which close prices follow the provided
+// values, with high and low prices one unit above and below them.
+func testTDCandles(vv ...int64) []Candle {
+	cc := make([]Candle, len(vv))
+
+	for i, v := range vv {
+		cc[i] = Candle{
+			High:  decimal.NewFromInt(v + 1),
+			Low:   decimal.NewFromInt(v - 1),
+			Close: decimal.NewFromInt(v),
+		}
+	}
+
+	return cc
+}
+
+func Test_NewTDSequential(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result TDSequential
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new TDSequential": {
+			Length: 5,
+			Result: TDSequential{
+				valid:  true,
+				length: 5,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewTDSequential(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_TDSequential_validate(t *testing.T) {
+	cc := map[string]struct {
+		TDSequential TDSequential
+		Error        error
+	}{
+		"Invalid length": {
+			TDSequential: TDSequential{
+				length: 4,
+			},
+			Error: &ConfigError{
+				Indicator: NameTDSequential,
+				Field:     "length",
+				Value:     4,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			TDSequential: TDSequential{
+				length: 5,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.TDSequential.validate())
+			if c.Error == nil {
+				assert.True(t, c.TDSequential.valid)
+			}
+		})
+	}
+}
+
+func Test_TDSequential_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		TDSequential TDSequential
+		Candles      []Candle
+		Result       decimal.Decimal
+		Error        error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			TDSequential: TDSequential{
+				valid:  true,
+				length: 10,
+			},
+			Candles: testTDCandles(10, 9, 8, 7, 6, 5, 4, 3, 2, 1),
+			Result:  decimal.NewFromInt(-6),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.TDSequential.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_TDSequential_CalcLines(t *testing.T) {
+	unperfected := testTDCandles(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13)
+	unperfected[9].High = decimal.NewFromInt(100)
+	unperfected[10].High = decimal.NewFromInt(100)
+
+	cc := map[string]struct {
+		TDSequential TDSequential
+		Candles      []Candle
+		Result       TDSequentialLines
+		Error        error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			TDSequential: TDSequential{
+				valid:  true,
+				length: 10,
+			},
+			Candles: testCandles(t),
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation without price changes": {
+			TDSequential: TDSequential{
+				valid:  true,
+				length: 5,
+			},
+			Candles: testTDCandles(1, 1, 1, 1, 1),
+		},
+		"Successful calculation of incomplete setup": {
+			TDSequential: TDSequential{
+				valid:  true,
+				length: 10,
+			},
+			Candles: testTDCandles(1, 2, 3, 4, 5, 6, 7, 8, 9, 10),
+			Result: TDSequentialLines{
+				Setup: 6,
+			},
+		},
+		"Successful calculation of perfected sell setup": {
+			TDSequential: TDSequential{
+				valid:  true,
+				length: 13,
+			},
+			Candles: testTDCandles(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13),
+			Result: TDSequentialLines{
+				Setup:     9,
+				Countdown: 1,
+				Perfected: true,
+			},
+		},
+		"Successful calculation of unperfected sell setup": {
+			TDSequential: TDSequential{
+				valid:  true,
+				length: 13,
+			},
+			Candles: unperfected,
+			Result: TDSequentialLines{
+				Setup: 9,
+			},
+		},
+		"Successful calculation of buy countdown": {
+			TDSequential: TDSequential{
+				valid:  true,
+				length: 15,
+			},
+			Candles: testTDCandles(15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1),
+			Result: TDSequentialLines{
+				Setup:     -2,
+				Countdown: -3,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.TDSequential.CalcLines(c.Candles)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_TDSequential_Count(t *testing.T) {
+	assert.Equal(t, 5, TDSequential{
+		length: 5,
+	}.Count())
+}
+
+func Test_TDSequential_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameTDSequential,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.NewFromInt(-9),
+		Max:     decimal.NewFromInt(9),
+	}, TDSequential{}.Describe())
+}
+
+func Test_NewTEMA(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result TEMA
+		Error  error
+	}{
+		"NewEMA returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new TEMA": {
+			Length: 1,
+			Result: TEMA{
+				valid: true,
+				ema: EMA{
+					sma: SMA{
+						length: 1,
+						valid:  true,
+					},
+					valid: true,
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewTEMA(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_TEMA_Calc(t *testing.T) {
+	cc := map[string]struct {
+		TEMA   TEMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			TEMA: TEMA{
+				valid: true,
+				ema: EMA{
+					sma: SMA{
+						length: 2,
+						valid:  true,
+					},
+					valid: true,
+				},
+			},
+			Data:  series(1, 2, 3),
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			TEMA: TEMA{
+				valid: true,
+				ema: EMA{
+					sma: SMA{
+						length: 2,
+						valid:  true,
+					},
+					valid: true,
+				},
+			},
+			Data:   series(1, 2, 3, 4, 5),
+			Result: decimal.NewFromInt(5),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.TEMA.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_TEMA_Count(t *testing.T) {
+	assert.Equal(t, 17, TEMA{
+		ema: EMA{
+			sma: SMA{
+				length: 5,
+			},
+		},
+	}.Count())
+}
+
+func Test_TEMA_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameTEMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.Zero,
+	}, TEMA{}.Describe())
+}
+
+// testTSI returns a small valid TSI used in calculation tests.
+func testTSI() TSI {
+	return TSI{
+		valid:  true,
+		long:   EMA{valid: true, sma: SMA{valid: true, length: 2}},
+		short:  EMA{valid: true, sma: SMA{valid: true, length: 2}},
+		signal: EMA{valid: true, sma: SMA{valid: true, length: 2}},
+	}
+}
+
+func Test_NewTSI(t *testing.T) {
+	cc := map[string]struct {
+		Long   int
+		Short  int
+		Signal int
+		Result TSI
+		Error  error
+	}{
+		"Invalid long smoothing": {
+			Short:  2,
+			Signal: 2,
+			Error:  assert.AnError,
+		},
+		"Invalid short smoothing": {
+			Long:   2,
+			Signal: 2,
+			Error:  assert.AnError,
+		},
+		"Invalid signal": {
+			Long:   2,
+			Short:  2,
+			Signal: -1,
+			Error:  assert.AnError,
+		},
+		"Successfully created new TSI without signal": {
+			Long:  2,
+			Short: 2,
+			Result: TSI{
+				valid: true,
+				long:  EMA{valid: true, sma: SMA{valid: true, length: 2}},
+				short: EMA{valid: true, sma: SMA{valid: true, length: 2}},
+			},
+		},
+		"Successfully created new TSI": {
+			Long:   2,
+			Short:  2,
+			Signal: 2,
+			Result: testTSI(),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewTSI(c.Long, c.Short, c.Signal)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_TSI_validate(t *testing.T) {
+	cc := map[string]struct {
+		TSI   TSI
+		Error error
+	}{
+		"Invalid smoothing": {
+			Error: &ConfigError{
+				Indicator: NameTSI,
+				Field:     "long",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Invalid signal": {
+			TSI: TSI{
+				long:   EMA{valid: true, sma: SMA{valid: true, length: 2}},
+				short:  EMA{valid: true, sma: SMA{valid: true, length: 2}},
+				signal: EMA{sma: SMA{length: 2}},
+			},
+			Error: &ConfigError{
+				Indicator: NameTSI,
+				Field:     "signal",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Successfully validated without signal": {
+			TSI: TSI{
+				long:  EMA{valid: true, sma: SMA{valid: true, length: 2}},
+				short: EMA{valid: true, sma: SMA{valid: true, length: 2}},
+			},
+		},
+		"Successfully validated": {
+			TSI: testTSI(),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.TSI.validate())
+			if c.Error == nil {
+				assert.True(t, c.TSI.valid)
+			}
+		})
+	}
+}
+
+func Test_TSI_Calc(t *testing.T) {
+	cc := map[string]struct {
+		TSI    TSI
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			TSI:   testTSI(),
+			Data:  series(1, 2, 3),
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			TSI:    testTSI(),
+			Data:   series(1, 3, 2, 5, 4, 8),
+			Result: decimal.RequireFromString("73.14814815"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.TSI.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_TSI_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		TSI    TSI
+		Data   []decimal.Decimal
+		Result TSILines
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			TSI:   testTSI(),
+			Data:  series(1, 2, 3),
+			Error: ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			TSI:  testTSI(),
+			Data: series(1, 1, 1, 1, 1, 1),
+			Result: TSILines{
+				TSI:    decimal.Zero,
+				Signal: decimal.Zero,
+			},
+		},
+		"Successful calculation without signal": {
+			TSI: TSI{
+				valid: true,
+				long:  EMA{valid: true, sma: SMA{valid: true, length: 2}},
+				short: EMA{valid: true, sma: SMA{valid: true, length: 2}},
+			},
+			Data: series(2, 5, 4, 8),
+			Result: TSILines{
+				TSI:    decimal.NewFromInt(75),
+				Signal: decimal.Zero,
+			},
+		},
+		"Successful calculation": {
+			TSI:  testTSI(),
+			Data: series(1, 3, 2, 5, 4, 8),
+			Result: TSILines{
+				TSI:    decimal.RequireFromString("73.14814815"),
+				Signal: decimal.RequireFromString("64.69135802"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.TSI.CalcLines(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.TSI.Round(8).String(), res.TSI.Round(8).String())
+			assert.Equal(t, c.Result.Signal.Round(8).String(), res.Signal.Round(8).String())
+		})
+	}
+}
+
+func Test_TSI_smooth(t *testing.T) {
+	_, err := TSI{}.smooth(series(1, 2, 3))
+	assert.Equal(t, ErrInvalidIndicator, err)
+
+	tsi := testTSI()
+	tsi.long = EMA{valid: true, sma: SMA{valid: true, length: 1}}
+	tsi.short = EMA{valid: true, sma: SMA{valid: true, length: 3}}
+
+	res, err := tsi.smooth(series(1, 2, 3, 4))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2", "3"}, decimalStrings(res))
+}
+
+func Test_TSI_Count(t *testing.T) {
+	assert.Equal(t, 6, testTSI().Count())
+	assert.Equal(t, 4, TSI{
+		long:  EMA{sma: SMA{length: 2}},
+		short: EMA{sma: SMA{length: 2}},
+	}.Count())
+}
+
+func Test_TSI_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameTSI,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.NewFromInt(-100),
+		Max:     decimal.NewFromInt(100),
+	}, TSI{}.Describe())
+}
+
+func Test_NewTTMSqueeze(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		BB     decimal.Decimal
+		KC     decimal.Decimal
+		Result TTMSqueeze
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new TTMSqueeze with default multipliers": {
+			Length: 2,
+			Result: TTMSqueeze{
+				valid:  true,
+				length: 2,
+				bb:     decimal.NewFromInt(2),
+				kc:     decimal.RequireFromString("1.5"),
+			},
+		},
+		"Successfully created new TTMSqueeze": {
+			Length: 2,
+			BB:     decimal.NewFromInt(3),
+			KC:     decimal.NewFromInt(1),
+			Result: TTMSqueeze{
+				valid:  true,
+				length: 2,
+				bb:     decimal.NewFromInt(3),
+				kc:     decimal.NewFromInt(1),
 			},
-			Result: decimal.RequireFromString("4.91959301"),
 		},
 	}
 
@@ -407,66 +14763,56 @@ func Test_BB_Calc(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := c.BB.Calc(c.Data)
+			res, err := NewTTMSqueeze(c.Length, c.BB, c.KC)
 			assertEqualError(t, c.Error, err)
-			if err != nil {
-				return
-			}
-
-			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+			assert.Equal(t, c.Result, res)
 		})
 	}
 }
 
-func Test_BB_Count(t *testing.T) {
-	assert.Equal(t, 1, BB{sma: SMA{length: 1}}.Count())
-}
-
-func Test_NewCCI(t *testing.T) {
+func Test_TTMSqueeze_validate(t *testing.T) {
 	cc := map[string]struct {
-		Type   MAType
-		Length int
-		Factor decimal.Decimal
-		Result CCI
-		Error  error
+		TTMSqueeze TTMSqueeze
+		Error      error
 	}{
-		"NewSMA returns an error": {
-			Error: assert.AnError,
-		},
-		"Invalid provided moving average type": {
-			Length: 1,
-			Error:  errors.New("invalid moving average"),
+		"Invalid length": {
+			TTMSqueeze: TTMSqueeze{
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameTTMSqueeze,
+				Field:     "length",
+				Value:     1,
+				Err:       ErrInvalidLength,
+			},
 		},
-		"Invalid factor": {
-			Type:   MATypeSMA,
-			Length: 1,
-			Factor: decimal.RequireFromString("-1"),
-			Error:  errors.New("invalid factor"),
+		"Invalid BB multiplier": {
+			TTMSqueeze: TTMSqueeze{
+				length: 2,
+				bb:     decimal.NewFromInt(-1),
+			},
+			Error: &ConfigError{
+				Indicator: NameTTMSqueeze,
+				Field:     "bb",
+				Value:     decimal.NewFromInt(-1),
+				Err:       errors.New("invalid multiplier"),
+			},
 		},
-		"Successfully created new CCI with default factor": {
-			Type:   MATypeSMA,
-			Length: 10,
-			Factor: decimal.Zero,
-			Result: CCI{
-				valid: true,
-				ma: SMA{
-					length: 10,
-					valid:  true,
-				},
-				factor: decimal.RequireFromString("0.015"),
+		"Invalid KC multiplier": {
+			TTMSqueeze: TTMSqueeze{
+				length: 2,
+				kc:     decimal.NewFromInt(-1),
+			},
+			Error: &ConfigError{
+				Indicator: NameTTMSqueeze,
+				Field:     "kc",
+				Value:     decimal.NewFromInt(-1),
+				Err:       errors.New("invalid multiplier"),
 			},
 		},
-		"Successfully created new CCI": {
-			Type:   MATypeSMA,
-			Length: 10,
-			Factor: _hundred,
-			Result: CCI{
-				valid: true,
-				ma: SMA{
-					length: 10,
-					valid:  true,
-				},
-				factor: _hundred,
+		"Successfully validated": {
+			TTMSqueeze: TTMSqueeze{
+				length: 2,
 			},
 		},
 	}
@@ -477,36 +14823,33 @@ func Test_NewCCI(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := NewCCI(c.Type, c.Length, c.Factor)
-			assertEqualError(t, c.Error, err)
-			assert.Equal(t, c.Result, res)
+			assertEqualError(t, c.Error, c.TTMSqueeze.validate())
+			if c.Error == nil {
+				assert.True(t, c.TTMSqueeze.valid)
+			}
 		})
 	}
 }
 
-func Test_CCI_validate(t *testing.T) {
+func Test_TTMSqueeze_CalcCandles(t *testing.T) {
 	cc := map[string]struct {
-		CCI   CCI
-		Error error
+		TTMSqueeze TTMSqueeze
+		Candles    []Candle
+		Result     decimal.Decimal
+		Error      error
 	}{
-		"Invalid factor": {
-			CCI: CCI{
-				valid: false,
-				ma: SMA{
-					length: 1,
-				},
-				factor: decimal.NewFromInt(-1),
-			},
-			Error: errors.New("invalid factor"),
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
 		},
-		"Successfully validated": {
-			CCI: CCI{
-				valid: false,
-				ma: SMA{
-					length: 1,
-				},
-				factor: decimal.RequireFromString("1"),
+		"Successful calculation": {
+			TTMSqueeze: TTMSqueeze{
+				valid:  true,
+				length: 3,
+				bb:     decimal.NewFromInt(2),
+				kc:     decimal.RequireFromString("1.5"),
 			},
+			Candles: testCandles(t),
+			Result:  decimal.RequireFromString("1.5"),
 		},
 	}
 
@@ -516,78 +14859,60 @@ func Test_CCI_validate(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			assertEqualError(t, c.Error, c.CCI.validate())
-			if c.Error == nil {
-				assert.True(t, c.CCI.valid)
+			res, err := c.TTMSqueeze.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
 			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
 		})
 	}
 }
 
-func Test_CCI_Calc(t *testing.T) {
+func Test_TTMSqueeze_CalcLines(t *testing.T) {
 	cc := map[string]struct {
-		CCI    CCI
-		Data   []decimal.Decimal
-		Result decimal.Decimal
-		Error  error
+		TTMSqueeze TTMSqueeze
+		Candles    []Candle
+		Result     TTMSqueezeLines
+		Error      error
 	}{
 		"Invalid indicator": {
-			CCI:   CCI{},
 			Error: ErrInvalidIndicator,
 		},
 		"Invalid data size": {
-			CCI: CCI{
-				valid: true,
-				ma: SMA{
-					length: 31,
-				},
-				factor: decimal.RequireFromString("0.015"),
-			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(30),
-			},
-			Error: ErrInvalidDataSize,
-		},
-		"Invalid SMA calc": {
-			CCI: CCI{
+			TTMSqueeze: TTMSqueeze{
 				valid:  true,
-				ma:     SMA{},
-				factor: decimal.RequireFromString("0.015"),
-			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(30),
+				length: 3,
 			},
-			Error: assert.AnError,
+			Candles: testCandles(t)[:4],
+			Error:   ErrInvalidDataSize,
 		},
-		"Successful handled division by 0": {
-			CCI: CCI{
-				valid: true,
-				ma: SMA{
-					length: 1,
-					valid:  true,
-				},
-				factor: decimal.RequireFromString("0.015"),
+		"Successful calculation with squeeze on": {
+			TTMSqueeze: TTMSqueeze{
+				valid:  true,
+				length: 3,
+				bb:     decimal.NewFromInt(2),
+				kc:     decimal.RequireFromString("1.5"),
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(3),
+			Candles: testCandles(t),
+			Result: TTMSqueezeLines{
+				On:       true,
+				Momentum: decimal.RequireFromString("1.5"),
 			},
-			Result: decimal.Zero,
 		},
-		"Successful calculation": {
-			CCI: CCI{
-				valid: true,
-				ma: SMA{
-					length: 3,
-					valid:  true,
-				},
-				factor: decimal.RequireFromString("0.015"),
+		"Successful calculation with squeeze off": {
+			TTMSqueeze: TTMSqueeze{
+				valid:  true,
+				length: 3,
+				bb:     decimal.NewFromInt(2),
+				kc:     decimal.NewFromInt(1),
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(3),
-				decimal.NewFromInt(6),
-				decimal.NewFromInt(9),
+			Candles: testCandles(t),
+			Result: TTMSqueezeLines{
+				On:       false,
+				Momentum: decimal.RequireFromString("1.5"),
 			},
-			Result: decimal.NewFromInt(100),
 		},
 	}
 
@@ -597,45 +14922,124 @@ func Test_CCI_Calc(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := c.CCI.Calc(c.Data)
+			res, err := c.TTMSqueeze.CalcLines(c.Candles)
 			assertEqualError(t, c.Error, err)
 			if err != nil {
 				return
 			}
 
-			assert.Equal(t, c.Result.String(), res.String())
+			assert.Equal(t, c.Result.On, res.On)
+			assert.Equal(t, c.Result.Momentum.Round(8).String(), res.Momentum.Round(8).String())
 		})
 	}
 }
 
-func Test_CCI_Count(t *testing.T) {
-	assert.Equal(t, 10, CCI{
-		ma: SMA{
-			length: 10,
-		},
+func Test_TTMSqueeze_Count(t *testing.T) {
+	assert.Equal(t, 9, TTMSqueeze{
+		length: 5,
 	}.Count())
 }
 
-func Test_NewDEMA(t *testing.T) {
+func Test_TTMSqueeze_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameTTMSqueeze,
+		Input: InputCandle,
+	}, TTMSqueeze{}.Describe())
+}
+
+func Test_NewTypicalPrice(t *testing.T) {
+	tp, err := NewTypicalPrice(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, TypicalPrice{valid: true, src: Source{valid: true, price: PriceHLC3}}, tp)
+
+	tp, err = NewTypicalPrice(SMA{valid: true, length: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, TypicalPrice{valid: true, src: Source{valid: true, price: PriceHLC3, ind: SMA{valid: true, length: 2}}}, tp)
+}
+
+func Test_TypicalPrice_validate(t *testing.T) {
+	tp := TypicalPrice{}
+	assert.Equal(t, &ConfigError{
+		Indicator: NameTypicalPrice,
+		Err:       ErrInvalidIndicator,
+	}, tp.validate())
+
+	tp = TypicalPrice{src: Source{valid: true, price: PriceHLC3}}
+	assert.NoError(t, tp.validate())
+	assert.True(t, tp.valid)
+}
+
+func Test_TypicalPrice_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		TypicalPrice TypicalPrice
+		Candles      []Candle
+		Result       decimal.Decimal
+		Error        error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			TypicalPrice: TypicalPrice{valid: true, src: Source{valid: true, price: PriceHLC3}},
+			Candles:      testCandles(t)[:2],
+			Error:        ErrInvalidDataSize,
+		},
+		"Successful calculation without indicator": {
+			TypicalPrice: TypicalPrice{valid: true, src: Source{valid: true, price: PriceHLC3}},
+			Candles:      testCandles(t)[4:],
+			Result:       decimal.RequireFromString("13.33333333"),
+		},
+		"Successful calculation with indicator": {
+			TypicalPrice: TypicalPrice{valid: true, src: Source{valid: true, price: PriceHLC3, ind: SMA{valid: true, length: 2}}},
+			Candles:      testCandles(t)[3:],
+			Result:       decimal.RequireFromString("11.83333333"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.TypicalPrice.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_TypicalPrice_Count(t *testing.T) {
+	assert.Equal(t, 1, TypicalPrice{}.Count())
+	assert.Equal(t, 3, TypicalPrice{src: Source{ind: SMA{length: 3}}}.Count())
+}
+
+func Test_TypicalPrice_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameTypicalPrice,
+		Input:   InputCandle,
+		Overlay: true,
+	}, TypicalPrice{}.Describe())
+}
+
+func Test_NewUlcer(t *testing.T) {
 	cc := map[string]struct {
 		Length int
-		Result DEMA
+		Result Ulcer
 		Error  error
 	}{
-		"NewEMA returns an error": {
+		"Validate returns an error": {
 			Error: assert.AnError,
 		},
-		"Successfully created new DEMA": {
-			Length: 1,
-			Result: DEMA{
-				valid: true,
-				ema: EMA{
-					sma: SMA{
-						length: 1,
-						valid:  true,
-					},
-					valid: true,
-				},
+		"Successfully created new Ulcer": {
+			Length: 2,
+			Result: Ulcer{
+				valid:  true,
+				length: 2,
 			},
 		},
 	}
@@ -646,59 +15050,85 @@ func Test_NewDEMA(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := NewDEMA(c.Length)
+			res, err := NewUlcer(c.Length)
 			assertEqualError(t, c.Error, err)
 			assert.Equal(t, c.Result, res)
 		})
 	}
 }
 
-func Test_DEMA_Calc(t *testing.T) {
+func Test_Ulcer_validate(t *testing.T) {
 	cc := map[string]struct {
-		DEMA   DEMA
+		Ulcer Ulcer
+		Error error
+	}{
+		"Invalid length": {
+			Ulcer: Ulcer{
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameUlcer,
+				Field:     "length",
+				Value:     1,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully validated": {
+			Ulcer: Ulcer{
+				length: 2,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Ulcer.validate())
+			if c.Error == nil {
+				assert.True(t, c.Ulcer.valid)
+			}
+		})
+	}
+}
+
+func Test_Ulcer_Calc(t *testing.T) {
+	cc := map[string]struct {
+		Ulcer  Ulcer
 		Data   []decimal.Decimal
 		Result decimal.Decimal
 		Error  error
 	}{
 		"Invalid indicator": {
-			DEMA:  DEMA{},
 			Error: ErrInvalidIndicator,
 		},
-		"Invalid data size": {
-			DEMA: DEMA{
-				valid: true,
-				ema: EMA{
-					sma: SMA{
-						length: 3,
-						valid:  true,
-					},
-					valid: true,
-				},
+		"Invalid data size": {
+			Ulcer: Ulcer{
+				valid:  true,
+				length: 3,
 			},
 			Data: []decimal.Decimal{
 				decimal.NewFromInt(30),
 			},
 			Error: ErrInvalidDataSize,
 		},
-		"Successful calculation": {
-			DEMA: DEMA{
-				valid: true,
-				ema: EMA{
-					sma: SMA{
-						length: 3,
-						valid:  true,
-					},
-					valid: true,
-				},
+		"Successfully handled division by 0": {
+			Ulcer: Ulcer{
+				valid:  true,
+				length: 3,
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(31),
-				decimal.NewFromInt(1),
-				decimal.NewFromInt(1),
-				decimal.NewFromInt(2),
-				decimal.NewFromInt(3),
+			Data:   series(0, 0, 0),
+			Result: decimal.Zero,
+		},
+		"Successful calculation": {
+			Ulcer: Ulcer{
+				valid:  true,
+				length: 5,
 			},
-			Result: decimal.RequireFromString("6.75"),
+			Data:   series(10, 12, 9, 11, 12),
+			Result: decimal.RequireFromString("11.78511302"),
 		},
 	}
 
@@ -708,45 +15138,44 @@ func Test_DEMA_Calc(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := c.DEMA.Calc(c.Data)
+			res, err := c.Ulcer.Calc(c.Data)
 			assertEqualError(t, c.Error, err)
 			if err != nil {
 				return
 			}
 
-			assert.Equal(t, c.Result.String(), res.String())
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
 		})
 	}
 }
 
-func Test_DEMA_Count(t *testing.T) {
-	assert.Equal(t, 29, DEMA{
-		valid: false,
-		ema: EMA{
-			sma: SMA{
-				length: 15,
-			},
-		},
+func Test_Ulcer_Count(t *testing.T) {
+	assert.Equal(t, 5, Ulcer{
+		length: 5,
 	}.Count())
 }
 
-func Test_NewEMA(t *testing.T) {
+func Test_Ulcer_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameUlcer,
+		Input: InputClose,
+	}, Ulcer{}.Describe())
+}
+
+func Test_NewVHF(t *testing.T) {
 	cc := map[string]struct {
 		Length int
-		Result EMA
+		Result VHF
 		Error  error
 	}{
-		"Invalid parameters": {
+		"Validate returns an error": {
 			Error: assert.AnError,
 		},
-		"Successfully created new EMA": {
-			Length: 1,
-			Result: EMA{
-				valid: true,
-				sma: SMA{
-					length: 1,
-					valid:  true,
-				},
+		"Successfully created new VHF": {
+			Length: 2,
+			Result: VHF{
+				valid:  true,
+				length: 2,
 			},
 		},
 	}
@@ -757,53 +15186,33 @@ func Test_NewEMA(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := NewEMA(c.Length)
+			res, err := NewVHF(c.Length)
 			assertEqualError(t, c.Error, err)
 			assert.Equal(t, c.Result, res)
 		})
 	}
 }
 
-func Test_EMA_Calc(t *testing.T) {
+func Test_VHF_validate(t *testing.T) {
 	cc := map[string]struct {
-		EMA    EMA
-		Data   []decimal.Decimal
-		Result decimal.Decimal
-		Error  error
+		VHF   VHF
+		Error error
 	}{
-		"Invalid indicator": {
-			EMA:   EMA{},
-			Error: ErrInvalidIndicator,
-		},
-		"Invalid data size": {
-			EMA: EMA{
-				valid: true,
-				sma: SMA{
-					length: 3,
-					valid:  true,
-				},
+		"Invalid length": {
+			VHF: VHF{
+				length: 0,
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(30),
+			Error: &ConfigError{
+				Indicator: NameVHF,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
 			},
-			Error: ErrInvalidDataSize,
 		},
-		"Successful calculation": {
-			EMA: EMA{
-				valid: true,
-				sma: SMA{
-					length: 3,
-					valid:  true,
-				},
-			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(31),
-				decimal.NewFromInt(1),
-				decimal.NewFromInt(1),
-				decimal.NewFromInt(2),
-				decimal.NewFromInt(3),
+		"Successfully validated": {
+			VHF: VHF{
+				length: 1,
 			},
-			Result: decimal.RequireFromString("4.75"),
 		},
 	}
 
@@ -813,40 +15222,49 @@ func Test_EMA_Calc(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := c.EMA.Calc(c.Data)
-			assertEqualError(t, c.Error, err)
-			if err != nil {
-				return
+			assertEqualError(t, c.Error, c.VHF.validate())
+			if c.Error == nil {
+				assert.True(t, c.VHF.valid)
 			}
-
-			assert.Equal(t, c.Result.String(), res.String())
 		})
 	}
 }
 
-func Test_EMA_CalcNext(t *testing.T) {
+func Test_VHF_Calc(t *testing.T) {
 	cc := map[string]struct {
-		EMA    EMA
-		Last   decimal.Decimal
-		Next   decimal.Decimal
+		VHF    VHF
+		Data   []decimal.Decimal
 		Result decimal.Decimal
 		Error  error
 	}{
 		"Invalid indicator": {
-			EMA:   EMA{},
 			Error: ErrInvalidIndicator,
 		},
+		"Invalid data size": {
+			VHF: VHF{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			VHF: VHF{
+				valid:  true,
+				length: 2,
+			},
+			Data:   series(1, 1, 1),
+			Result: decimal.Zero,
+		},
 		"Successful calculation": {
-			EMA: EMA{
-				valid: true,
-				sma: SMA{
-					length: 3,
-					valid:  true,
-				},
+			VHF: VHF{
+				valid:  true,
+				length: 4,
 			},
-			Last:   decimal.NewFromInt(5),
-			Next:   decimal.NewFromInt(5),
-			Result: decimal.NewFromInt(5),
+			Data:   series(1, 3, 2, 5, 4),
+			Result: decimal.RequireFromString("0.57142857"),
 		},
 	}
 
@@ -856,50 +15274,63 @@ func Test_EMA_CalcNext(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := c.EMA.CalcNext(c.Last, c.Next)
+			res, err := c.VHF.Calc(c.Data)
 			assertEqualError(t, c.Error, err)
 			if err != nil {
 				return
 			}
 
-			assert.Equal(t, c.Result.String(), res.String())
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
 		})
 	}
 }
 
-func Test_EMA_Count(t *testing.T) {
-	assert.Equal(t, 29, EMA{
-		sma: SMA{
-			length: 15,
-		},
+func Test_VHF_Count(t *testing.T) {
+	assert.Equal(t, 6, VHF{
+		length: 5,
 	}.Count())
 }
 
-func Test_EMA_multiplier(t *testing.T) {
-	assert.Equal(t, decimal.RequireFromString("0.5").String(), EMA{
-		sma: SMA{
-			length: 3,
-		},
-	}.multiplier().String())
+func Test_VHF_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameVHF,
+		Input:   InputClose,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     decimal.NewFromInt(1),
+	}, VHF{}.Describe())
 }
 
-func Test_NewHMA(t *testing.T) {
+func Test_NewVolumeProfile(t *testing.T) {
 	cc := map[string]struct {
 		Length int
-		Result HMA
+		Bins   int
+		Area   decimal.Decimal
+		Result VolumeProfile
 		Error  error
 	}{
-		"NewWMA returns an error": {
+		"Validate returns an error": {
 			Error: assert.AnError,
 		},
-		"Successfully created new HMA": {
-			Length: 2,
-			Result: HMA{
-				valid: true,
-				wma: WMA{
-					length: 2,
-					valid:  true,
-				},
+		"Successfully created new VolumeProfile with default area": {
+			Length: 5,
+			Bins:   7,
+			Result: VolumeProfile{
+				valid:  true,
+				length: 5,
+				bins:   7,
+				area:   decimal.RequireFromString("0.7"),
+			},
+		},
+		"Successfully created new VolumeProfile": {
+			Length: 5,
+			Bins:   7,
+			Area:   decimal.RequireFromString("0.5"),
+			Result: VolumeProfile{
+				valid:  true,
+				length: 5,
+				bins:   7,
+				area:   decimal.RequireFromString("0.5"),
 			},
 		},
 	}
@@ -910,53 +15341,183 @@ func Test_NewHMA(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := NewHMA(c.Length)
+			res, err := NewVolumeProfile(c.Length, c.Bins, c.Area)
 			assertEqualError(t, c.Error, err)
 			assert.Equal(t, c.Result, res)
 		})
 	}
 }
 
-func Test_HMA_Calc(t *testing.T) {
+func Test_VolumeProfile_validate(t *testing.T) {
 	cc := map[string]struct {
-		HMA    HMA
-		Data   []decimal.Decimal
-		Result decimal.Decimal
-		Error  error
+		VolumeProfile VolumeProfile
+		Error         error
+	}{
+		"Invalid length": {
+			VolumeProfile: VolumeProfile{
+				bins: 1,
+				area: _one,
+			},
+			Error: &ConfigError{
+				Indicator: NameVolumeProfile,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Invalid bins": {
+			VolumeProfile: VolumeProfile{
+				length: 1,
+				area:   _one,
+			},
+			Error: &ConfigError{
+				Indicator: NameVolumeProfile,
+				Field:     "bins",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Area is too low": {
+			VolumeProfile: VolumeProfile{
+				length: 1,
+				bins:   1,
+			},
+			Error: &ConfigError{
+				Indicator: NameVolumeProfile,
+				Field:     "area",
+				Value:     decimal.Decimal{},
+				Err:       ErrInvalidFactor,
+			},
+		},
+		"Area is too high": {
+			VolumeProfile: VolumeProfile{
+				length: 1,
+				bins:   1,
+				area:   decimal.RequireFromString("1.1"),
+			},
+			Error: &ConfigError{
+				Indicator: NameVolumeProfile,
+				Field:     "area",
+				Value:     decimal.RequireFromString("1.1"),
+				Err:       ErrInvalidFactor,
+			},
+		},
+		"Successfully validated": {
+			VolumeProfile: VolumeProfile{
+				length: 1,
+				bins:   1,
+				area:   _one,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.VolumeProfile.validate())
+			if c.Error == nil {
+				assert.True(t, c.VolumeProfile.valid)
+			}
+		})
+	}
+}
+
+func Test_VolumeProfile_CalcProfile(t *testing.T) {
+	bin := func(low, high int64, vol string) ProfileBin {
+		return ProfileBin{
+			Low:    decimal.NewFromInt(low),
+			High:   decimal.NewFromInt(high),
+			Volume: decimal.RequireFromString(vol),
+		}
+	}
+
+	cc := map[string]struct {
+		VolumeProfile VolumeProfile
+		Candles       []Candle
+		Result        Profile
+		Error         error
 	}{
 		"Invalid indicator": {
-			HMA:   HMA{},
 			Error: ErrInvalidIndicator,
 		},
 		"Invalid data size": {
-			HMA: HMA{
-				valid: true,
-				wma: WMA{
-					length: 5,
-					valid:  true,
+			VolumeProfile: VolumeProfile{
+				valid:  true,
+				length: 5,
+				bins:   7,
+				area:   decimal.RequireFromString("0.7"),
+			},
+			Candles: testCandles(t)[:4],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation with equal prices": {
+			VolumeProfile: VolumeProfile{
+				valid:  true,
+				length: 2,
+				bins:   2,
+				area:   decimal.RequireFromString("0.7"),
+			},
+			Candles: []Candle{
+				{High: _one, Low: _one, Volume: _one},
+				{High: _one, Low: _one, Volume: _one},
+			},
+			Result: Profile{
+				POC: _one,
+				VAH: _one,
+				VAL: _one,
+				Histogram: []ProfileBin{
+					bin(1, 1, "2"),
+					bin(1, 1, "0"),
 				},
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(30),
+		},
+		"Successful calculation with the value area expanding upwards": {
+			VolumeProfile: VolumeProfile{
+				valid:  true,
+				length: 2,
+				bins:   3,
+				area:   _one,
+			},
+			Candles: []Candle{
+				{High: decimal.NewFromInt(1), Low: decimal.NewFromInt(1), Volume: decimal.NewFromInt(3)},
+				{High: decimal.NewFromInt(4), Low: decimal.NewFromInt(2), Volume: decimal.NewFromInt(4)},
+			},
+			Result: Profile{
+				POC: decimal.RequireFromString("1.5"),
+				VAH: decimal.NewFromInt(4),
+				VAL: decimal.NewFromInt(1),
+				Histogram: []ProfileBin{
+					bin(1, 2, "3"),
+					bin(2, 3, "2"),
+					bin(3, 4, "2"),
+				},
 			},
-			Error: ErrInvalidDataSize,
 		},
 		"Successful calculation": {
-			HMA: HMA{
-				valid: true,
-				wma: WMA{
-					length: 4,
-					valid:  true,
-				},
+			VolumeProfile: VolumeProfile{
+				valid:  true,
+				length: 5,
+				bins:   7,
+				area:   decimal.RequireFromString("0.7"),
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(32),
-				decimal.NewFromInt(29),
-				decimal.NewFromInt(38),
-				decimal.NewFromInt(34),
-				decimal.NewFromInt(29),
+			Candles: testCandles(t),
+			Result: Profile{
+				POC: decimal.RequireFromString("10.5"),
+				VAH: decimal.NewFromInt(12),
+				VAL: decimal.NewFromInt(9),
+				Histogram: []ProfileBin{
+					bin(8, 9, "50"),
+					bin(9, 10, "200"),
+					bin(10, 11, "450"),
+					bin(11, 12, "112.5"),
+					bin(12, 13, "62.5"),
+					bin(13, 14, "62.5"),
+					bin(14, 15, "62.5"),
+				},
 			},
-			Result: decimal.RequireFromString("33.8"),
 		},
 	}
 
@@ -966,39 +15527,60 @@ func Test_HMA_Calc(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := c.HMA.Calc(c.Data)
+			res, err := c.VolumeProfile.CalcProfile(c.Candles)
 			assertEqualError(t, c.Error, err)
 			if err != nil {
 				return
 			}
 
-			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+			assert.Equal(t, c.Result.POC.String(), res.POC.String())
+			assert.Equal(t, c.Result.VAH.String(), res.VAH.String())
+			assert.Equal(t, c.Result.VAL.String(), res.VAL.String())
+			require.Len(t, res.Histogram, len(c.Result.Histogram))
+
+			for i := range res.Histogram {
+				assert.Equal(t, c.Result.Histogram[i].Low.String(), res.Histogram[i].Low.String())
+				assert.Equal(t, c.Result.Histogram[i].High.String(), res.Histogram[i].High.String())
+				assert.Equal(t, c.Result.Histogram[i].Volume.String(), res.Histogram[i].Volume.String())
+			}
 		})
 	}
 }
 
-func Test_HMA_Count(t *testing.T) {
-	assert.Equal(t, 17, HMA{
-		wma: WMA{
-			length: 15,
-		},
-	}.Count())
+func Test_VolumeProfile_bin(t *testing.T) {
+	vp := VolumeProfile{bins: 3}
+
+	assert.Equal(t, 0, vp.bin(_one, _one, decimal.Zero))
+	assert.Equal(t, 1, vp.bin(decimal.NewFromInt(2), _one, _one))
+	assert.Equal(t, 2, vp.bin(decimal.NewFromInt(4), _one, _one))
+}
+
+func Test_VolumeProfile_Count(t *testing.T) {
+	assert.Equal(t, 5, VolumeProfile{length: 5}.Count())
 }
 
-func Test_NewROC(t *testing.T) {
+func Test_VolumeProfile_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameVolumeProfile,
+		Input:   InputCandle,
+		Overlay: true,
+	}, VolumeProfile{}.Describe())
+}
+
+func Test_NewVWMA(t *testing.T) {
 	cc := map[string]struct {
 		Length int
-		Result ROC
+		Result VWMA
 		Error  error
 	}{
 		"Validate returns an error": {
 			Error: assert.AnError,
 		},
-		"Successfully created new ROC": {
-			Length: 1,
-			Result: ROC{
+		"Successfully created new VWMA": {
+			Length: 2,
+			Result: VWMA{
 				valid:  true,
-				length: 1,
+				length: 2,
 			},
 		},
 	}
@@ -1009,26 +15591,31 @@ func Test_NewROC(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := NewROC(c.Length)
+			res, err := NewVWMA(c.Length)
 			assertEqualError(t, c.Error, err)
 			assert.Equal(t, c.Result, res)
 		})
 	}
 }
 
-func Test_ROC_validate(t *testing.T) {
+func Test_VWMA_validate(t *testing.T) {
 	cc := map[string]struct {
-		ROC   ROC
+		VWMA  VWMA
 		Error error
 	}{
 		"Invalid length": {
-			ROC: ROC{
-				length: -1,
+			VWMA: VWMA{
+				length: 0,
+			},
+			Error: &ConfigError{
+				Indicator: NameVWMA,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
 			},
-			Error: ErrInvalidLength,
 		},
 		"Successfully validated": {
-			ROC: ROC{
+			VWMA: VWMA{
 				length: 1,
 			},
 		},
@@ -1040,48 +15627,47 @@ func Test_ROC_validate(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			assertEqualError(t, c.Error, c.ROC.validate())
+			assertEqualError(t, c.Error, c.VWMA.validate())
 			if c.Error == nil {
-				assert.True(t, c.ROC.valid)
+				assert.True(t, c.VWMA.valid)
 			}
 		})
 	}
 }
 
-func Test_ROC_Calc(t *testing.T) {
+func Test_VWMA_CalcCandles(t *testing.T) {
 	cc := map[string]struct {
-		ROC    ROC
-		Data   []decimal.Decimal
-		Result decimal.Decimal
-		Error  error
+		VWMA    VWMA
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
 	}{
 		"Invalid indicator": {
-			ROC:   ROC{},
 			Error: ErrInvalidIndicator,
 		},
 		"Invalid data size": {
-			ROC: ROC{
+			VWMA: VWMA{
 				valid:  true,
 				length: 3,
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(30),
+			Candles: testCandles(t)[:1],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successfully handled division by 0": {
+			VWMA: VWMA{
+				valid:  true,
+				length: 2,
 			},
-			Error: ErrInvalidDataSize,
+			Candles: []Candle{{}, {}},
+			Result:  decimal.Zero,
 		},
 		"Successful calculation": {
-			ROC: ROC{
+			VWMA: VWMA{
 				valid:  true,
-				length: 5,
-			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(7),
-				decimal.NewFromInt(16),
-				decimal.NewFromInt(24),
-				decimal.NewFromInt(16),
-				decimal.NewFromInt(10),
+				length: 3,
 			},
-			Result: decimal.RequireFromString("-30"),
+			Candles: testCandles(t)[:3],
+			Result:  decimal.RequireFromString("10.11111111"),
 		},
 	}
 
@@ -1091,39 +15677,87 @@ func Test_ROC_Calc(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := c.ROC.Calc(c.Data)
+			res, err := c.VWMA.CalcCandles(c.Candles)
 			assertEqualError(t, c.Error, err)
 			if err != nil {
 				return
 			}
 
-			assert.Equal(t, c.Result.String(), res.String())
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
 		})
 	}
 }
 
-func Test_ROC_Count(t *testing.T) {
-	assert.Equal(t, 15, ROC{
-		length: 15,
+func Test_VWMA_Count(t *testing.T) {
+	assert.Equal(t, 5, VWMA{
+		length: 5,
 	}.Count())
 }
 
-func Test_NewRSI(t *testing.T) {
+func Test_VWMA_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameVWMA,
+		Input:   InputCandle,
+		Overlay: true,
+		Lag:     decimal.NewFromInt(2),
+	}, VWMA{length: 5}.Describe())
+}
+
+// testVWMACD returns a small valid VWMACD used in calculation tests.
+func testVWMACD() VWMACD {
+	return VWMACD{
+		valid:  true,
+		fast:   VWMA{valid: true, length: 2},
+		slow:   VWMA{valid: true, length: 3},
+		signal: EMA{valid: true, sma: SMA{valid: true, length: 2}},
+	}
+}
+
+func Test_NewVWMACD(t *testing.T) {
 	cc := map[string]struct {
-		Length int
-		Result RSI
+		Fast   int
+		Slow   int
+		Signal int
+		Result VWMACD
 		Error  error
 	}{
-		"Validate returns an error": {
-			Error: assert.AnError,
+		"Invalid fast and slow lengths": {
+			Fast:   3,
+			Slow:   2,
+			Signal: 2,
+			Error: &ConfigError{
+				Indicator: NameVWMACD,
+				Field:     "fast",
+				Value:     3,
+				Err:       ErrInvalidLength,
+			},
 		},
-		"Successfully created new RSI": {
-			Length: 1,
-			Result: RSI{
-				valid:  true,
-				length: 1,
+		"Invalid fast length": {
+			Slow:   2,
+			Signal: 2,
+			Error: &ConfigError{
+				Indicator: NameVWMACD,
+				Field:     "fast",
+				Value:     0,
+				Err:       ErrInvalidLength,
 			},
 		},
+		"Invalid signal length": {
+			Fast: 2,
+			Slow: 3,
+			Error: &ConfigError{
+				Indicator: NameVWMACD,
+				Field:     "signal",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
+		},
+		"Successfully created new VWMACD": {
+			Fast:   2,
+			Slow:   3,
+			Signal: 2,
+			Result: testVWMACD(),
+		},
 	}
 
 	for cn, c := range cc {
@@ -1132,27 +15766,56 @@ func Test_NewRSI(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := NewRSI(c.Length)
+			res, err := NewVWMACD(c.Fast, c.Slow, c.Signal)
 			assertEqualError(t, c.Error, err)
 			assert.Equal(t, c.Result, res)
 		})
 	}
 }
 
-func Test_RSI_validate(t *testing.T) {
+func Test_VWMACD_validate(t *testing.T) {
 	cc := map[string]struct {
-		RSI   RSI
-		Error error
+		VWMACD VWMACD
+		Error  error
 	}{
-		"Invalid length": {
-			RSI: RSI{
-				length: 0,
+		"Invalid fast VWMA": {
+			VWMACD: VWMACD{
+				slow:   VWMA{valid: true},
+				signal: EMA{valid: true},
+			},
+			Error: &ConfigError{
+				Indicator: NameVWMACD,
+				Field:     "fast",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Invalid slow VWMA": {
+			VWMACD: VWMACD{
+				fast:   VWMA{valid: true},
+				signal: EMA{valid: true},
+			},
+			Error: &ConfigError{
+				Indicator: NameVWMACD,
+				Field:     "slow",
+				Err:       ErrInvalidIndicator,
+			},
+		},
+		"Invalid signal EMA": {
+			VWMACD: VWMACD{
+				fast: VWMA{valid: true},
+				slow: VWMA{valid: true},
+			},
+			Error: &ConfigError{
+				Indicator: NameVWMACD,
+				Field:     "signal",
+				Err:       ErrInvalidIndicator,
 			},
-			Error: ErrInvalidLength,
 		},
 		"Successfully validated": {
-			RSI: RSI{
-				length: 1,
+			VWMACD: VWMACD{
+				fast:   VWMA{valid: true},
+				slow:   VWMA{valid: true},
+				signal: EMA{valid: true},
 			},
 		},
 	}
@@ -1163,70 +15826,33 @@ func Test_RSI_validate(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			assertEqualError(t, c.Error, c.RSI.validate())
+			assertEqualError(t, c.Error, c.VWMACD.validate())
 			if c.Error == nil {
-				assert.True(t, c.RSI.valid)
+				assert.True(t, c.VWMACD.valid)
 			}
 		})
 	}
 }
 
-func Test_RSI_Calc(t *testing.T) {
+func Test_VWMACD_CalcCandles(t *testing.T) {
 	cc := map[string]struct {
-		RSI    RSI
-		Data   []decimal.Decimal
-		Result decimal.Decimal
-		Error  error
+		VWMACD  VWMACD
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
 	}{
 		"Invalid indicator": {
-			RSI:   RSI{},
 			Error: ErrInvalidIndicator,
 		},
 		"Invalid data size": {
-			RSI: RSI{
-				valid:  true,
-				length: 3,
-			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(30),
-			},
-			Error: ErrInvalidDataSize,
-		},
-		"Successful calculation when average gain 0": {
-			RSI: RSI{
-				valid:  true,
-				length: 3,
-			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(16),
-				decimal.NewFromInt(12),
-				decimal.NewFromInt(8),
-			},
-			Result: decimal.NewFromInt(0),
-		},
-		"Successful calculation when average loss 0": {
-			RSI: RSI{
-				valid:  true,
-				length: 3,
-			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(2),
-				decimal.NewFromInt(4),
-				decimal.NewFromInt(8),
-			},
-			Result: _hundred,
+			VWMACD:  testVWMACD(),
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
 		},
 		"Successful calculation": {
-			RSI: RSI{
-				valid:  true,
-				length: 3,
-			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(8),
-				decimal.NewFromInt(12),
-				decimal.NewFromInt(8),
-			},
-			Result: decimal.NewFromInt(50),
+			VWMACD:  testVWMACD(),
+			Candles: testCandles(t),
+			Result:  decimal.RequireFromString("0.17532468"),
 		},
 	}
 
@@ -1236,37 +15862,39 @@ func Test_RSI_Calc(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := c.RSI.Calc(c.Data)
+			res, err := c.VWMACD.CalcCandles(c.Candles)
 			assertEqualError(t, c.Error, err)
 			if err != nil {
 				return
 			}
 
-			assert.Equal(t, c.Result.String(), res.String())
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
 		})
 	}
 }
 
-func Test_RSI_Count(t *testing.T) {
-	assert.Equal(t, 15, RSI{
-		length: 15,
-	}.Count())
-}
-
-func Test_NewSMA(t *testing.T) {
+func Test_VWMACD_CalcLines(t *testing.T) {
 	cc := map[string]struct {
-		Length int
-		Result SMA
-		Error  error
+		VWMACD  VWMACD
+		Candles []Candle
+		Result  VWMACDLines
+		Error   error
 	}{
-		"Validate returns an error": {
-			Error: assert.AnError,
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
 		},
-		"Successfully created new SMA": {
-			Length: 1,
-			Result: SMA{
-				valid:  true,
-				length: 1,
+		"Invalid data size": {
+			VWMACD:  testVWMACD(),
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			VWMACD:  testVWMACD(),
+			Candles: testCandles(t),
+			Result: VWMACDLines{
+				MACD:      decimal.RequireFromString("0.17532468"),
+				Signal:    decimal.RequireFromString("0.18688719"),
+				Histogram: decimal.RequireFromString("-0.01156251"),
 			},
 		},
 	}
@@ -1277,28 +15905,76 @@ func Test_NewSMA(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := NewSMA(c.Length)
+			res, err := c.VWMACD.CalcLines(c.Candles)
 			assertEqualError(t, c.Error, err)
-			assert.Equal(t, c.Result, res)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.MACD.Round(8).String(), res.MACD.Round(8).String())
+			assert.Equal(t, c.Result.Signal.Round(8).String(), res.Signal.Round(8).String())
+			assert.Equal(t, c.Result.Histogram.Round(8).String(), res.Histogram.Round(8).String())
 		})
 	}
 }
 
-func Test_SMA_validate(t *testing.T) {
+func Test_VWMACD_Count(t *testing.T) {
+	assert.Equal(t, 5, testVWMACD().Count())
+}
+
+func Test_VWMACD_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameVWMACD,
+		Input: InputCandle,
+	}, VWMACD{}.Describe())
+}
+
+func Test_NewWeightedClose(t *testing.T) {
+	wc, err := NewWeightedClose(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, WeightedClose{valid: true, src: Source{valid: true, price: PriceHLCC4}}, wc)
+
+	wc, err = NewWeightedClose(SMA{valid: true, length: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, WeightedClose{valid: true, src: Source{valid: true, price: PriceHLCC4, ind: SMA{valid: true, length: 2}}}, wc)
+}
+
+func Test_WeightedClose_validate(t *testing.T) {
+	wc := WeightedClose{}
+	assert.Equal(t, &ConfigError{
+		Indicator: NameWeightedClose,
+		Err:       ErrInvalidIndicator,
+	}, wc.validate())
+
+	wc = WeightedClose{src: Source{valid: true, price: PriceHLCC4}}
+	assert.NoError(t, wc.validate())
+	assert.True(t, wc.valid)
+}
+
+func Test_WeightedClose_CalcCandles(t *testing.T) {
 	cc := map[string]struct {
-		SMA   SMA
-		Error error
+		WeightedClose WeightedClose
+		Candles       []Candle
+		Result        decimal.Decimal
+		Error         error
 	}{
-		"Invalid length": {
-			SMA: SMA{
-				length: 0,
-			},
-			Error: ErrInvalidLength,
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
 		},
-		"Successfully validated": {
-			SMA: SMA{
-				length: 1,
-			},
+		"Invalid data size": {
+			WeightedClose: WeightedClose{valid: true, src: Source{valid: true, price: PriceHLCC4}},
+			Candles:       testCandles(t)[:2],
+			Error:         ErrInvalidDataSize,
+		},
+		"Successful calculation without indicator": {
+			WeightedClose: WeightedClose{valid: true, src: Source{valid: true, price: PriceHLCC4}},
+			Candles:       testCandles(t)[4:],
+			Result:        decimal.RequireFromString("13.5"),
+		},
+		"Successful calculation with indicator": {
+			WeightedClose: WeightedClose{valid: true, src: Source{valid: true, price: PriceHLCC4, ind: SMA{valid: true, length: 2}}},
+			Candles:       testCandles(t)[3:],
+			Result:        decimal.RequireFromString("11.875"),
 		},
 	}
 
@@ -1308,46 +15984,48 @@ func Test_SMA_validate(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			assertEqualError(t, c.Error, c.SMA.validate())
-			if c.Error == nil {
-				assert.True(t, c.SMA.valid)
+			res, err := c.WeightedClose.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
 			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
 		})
 	}
 }
 
-func Test_SMA_Calc(t *testing.T) {
+func Test_WeightedClose_Count(t *testing.T) {
+	assert.Equal(t, 1, WeightedClose{}.Count())
+	assert.Equal(t, 3, WeightedClose{src: Source{ind: SMA{length: 3}}}.Count())
+}
+
+func Test_WeightedClose_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameWeightedClose,
+		Input:   InputCandle,
+		Overlay: true,
+	}, WeightedClose{}.Describe())
+}
+
+func Test_NewWeisWave(t *testing.T) {
 	cc := map[string]struct {
-		SMA    SMA
-		Data   []decimal.Decimal
-		Result decimal.Decimal
-		Error  error
+		Length   int
+		Reversal decimal.Decimal
+		Result   WeisWave
+		Error    error
 	}{
-		"Invalid indicator": {
-			SMA:   SMA{},
-			Error: ErrInvalidIndicator,
-		},
-		"Invalid data size": {
-			SMA: SMA{
-				valid:  true,
-				length: 3,
-			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(30),
-			},
-			Error: ErrInvalidDataSize,
+		"Validate returns an error": {
+			Error: assert.AnError,
 		},
-		"Successful calculation": {
-			SMA: SMA{
-				valid:  true,
-				length: 3,
-			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(30),
-				decimal.NewFromInt(31),
-				decimal.NewFromInt(32),
+		"Successfully created new WeisWave": {
+			Length:   2,
+			Reversal: decimal.NewFromInt(1),
+			Result: WeisWave{
+				valid:    true,
+				length:   2,
+				reversal: decimal.NewFromInt(1),
 			},
-			Result: decimal.NewFromInt(31),
 		},
 	}
 
@@ -1357,40 +16035,44 @@ func Test_SMA_Calc(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := c.SMA.Calc(c.Data)
+			res, err := NewWeisWave(c.Length, c.Reversal)
 			assertEqualError(t, c.Error, err)
-			if err != nil {
-				return
-			}
-
-			assert.Equal(t, c.Result.String(), res.String())
+			assert.Equal(t, c.Result, res)
 		})
 	}
 }
 
-func Test_SMA_Count(t *testing.T) {
-	assert.Equal(t, 15, SMA{
-		length: 15,
-	}.Count())
-}
-
-func Test_NewSRSI(t *testing.T) {
+func Test_WeisWave_validate(t *testing.T) {
 	cc := map[string]struct {
-		Length int
-		Result SRSI
-		Error  error
+		WeisWave WeisWave
+		Error    error
 	}{
-		"Validate returns an error": {
-			Error: assert.AnError,
+		"Invalid length": {
+			WeisWave: WeisWave{
+				length: 1,
+			},
+			Error: &ConfigError{
+				Indicator: NameWeisWave,
+				Field:     "length",
+				Value:     1,
+				Err:       ErrInvalidLength,
+			},
 		},
-		"Successfully created new SRSI": {
-			Length: 1,
-			Result: SRSI{
-				valid: true,
-				rsi: RSI{
-					length: 1,
-					valid:  true,
-				},
+		"Invalid reversal": {
+			WeisWave: WeisWave{
+				length:   2,
+				reversal: decimal.NewFromInt(-1),
+			},
+			Error: &ConfigError{
+				Indicator: NameWeisWave,
+				Field:     "reversal",
+				Value:     decimal.NewFromInt(-1),
+				Err:       errors.New("invalid reversal"),
+			},
+		},
+		"Successfully validated": {
+			WeisWave: WeisWave{
+				length: 2,
 			},
 		},
 	}
@@ -1401,70 +16083,64 @@ func Test_NewSRSI(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := NewSRSI(c.Length)
-			assertEqualError(t, c.Error, err)
-			assert.Equal(t, c.Result, res)
+			assertEqualError(t, c.Error, c.WeisWave.validate())
+			if c.Error == nil {
+				assert.True(t, c.WeisWave.valid)
+			}
 		})
 	}
 }
 
-func Test_SRSI_Calc(t *testing.T) {
+func Test_WeisWave_CalcCandles(t *testing.T) {
 	cc := map[string]struct {
-		SRSI   SRSI
-		Data   []decimal.Decimal
-		Result decimal.Decimal
-		Error  error
+		WeisWave WeisWave
+		Candles  []Candle
+		Result   decimal.Decimal
+		Error    error
 	}{
 		"Invalid indicator": {
-			SRSI:  SRSI{},
 			Error: ErrInvalidIndicator,
 		},
 		"Invalid data size": {
-			SRSI: SRSI{
-				valid: true,
-				rsi: RSI{
-					length: 5,
-					valid:  true,
-				},
-			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(30),
+			WeisWave: WeisWave{
+				valid:  true,
+				length: 5,
 			},
-			Error: assert.AnError,
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
 		},
-		"Successfully handled division by 0": {
-			SRSI: SRSI{
-				valid: true,
-				rsi: RSI{
-					length: 3,
-					valid:  true,
-				},
+		"Successful calculation without price changes": {
+			WeisWave: WeisWave{
+				valid:  true,
+				length: 5,
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(8),
-				decimal.NewFromInt(12),
-				decimal.NewFromInt(8),
-				decimal.NewFromInt(12),
-				decimal.NewFromInt(8),
+			Candles: make([]Candle, 5),
+			Result:  decimal.Zero,
+		},
+		"Successful calculation of down wave": {
+			WeisWave: WeisWave{
+				valid:  true,
+				length: 4,
 			},
-			Result: decimal.Zero,
+			Candles: testCandles(t)[:4],
+			Result:  decimal.NewFromInt(-300),
 		},
-		"Successful calculation": {
-			SRSI: SRSI{
-				valid: true,
-				rsi: RSI{
-					length: 3,
-					valid:  true,
-				},
+		"Successful calculation of up wave": {
+			WeisWave: WeisWave{
+				valid:  true,
+				length: 5,
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(11),
-				decimal.NewFromInt(12),
-				decimal.NewFromInt(11),
-				decimal.NewFromInt(11),
-				decimal.NewFromInt(11),
+			Candles: testCandles(t),
+			Result:  decimal.NewFromInt(250),
+		},
+		"Successful calculation using reversal": {
+			WeisWave: WeisWave{
+				valid:    true,
+				length:   5,
+				reversal: decimal.NewFromInt(20),
 			},
-			Result: decimal.RequireFromString("0.5"),
+			Candles: testCandles(t),
+			Result:  decimal.NewFromInt(900),
 		},
 	}
 
@@ -1474,7 +16150,7 @@ func Test_SRSI_Calc(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := c.SRSI.Calc(c.Data)
+			res, err := c.WeisWave.CalcCandles(c.Candles)
 			assertEqualError(t, c.Error, err)
 			if err != nil {
 				return
@@ -1485,29 +16161,36 @@ func Test_SRSI_Calc(t *testing.T) {
 	}
 }
 
-func Test_SRSI_Count(t *testing.T) {
-	assert.Equal(t, 29, SRSI{
-		valid: false,
-		rsi: RSI{
-			length: 15,
-		},
+func Test_WeisWave_Count(t *testing.T) {
+	assert.Equal(t, 5, WeisWave{
+		length: 5,
 	}.Count())
 }
 
-func Test_NewStoch(t *testing.T) {
+func Test_WeisWave_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:  NameWeisWave,
+		Input: InputCandle,
+	}, WeisWave{}.Describe())
+}
+
+func Test_NewWillR(t *testing.T) {
 	cc := map[string]struct {
-		Length int
-		Result Stoch
-		Error  error
+		Length  int
+		Rescale bool
+		Result  WillR
+		Error   error
 	}{
 		"Validate returns an error": {
 			Error: assert.AnError,
 		},
-		"Successfully created new Stoch": {
-			Length: 1,
-			Result: Stoch{
-				valid:  true,
-				length: 1,
+		"Successfully created new WillR": {
+			Length:  2,
+			Rescale: true,
+			Result: WillR{
+				valid:   true,
+				length:  2,
+				rescale: true,
 			},
 		},
 	}
@@ -1518,26 +16201,31 @@ func Test_NewStoch(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := NewStoch(c.Length)
+			res, err := NewWillR(c.Length, c.Rescale)
 			assertEqualError(t, c.Error, err)
 			assert.Equal(t, c.Result, res)
 		})
 	}
 }
 
-func Test_Stoch_validate(t *testing.T) {
+func Test_WillR_validate(t *testing.T) {
 	cc := map[string]struct {
-		Stoch Stoch
+		WillR WillR
 		Error error
 	}{
 		"Invalid length": {
-			Stoch: Stoch{
+			WillR: WillR{
 				length: 0,
 			},
-			Error: ErrInvalidLength,
+			Error: &ConfigError{
+				Indicator: NameWillR,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
 		},
 		"Successfully validated": {
-			Stoch: Stoch{
+			WillR: WillR{
 				length: 1,
 			},
 		},
@@ -1549,70 +16237,56 @@ func Test_Stoch_validate(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			assertEqualError(t, c.Error, c.Stoch.validate())
+			assertEqualError(t, c.Error, c.WillR.validate())
 			if c.Error == nil {
-				assert.True(t, c.Stoch.valid)
+				assert.True(t, c.WillR.valid)
 			}
 		})
 	}
 }
 
-func Test_Stoch_Calc(t *testing.T) {
+func Test_WillR_CalcCandles(t *testing.T) {
 	cc := map[string]struct {
-		Stoch  Stoch
-		Data   []decimal.Decimal
-		Result decimal.Decimal
-		Error  error
+		WillR   WillR
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
 	}{
 		"Invalid indicator": {
-			Stoch: Stoch{},
 			Error: ErrInvalidIndicator,
 		},
 		"Invalid data size": {
-			Stoch: Stoch{
-				valid:  true,
-				length: 3,
-			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(30),
-			},
-			Error: ErrInvalidDataSize,
-		},
-		"Successful calculation when new lows are reached": {
-			Stoch: Stoch{
+			WillR: WillR{
 				valid:  true,
 				length: 3,
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(150),
-				decimal.NewFromInt(125),
-				decimal.NewFromInt(145),
-			},
-			Result: decimal.NewFromInt(80),
+			Candles: testCandles(t)[:1],
+			Error:   ErrInvalidDataSize,
 		},
 		"Successfully handled division by 0": {
-			Stoch: Stoch{
+			WillR: WillR{
 				valid:  true,
-				length: 3,
-			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(150),
-				decimal.NewFromInt(150),
-				decimal.NewFromInt(150),
+				length: 2,
 			},
-			Result: decimal.Zero,
+			Candles: []Candle{{}, {}},
+			Result:  decimal.NewFromInt(-100),
 		},
-		"Successful calculation when new highs are reached": {
-			Stoch: Stoch{
+		"Successful calculation": {
+			WillR: WillR{
 				valid:  true,
 				length: 3,
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(120),
-				decimal.NewFromInt(145),
-				decimal.NewFromInt(135),
+			Candles: testCandles(t)[2:],
+			Result:  decimal.RequireFromString("-16.66666667"),
+		},
+		"Successful calculation with rescale": {
+			WillR: WillR{
+				valid:   true,
+				length:  3,
+				rescale: true,
 			},
-			Result: decimal.NewFromInt(60),
+			Candles: testCandles(t)[2:],
+			Result:  decimal.RequireFromString("83.33333333"),
 		},
 	}
 
@@ -1622,17 +16296,41 @@ func Test_Stoch_Calc(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := c.Stoch.Calc(c.Data)
+			res, err := c.WillR.CalcCandles(c.Candles)
 			assertEqualError(t, c.Error, err)
 			if err != nil {
 				return
 			}
 
-			assert.Equal(t, c.Result.String(), res.String())
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
 		})
 	}
 }
 
+func Test_WillR_Count(t *testing.T) {
+	assert.Equal(t, 5, WillR{
+		length: 5,
+	}.Count())
+}
+
+func Test_WillR_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameWillR,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.NewFromInt(-100),
+		Max:     decimal.Zero,
+	}, WillR{}.Describe())
+
+	assertEqualDescription(t, Description{
+		Name:    NameWillR,
+		Input:   InputCandle,
+		Bounded: true,
+		Min:     decimal.Zero,
+		Max:     decimal.NewFromInt(100),
+	}, WillR{rescale: true}.Describe())
+}
+
 func Test_NewWMA(t *testing.T) {
 	cc := map[string]struct {
 		Length int
@@ -1673,7 +16371,12 @@ func Test_WMA_validate(t *testing.T) {
 			WMA: WMA{
 				length: 0,
 			},
-			Error: ErrInvalidLength,
+			Error: &ConfigError{
+				Indicator: NameWMA,
+				Field:     "length",
+				Value:     0,
+				Err:       ErrInvalidLength,
+			},
 		},
 		"Successfully validated": {
 			WMA: WMA{
@@ -1753,3 +16456,132 @@ func Test_WMA_Count(t *testing.T) {
 		length: 15,
 	}.Count())
 }
+
+func Test_WMA_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameWMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.NewFromInt(2),
+	}, WMA{
+		length: 7,
+	}.Describe())
+}
+
+func Test_NewZLEMA(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result ZLEMA
+		Error  error
+	}{
+		"NewEMA returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new ZLEMA": {
+			Length: 1,
+			Result: ZLEMA{
+				valid: true,
+				ema: EMA{
+					sma: SMA{
+						length: 1,
+						valid:  true,
+					},
+					valid: true,
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewZLEMA(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ZLEMA_Calc(t *testing.T) {
+	cc := map[string]struct {
+		ZLEMA  ZLEMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			ZLEMA: ZLEMA{
+				valid: true,
+				ema: EMA{
+					sma: SMA{
+						length: 3,
+						valid:  true,
+					},
+					valid: true,
+				},
+			},
+			Data:  series(1, 2, 3),
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			ZLEMA: ZLEMA{
+				valid: true,
+				ema: EMA{
+					sma: SMA{
+						length: 3,
+						valid:  true,
+					},
+					valid: true,
+				},
+			},
+			Data:   series(1, 2, 3, 4, 5, 6),
+			Result: decimal.NewFromInt(6),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ZLEMA.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_ZLEMA_lag(t *testing.T) {
+	assert.Equal(t, 0, ZLEMA{ema: EMA{sma: SMA{length: 2}}}.lag())
+	assert.Equal(t, 2, ZLEMA{ema: EMA{sma: SMA{length: 5}}}.lag())
+}
+
+func Test_ZLEMA_Count(t *testing.T) {
+	assert.Equal(t, 11, ZLEMA{
+		ema: EMA{
+			sma: SMA{
+				length: 5,
+			},
+		},
+	}.Count())
+}
+
+func Test_ZLEMA_Describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Name:    NameZLEMA,
+		Input:   InputClose,
+		Overlay: true,
+		Lag:     decimal.Zero,
+	}, ZLEMA{}.Describe())
+}
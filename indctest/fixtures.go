@@ -0,0 +1,192 @@
+package indctest
+
+import (
+	"time"
+
+	"github.com/jellydator/indc"
+	"github.com/shopspring/decimal"
+)
+
+// candle is a helper function that creates a daily candle of the
+// reference series.
+func candle(day int, o, h, l, c, v string) indc.Candle {
+	return indc.Candle{
+		Timestamp: time.Date(2021, 1, 1+day, 0, 0, 0, 0, time.UTC),
+		Open:      decimal.RequireFromString(o),
+		High:      decimal.RequireFromString(h),
+		Low:       decimal.RequireFromString(l),
+		Close:     decimal.RequireFromString(c),
+		Volume:    decimal.RequireFromString(v),
+	}
+}
+
+// Candles returns the reference daily OHLCV series, ordered from the
+// oldest candle to the newest one.
+// A new slice is returned on every call, so it can be modified freely.
+func Candles() []indc.Candle {
+	return []indc.Candle{
+		candle(0, "105.26", "107.18", "104.86", "105.93", "20080"),
+		candle(1, "105.93", "107.26", "105.28", "107.14", "18160"),
+		candle(2, "107.14", "109.15", "107.04", "108.79", "13520"),
+		candle(3, "108.79", "108.84", "106.28", "106.74", "21490"),
+		candle(4, "106.74", "107.66", "106.27", "107.44", "12760"),
+		candle(5, "107.44", "108.98", "107.25", "108.11", "17120"),
+		candle(6, "108.11", "109.20", "107.38", "108.91", "24800"),
+		candle(7, "108.91", "112.29", "108.55", "110.99", "23360"),
+		candle(8, "110.99", "112.11", "110.59", "111.57", "21360"),
+		candle(9, "111.57", "112.13", "111.47", "111.90", "14370"),
+		candle(10, "111.90", "112.43", "111.38", "112.11", "10310"),
+		candle(11, "112.11", "113.98", "112.11", "113.59", "11550"),
+		candle(12, "113.59", "115.12", "113.42", "114.92", "14390"),
+		candle(13, "114.92", "115.62", "112.70", "113.38", "17890"),
+		candle(14, "113.38", "113.61", "112.12", "112.38", "21470"),
+		candle(15, "112.38", "113.44", "112.31", "112.86", "12610"),
+		candle(16, "112.86", "112.86", "108.37", "109.45", "21220"),
+		candle(17, "109.45", "109.89", "106.91", "106.95", "22550"),
+		candle(18, "106.95", "108.18", "106.31", "107.69", "9680"),
+		candle(19, "107.69", "109.84", "106.80", "109.56", "11340"),
+		candle(20, "109.56", "111.30", "109.39", "109.87", "18130"),
+		candle(21, "109.87", "110.99", "107.72", "107.95", "17430"),
+		candle(22, "107.95", "108.81", "104.59", "105.32", "13930"),
+		candle(23, "105.32", "107.39", "104.38", "106.95", "14830"),
+		candle(24, "106.95", "107.55", "106.20", "106.27", "9700"),
+		candle(25, "106.27", "106.45", "104.35", "105.04", "13990"),
+		candle(26, "105.04", "105.70", "103.27", "103.45", "17950"),
+		candle(27, "103.45", "103.70", "102.75", "102.89", "21030"),
+		candle(28, "102.89", "103.26", "101.53", "102.13", "24600"),
+		candle(29, "102.13", "103.77", "102.02", "102.88", "11110"),
+		candle(30, "102.88", "103.79", "100.71", "101.28", "14170"),
+		candle(31, "101.28", "101.92", "100.86", "101.42", "17510"),
+		candle(32, "101.42", "102.37", "99.88", "101.86", "19170"),
+		candle(33, "101.86", "104.85", "101.00", "103.62", "13020"),
+		candle(34, "103.62", "104.95", "103.61", "104.80", "24170"),
+		candle(35, "104.80", "105.76", "104.47", "105.64", "24270"),
+		candle(36, "105.64", "107.02", "105.15", "106.69", "23080"),
+		candle(37, "106.69", "106.76", "104.73", "104.81", "11680"),
+		candle(38, "104.81", "104.81", "104.39", "104.73", "13930"),
+		candle(39, "104.73", "105.79", "104.65", "105.36", "21830"),
+		candle(40, "105.36", "105.41", "103.78", "104.33", "22960"),
+		candle(41, "104.33", "104.44", "104.01", "104.20", "18070"),
+		candle(42, "104.20", "104.80", "102.82", "103.80", "22260"),
+		candle(43, "103.80", "104.40", "100.19", "101.29", "12380"),
+		candle(44, "101.29", "103.31", "100.70", "102.88", "20220"),
+		candle(45, "102.88", "103.77", "102.21", "102.94", "12820"),
+		candle(46, "102.94", "103.48", "100.45", "101.10", "12080"),
+		candle(47, "101.10", "102.47", "100.75", "101.62", "22580"),
+		candle(48, "101.62", "102.97", "101.50", "101.92", "23300"),
+		candle(49, "101.92", "101.92", "100.44", "100.93", "11580"),
+		candle(50, "100.93", "102.55", "100.89", "102.41", "10670"),
+		candle(51, "102.41", "103.17", "100.31", "101.77", "24580"),
+		candle(52, "101.77", "101.92", "100.14", "100.60", "22080"),
+		candle(53, "100.60", "100.85", "99.39", "100.06", "15380"),
+		candle(54, "100.06", "101.39", "99.06", "100.36", "15680"),
+		candle(55, "100.36", "101.44", "100.11", "101.12", "20420"),
+		candle(56, "101.12", "101.64", "100.69", "100.99", "17010"),
+		candle(57, "100.99", "101.29", "100.01", "100.57", "14440"),
+		candle(58, "100.57", "100.84", "98.43", "99.68", "12950"),
+		candle(59, "99.68", "100.45", "97.98", "98.94", "20930"),
+		candle(60, "98.94", "100.37", "98.68", "100.36", "21420"),
+		candle(61, "100.36", "102.17", "100.29", "101.03", "13160"),
+		candle(62, "101.03", "103.74", "101.01", "103.11", "19730"),
+		candle(63, "103.11", "103.28", "102.08", "102.29", "18300"),
+		candle(64, "102.29", "103.13", "101.71", "102.77", "9460"),
+		candle(65, "102.77", "103.57", "102.02", "103.15", "22110"),
+		candle(66, "103.15", "104.20", "101.46", "102.36", "14810"),
+		candle(67, "102.36", "103.43", "102.11", "103.00", "13640"),
+		candle(68, "103.00", "103.04", "101.80", "102.94", "15660"),
+		candle(69, "102.94", "104.14", "101.82", "103.32", "23670"),
+		candle(70, "103.32", "103.40", "102.49", "102.74", "10420"),
+		candle(71, "102.74", "102.95", "101.53", "101.64", "9410"),
+		candle(72, "101.64", "101.72", "99.27", "99.51", "14610"),
+		candle(73, "99.51", "99.54", "97.19", "97.49", "9250"),
+		candle(74, "97.49", "98.68", "97.47", "98.07", "15890"),
+		candle(75, "98.07", "99.17", "97.55", "98.67", "15110"),
+		candle(76, "98.67", "100.14", "98.58", "99.68", "13630"),
+		candle(77, "99.68", "100.45", "97.35", "97.69", "22530"),
+		candle(78, "97.69", "98.08", "97.02", "97.91", "13540"),
+		candle(79, "97.91", "98.43", "97.82", "98.37", "15400"),
+		candle(80, "98.37", "99.62", "98.28", "98.66", "23130"),
+		candle(81, "98.66", "100.34", "98.62", "99.89", "20410"),
+		candle(82, "99.89", "100.88", "99.16", "100.40", "21680"),
+		candle(83, "100.40", "102.05", "99.88", "101.74", "21940"),
+		candle(84, "101.74", "102.40", "99.88", "101.48", "17730"),
+		candle(85, "101.48", "101.95", "99.53", "100.35", "12560"),
+		candle(86, "100.35", "102.05", "99.86", "99.97", "12300"),
+		candle(87, "99.97", "100.86", "99.28", "99.70", "15560"),
+		candle(88, "99.70", "100.44", "99.49", "100.31", "18780"),
+		candle(89, "100.31", "100.54", "99.57", "100.00", "15240"),
+		candle(90, "100.00", "100.31", "98.00", "99.01", "10480"),
+		candle(91, "99.01", "101.33", "98.09", "101.10", "19390"),
+		candle(92, "101.10", "101.32", "98.74", "99.43", "13920"),
+		candle(93, "99.43", "100.12", "95.73", "96.99", "11530"),
+		candle(94, "96.99", "100.84", "96.07", "99.86", "10260"),
+		candle(95, "99.86", "101.10", "98.39", "100.44", "9950"),
+		candle(96, "100.44", "101.18", "99.75", "100.89", "20070"),
+		candle(97, "100.89", "101.42", "97.38", "98.62", "12700"),
+		candle(98, "98.62", "99.52", "95.90", "96.26", "10990"),
+		candle(99, "96.26", "96.85", "96.08", "96.66", "13210"),
+		candle(100, "96.66", "97.58", "95.47", "96.74", "18530"),
+		candle(101, "96.74", "98.10", "96.22", "97.37", "12680"),
+		candle(102, "97.37", "99.14", "96.47", "98.70", "19750"),
+		candle(103, "98.70", "99.35", "97.97", "98.77", "21470"),
+		candle(104, "98.77", "102.12", "98.08", "101.85", "24500"),
+		candle(105, "101.85", "103.26", "100.28", "100.97", "22680"),
+		candle(106, "100.97", "101.85", "97.25", "98.45", "15420"),
+		candle(107, "98.45", "99.04", "96.76", "97.56", "18340"),
+		candle(108, "97.56", "97.79", "94.51", "94.99", "23270"),
+		candle(109, "94.99", "96.29", "93.91", "96.11", "22250"),
+		candle(110, "96.11", "97.74", "95.39", "96.69", "23670"),
+		candle(111, "96.69", "97.73", "95.95", "96.08", "18450"),
+		candle(112, "96.08", "97.04", "94.49", "95.28", "13460"),
+		candle(113, "95.28", "97.32", "94.83", "97.04", "17000"),
+		candle(114, "97.04", "100.52", "96.71", "99.72", "17220"),
+		candle(115, "99.72", "101.47", "98.47", "100.13", "20260"),
+		candle(116, "100.13", "100.81", "98.26", "98.86", "16790"),
+		candle(117, "98.86", "102.11", "98.51", "101.80", "13750"),
+		candle(118, "101.80", "103.00", "100.54", "102.88", "12730"),
+		candle(119, "102.88", "102.99", "100.82", "101.51", "16560"),
+	}
+}
+
+// Closes returns the close prices of the reference series.
+func Closes() []decimal.Decimal {
+	cc := Candles()
+	res := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		res[i] = cc[i].Close
+	}
+
+	return res
+}
+
+// Benchmark returns the close prices of the reference benchmark series,
+// e.g. a market index, aligned with the candles of the reference series.
+// A new slice is returned on every call, so it can be modified freely.
+func Benchmark() []decimal.Decimal {
+	return decimals(
+		"250.00", "250.18", "252.96", "251.36", "251.70", "250.97", "252.00", "255.49", "257.69", "256.58",
+		"255.21", "258.13", "260.56", "260.46", "260.36", "260.89", "255.80", "255.58", "256.27", "257.93",
+		"256.90", "254.33", "250.80", "252.70", "251.66", "250.26", "248.85", "249.41", "248.57", "247.44",
+		"246.00", "244.46", "244.89", "245.62", "247.30", "247.53", "249.27", "250.75", "250.57", "252.53",
+		"249.38", "248.83", "249.08", "245.35", "248.11", "248.33", "244.42", "245.86", "245.30", "246.17",
+		"247.71", "247.63", "245.92", "246.30", "246.00", "248.35", "248.00", "248.95", "248.41", "247.48",
+		"248.65", "250.60", "254.28", "255.15", "255.39", "256.66", "256.13", "257.45", "257.63", "258.44",
+		"256.80", "253.85", "250.15", "247.84", "250.60", "252.74", "255.62", "253.55", "254.78", "255.73",
+		"257.17", "261.37", "261.74", "262.93", "265.10", "263.65", "264.33", "265.06", "264.53", "267.26",
+		"268.05", "271.68", "270.01", "266.21", "269.76", "270.55", "271.78", "269.63", "266.71", "267.45",
+		"269.39", "269.42", "272.70", "274.33", "279.06", "275.11", "269.74", "268.60", "265.06", "268.99",
+		"268.61", "267.60", "267.13", "269.88", "271.15", "272.91", "273.99", "280.25", "280.75", "277.93",
+	)
+}
+
+// decimals is a helper function that parses the provided decimal strings.
+func decimals(ss ...string) []decimal.Decimal {
+	res := make([]decimal.Decimal, len(ss))
+
+	for i := range ss {
+		res[i] = decimal.RequireFromString(ss[i])
+	}
+
+	return res
+}
@@ -0,0 +1,557 @@
+// Package indctest provides reference fixtures, expected indicator values
+// and assertion helpers that can be used to verify indicator wiring and
+// custom indicators against known-good vectors. Only the vectors with a
+// reference are verified against an independent definition, the rest of
+// them guard against regressions of this module.
+package indctest
+
+import (
+	"github.com/jellydator/indc"
+	"github.com/shopspring/decimal"
+)
+
+// TB is the subset of testing.TB that is used by the assertion helpers.
+type TB interface {
+	// Helper should mark the calling function as a test helper.
+	Helper()
+
+	// Errorf should report a formatted test failure.
+	Errorf(format string, args ...interface{})
+}
+
+// Vector holds an indicator together with its expected value calculated
+// from the newest close prices of the reference series.
+type Vector struct {
+	// Name specifies the human readable name of the vector.
+	Name string
+
+	// Indicator specifies the indicator that is used during the
+	// calculation.
+	Indicator indc.Indicator
+
+	// Expected specifies the expected calculation result.
+	Expected decimal.Decimal
+
+	// Reference specifies the published definition, e.g. a TA-Lib
+	// function, that the expected value was independently recalculated
+	// from over the same data points. It is empty when the expected
+	// value was recorded from this module's own output.
+	Reference string
+}
+
+// CandleVector holds a candle indicator together with its expected value
+// calculated from the newest candles of the reference series.
+type CandleVector struct {
+	// Name specifies the human readable name of the vector.
+	Name string
+
+	// Indicator specifies the indicator that is used during the
+	// calculation.
+	Indicator indc.CandleIndicator
+
+	// Expected specifies the expected calculation result.
+	Expected decimal.Decimal
+
+	// Reference specifies the published definition, e.g. a TA-Lib
+	// function, that the expected value was independently recalculated
+	// from over the same data points. It is empty when the expected
+	// value was recorded from this module's own output.
+	Reference string
+}
+
+// PairVector holds a pair indicator together with its expected value
+// calculated from the newest close prices of the reference and benchmark
+// series.
+type PairVector struct {
+	// Name specifies the human readable name of the vector.
+	Name string
+
+	// Indicator specifies the indicator that is used during the
+	// calculation.
+	Indicator indc.PairIndicator
+
+	// Expected specifies the expected calculation result.
+	Expected decimal.Decimal
+
+	// Reference specifies the published definition, e.g. a TA-Lib
+	// function, that the expected value was independently recalculated
+	// from over the same data points. It is empty when the expected
+	// value was recorded from this module's own output.
+	Reference string
+}
+
+// Vectors returns reference vectors of the indicators provided by the
+// indc package. The expected values are rounded to 8 decimal places.
+func Vectors() []Vector {
+	return []Vector{
+		vector("ALMA 9 0.85 6", "", "101.50244195")(indc.NewALMA(9, decimal.RequireFromString("0.85"), decimal.NewFromInt(6))),
+		vector("APO EMA 5 10", "", "0.85257697")(indc.NewAPO(indc.MATypeEMA, indc.PriceClose, 5, 10)),
+		vector("Aroon up 14", "TA-Lib AROON", "92.85714286")(indc.NewAroon(indc.TrendUp, 14)),
+		vector("Aroon down 14", "TA-Lib AROON", "21.42857143")(indc.NewAroon(indc.TrendDown, 14)),
+		vector("AroonOsc 14", "TA-Lib AROONOSC", "71.42857143")(indc.NewAroonOsc(14)),
+		vector("BB upper 20 2", "TA-Lib BBANDS", "103.17192288")(indc.NewBB(false, indc.BandUpper, decimal.NewFromInt(2), 20)),
+		vector("BB lower 20 2", "TA-Lib BBANDS", "93.97807712")(indc.NewBB(false, indc.BandLower, decimal.NewFromInt(2), 20)),
+		vector("BB width 20 2", "", "9.32675198")(indc.NewBB(false, indc.BandWidth, decimal.NewFromInt(2), 20)),
+		vector("BBW 20 2", "", "0.09326752")(indc.NewBBW(decimal.NewFromInt(2), 20)),
+		vector("Calmar 20", "", "0.73206427")(indc.NewCalmar(20, 0)),
+		vector("CCI SMA 20", "TA-Lib CCI with high and low equal to close", "100.65157750")(indc.NewCCI(indc.MATypeSMA, 20, decimal.Zero)),
+		vector("CMO 14", "", "2.62135922")(indc.NewCMO(14)),
+		vector("Decycler 10", "", "102.66738707")(indc.NewDecycler(10)),
+		vector("DEMA 10", "", "98.68276278")(indc.NewDEMA(10)),
+		vector("Donchian upper 20", "TA-Lib MAX", "102.88000000")(indc.NewDonchian(indc.BandUpper, 20)),
+		vector("DYMI 14 5 10 5 30", "", "72.29822161")(indc.NewDYMI(14, 5, 10, 5, 30)),
+		vector("EMA 10", "TA-Lib EMA", "99.98063290")(indc.NewEMA(10)),
+		vector("ER 10", "", "0.40000000")(indc.NewER(10)),
+		vector("Ergodic 10 5 3", "", "2.84710316")(indc.NewErgodic(10, 5, 3)),
+		vector("FRAMA 10", "", "102.20740855")(indc.NewFRAMA(10)),
+		vector("GMMA separation", "", "0.84008049")(indc.NewGMMA()),
+		vector("Highest 20", "TA-Lib MAX", "102.88000000")(indc.NewHighest(20)),
+		vector("HilbertPeriod 30", "", "10.47683183")(indc.NewHilbertPeriod(30)),
+		vector("HMA 9", "", "93.82107407")(indc.NewHMA(9)),
+		vector("KST", "", "33.82683997")(indc.NewKST([4]int{3, 4, 5, 6}, [4]int{3, 3, 3, 4}, 3)),
+		vector("Kurtosis 20", "", "-1.07810036")(indc.NewKurtosis(20)),
+		vector("LinReg slope 14", "TA-Lib LINEARREG_SLOPE", "0.45872527")(indc.NewLinReg(14)),
+		vector("Lowest 20", "TA-Lib MIN", "94.99000000")(indc.NewLowest(20)),
+		vector("LSMA 14", "TA-Lib LINEARREG", "101.34600000")(indc.NewLSMA(14)),
+		vector("MACD EMA 5 10", "", "0.85257697")(indc.NewMACD(ema(5), ema(10), sma(3))),
+		vector("McGinley 10 0.6", "", "99.60894679")(indc.NewMcGinley(10, decimal.Zero)),
+		vector("Normalize RSI 5 20 min max", "", "60.36036036")(indc.NewNormalize(rsi(5), 20, indc.ScalingMinMax)),
+		vector("Normalize RSI 5 20 percent rank", "", "47.36842105")(indc.NewNormalize(rsi(5), 20, indc.ScalingPercentRank)),
+		vector("Offset SMA 10 5", "", "97.28900000")(indc.NewOffset(sma(10), 5)),
+		vector("PercentB 20 2", "", "0.81923529")(indc.NewPercentB(decimal.NewFromInt(2), 20)),
+		vector("Periodogram 3 10 8", "", "6")(indc.NewPeriodogram(3, 10, 8)),
+		vector("PPO EMA 5 10 3", "", "0.85274212")(indc.NewPPO(ema(5), ema(10), ema(3))),
+		vector("Rainbow 2 10", "", "12.48558285")(indc.NewRainbow(2, 10)),
+		vector("Ribbon SMA 5 10 20", "", "99.53666667")(indc.NewRibbon(sma(5), sma(10), sma(20))),
+		vector("ROC 12", "", "-6.42301251")(indc.NewROC(12)),
+		vector("RSI 14", "", "58.46238938")(indc.NewRSI(14, indc.SmoothingSMA)),
+		vector("RSI 14 Wilder", "TA-Lib RSI", "56.44710287")(indc.NewRSI(14, indc.SmoothingWilder)),
+		vector("SavitzkyGolay 11 3", "", "101.92657343")(indc.NewSavitzkyGolay(11, 3)),
+		vector("Sharpe 20 252", "", "2.52242046")(indc.NewSharpe(20, 252)),
+		vector("Skew 20", "", "0.25166786")(indc.NewSkew(20)),
+		vector("SMA 20", "TA-Lib SMA", "98.57500000")(indc.NewSMA(20)),
+		vector("SMMA 10", "TradingView ta.rma", "99.19480617")(indc.NewSMMA(10)),
+		vector("Sortino 20 252", "", "4.18354982")(indc.NewSortino(20, 252)),
+		vector("SRSI 14", "", "0.82106501")(indc.NewSRSI(14)),
+		vector("Stoch 14", "TA-Lib STOCHF with high and low equal to close", "82.63624842")(indc.NewStoch(14)),
+		vector("StochFull 14 SMA 3 3", "", "93.96912848")(indc.NewStochFull(14, sma(3), sma(3))),
+		vector("StochOf RSI 14 14 3", "", "88.35191577")(indc.NewStochOf(rsi(14), 14, 3)),
+		vector("SuperSmoother 10", "", "101.36074744")(indc.NewSuperSmoother(10)),
+		vector("T3 5 0.7", "", "100.67378906")(indc.NewT3(5, decimal.RequireFromString("0.7"))),
+		vector("TEMA 5", "TA-Lib TEMA", "102.31146850")(indc.NewTEMA(5)),
+		vector("TSI 10 5 3", "", "30.99011592")(indc.NewTSI(10, 5, 3)),
+		vector("Ulcer 14", "", "1.76494055")(indc.NewUlcer(14)),
+		vector("VHF 14", "", "0.38300971")(indc.NewVHF(14)),
+		vector("WMA 10", "TA-Lib WMA", "100.17581818")(indc.NewWMA(10)),
+		vector("ZLEMA 10", "", "102.13094687")(indc.NewZLEMA(10)),
+	}
+}
+
+// CandleVectors returns reference vectors of the candle indicators
+// provided by the indc package. The expected values are rounded to 8
+// decimal places.
+func CandleVectors() []CandleVector {
+	return []CandleVector{
+		candleVector("ADX 7 Wilder", "", "22.14897463")(indc.NewADX(7, indc.SmoothingWilder)),
+		candleVector("Alligator jaw", "", "98.24222676")(alligatorLine(func(ll indc.AlligatorLines) decimal.Decimal { return ll.Jaw })(indc.NewAlligator(smma(13, 8), smma(8, 5), smma(5, 3)))),
+		candleVector("Alligator teeth", "", "97.42893905")(alligatorLine(func(ll indc.AlligatorLines) decimal.Decimal { return ll.Teeth })(indc.NewAlligator(smma(13, 8), smma(8, 5), smma(5, 3)))),
+		candleVector("Alligator lips", "", "97.71900640")(alligatorLine(func(ll indc.AlligatorLines) decimal.Decimal { return ll.Lips })(indc.NewAlligator(smma(13, 8), smma(8, 5), smma(5, 3)))),
+		candleVector("ATR 14 Wilder", "TA-Lib ATR", "2.79155867")(indc.NewATR(14, indc.SmoothingWilder)),
+		candleVector("ATR 14 EMA", "", "2.73819212")(indc.NewATR(14, indc.SmoothingEMA)),
+		candleVector("ATR 14 SMA", "", "2.80714286")(indc.NewATR(14, indc.SmoothingSMA)),
+		candleVector("BOP SMA 14", "TA-Lib BOP and SMA", "0.00089161")(indc.NewBOP(sma(14))),
+		candleVector("CandleStats 14", "", "0.52417303")(indc.NewCandleStats(14)),
+		candleVector("CMF 20", "TradingView Chaikin Money Flow", "0.09985004")(indc.NewCMF(20)),
+		candleVector("DMI +DI 14 Wilder", "TA-Lib PLUS_DI", "22.09981396")(indc.NewDMI(indc.TrendUp, 14, indc.SmoothingWilder)),
+		candleVector("DMI -DI 14 Wilder", "TA-Lib MINUS_DI", "16.10527832")(indc.NewDMI(indc.TrendDown, 14, indc.SmoothingWilder)),
+		candleVector("Donchian lower 20", "TA-Lib MIN of low prices", "93.91000000")(indc.NewDonchian(indc.BandLower, 20)),
+		candleVector("Donchian upper 20", "TA-Lib MAX of high prices", "103.26000000")(indc.NewDonchian(indc.BandUpper, 20)),
+		candleVector("DonchianWidth 20 normalized", "", "0.09484201")(indc.NewDonchianWidth(20, true)),
+		candleVector("ElderRay bull 13", "", "3.47443632")(indc.NewElderRay(indc.TrendUp, 13)),
+		candleVector("ElderRay bear 13", "", "1.30443632")(indc.NewElderRay(indc.TrendDown, 13)),
+		candleVector("ForceIndex 13", "", "4880.68930730")(indc.NewForceIndex(13)),
+		candleVector("Gap 1", "", "0")(indc.NewGap(decimal.NewFromInt(1))),
+		candleVector("Gator upper", "", "0.81328771")(gatorLine(func(ll indc.GatorLines) decimal.Decimal { return ll.Upper })(indc.NewGator(smma(13, 8), smma(8, 5), smma(5, 3)))),
+		candleVector("Gator lower", "", "-0.29006735")(gatorLine(func(ll indc.GatorLines) decimal.Decimal { return ll.Lower })(indc.NewGator(smma(13, 8), smma(8, 5), smma(5, 3)))),
+		candleVector("Ichimoku tenkan 9 26 52", "", "98.74500000")(ichimokuLine(func(ll indc.IchimokuLines) decimal.Decimal { return ll.Tenkan })(indc.NewIchimoku(9, 26, 52))),
+		candleVector("Ichimoku kijun 9 26 52", "", "98.58500000")(ichimokuLine(func(ll indc.IchimokuLines) decimal.Decimal { return ll.Kijun })(indc.NewIchimoku(9, 26, 52))),
+		candleVector("Ichimoku senkou A 9 26 52", "", "99.41250000")(ichimokuLine(func(ll indc.IchimokuLines) decimal.Decimal { return ll.SenkouA })(indc.NewIchimoku(9, 26, 52))),
+		candleVector("Ichimoku senkou B 9 26 52", "", "100.26500000")(ichimokuLine(func(ll indc.IchimokuLines) decimal.Decimal { return ll.SenkouB })(indc.NewIchimoku(9, 26, 52))),
+		candleVector("IntradayIntensity 20 percent", "", "9.98500426")(indc.NewIntradayIntensity(20, true)),
+		candleVector("Keltner lower EMA 10 2", "", "94.53455266")(indc.NewKeltner(indc.BandLower, nil, 10, decimal.Zero)),
+		candleVector("Keltner upper EMA 10 2", "", "105.42671315")(indc.NewKeltner(indc.BandUpper, nil, 10, decimal.Zero)),
+		candleVector("KeltnerWidth 10 2 normalized", "", "0.10894270")(indc.NewKeltnerWidth(10, decimal.Zero, true)),
+		candleVector("MedianPrice SMA 10", "TA-Lib MEDPRICE and SMA", "98.73500000")(indc.NewMedianPrice(sma(10))),
+		candleVector("MFI 14", "TA-Lib MFI", "42.32049173")(indc.NewMFI(14)),
+		candleVector("NVI 20 EMA 5", "", "944.72340541")(indc.NewNVI(20, 5)),
+		candleVector("OBV 20", "", "78840")(indc.NewOBV(20)),
+		candleVector("Pivots classic PP", "", "101.77333333")(pivotsLine(func(ll indc.PivotLevels) decimal.Decimal { return ll.PP })(indc.NewPivots(indc.PivotClassic))),
+		candleVector("Pivots classic R1", "", "102.72666667")(pivotsLine(func(ll indc.PivotLevels) decimal.Decimal { return ll.R1 })(indc.NewPivots(indc.PivotClassic))),
+		candleVector("Pivots classic S1", "", "100.55666667")(pivotsLine(func(ll indc.PivotLevels) decimal.Decimal { return ll.S1 })(indc.NewPivots(indc.PivotClassic))),
+		candleVector("Pivots Fibonacci R1", "", "102.60227333")(pivotsLine(func(ll indc.PivotLevels) decimal.Decimal { return ll.R1 })(indc.NewPivots(indc.PivotFibonacci))),
+		candleVector("Pivots Camarilla R1", "", "101.70891667")(pivotsLine(func(ll indc.PivotLevels) decimal.Decimal { return ll.R1 })(indc.NewPivots(indc.PivotCamarilla))),
+		candleVector("Pivots Woodie PP", "", "101.70750000")(pivotsLine(func(ll indc.PivotLevels) decimal.Decimal { return ll.PP })(indc.NewPivots(indc.PivotWoodie))),
+		candleVector("ProjectionBands lower 14", "", "97.10419780")(indc.NewProjectionBands(indc.BandLower, 14)),
+		candleVector("ProjectionBands upper 14", "", "106.31285714")(indc.NewProjectionBands(indc.BandUpper, 14)),
+		candleVector("ProjectionOscillator 14", "", "47.84412187")(indc.NewProjectionOscillator(14)),
+		candleVector("PVI 20 EMA 5", "", "1062.80355176")(indc.NewPVI(20, 5)),
+		candleVector("PVO EMA 5 10 3", "", "-6.89980795")(indc.NewPVO(ema(5), ema(10), ema(3))),
+		candleVector("Qstick 14", "", "0.03857143")(indc.NewQstick(14)),
+		candleVector("SMI 10 3 3 5", "", "64.36239139")(indc.NewSMI(10, 3, 3, 5)),
+		candleVector("Source OHLC4 SMA 10", "TA-Lib AVGPRICE and SMA", "98.73200000")(indc.NewSource(indc.PriceOHLC4, sma(10))),
+		candleVector("StochFull 14 SMA 3 3", "", "87.97640548")(indc.NewStochFull(14, sma(3), sma(3))),
+		candleVector("TDSequential 30", "", "8")(indc.NewTDSequential(30)),
+		candleVector("TTMSqueeze 14", "", "3.17783673")(indc.NewTTMSqueeze(14, decimal.Zero, decimal.Zero)),
+		candleVector("TypicalPrice SMA 10", "TA-Lib TYPPRICE and SMA", "98.82300000")(indc.NewTypicalPrice(sma(10))),
+		candleVector("VolumeProfile POC 20 10", "", "97.18250000")(profileLine(func(p indc.Profile) decimal.Decimal { return p.POC })(indc.NewVolumeProfile(20, 10, decimal.Zero))),
+		candleVector("VolumeProfile VAH 20 10", "", "100.45500000")(profileLine(func(p indc.Profile) decimal.Decimal { return p.VAH })(indc.NewVolumeProfile(20, 10, decimal.Zero))),
+		candleVector("VolumeProfile VAL 20 10", "", "94.84500000")(profileLine(func(p indc.Profile) decimal.Decimal { return p.VAL })(indc.NewVolumeProfile(20, 10, decimal.Zero))),
+		candleVector("VWMA 20", "TradingView ta.vwma", "98.50931775")(indc.NewVWMA(20)),
+		candleVector("VWMACD 5 10 3", "", "2.04680462")(indc.NewVWMACD(5, 10, 3)),
+		candleVector("WeightedClose SMA 10", "TA-Lib WCLPRICE and SMA", "98.86700000")(indc.NewWeightedClose(sma(10))),
+		candleVector("WeisWave 20 1", "", "-16560")(indc.NewWeisWave(20, decimal.NewFromInt(1))),
+		candleVector("WillR 14", "TA-Lib WILLR", "-16.39163916")(indc.NewWillR(14, false)),
+	}
+}
+
+// PairVectors returns reference vectors of the pair indicators provided
+// by the indc package. The expected values are rounded to 8 decimal
+// places.
+func PairVectors() []PairVector {
+	return []PairVector{
+		pairVector("Beta 20", "", "1.29021406")(indc.NewBeta(20)),
+		pairVector("Correlation 20", "", "0.94939816")(indc.NewCorrelation(20)),
+		pairVector("RSC 20", "", "0.36523585")(indc.NewRSC(20)),
+	}
+}
+
+// ema is a helper function that creates EMA used as a source indicator.
+func ema(length int) indc.Indicator {
+	ind, err := indc.NewEMA(length)
+	if err != nil {
+		// unlikely to happen
+		panic(err)
+	}
+
+	return ind
+}
+
+// rsi is a helper function that creates RSI used as a source indicator.
+func rsi(length int) indc.Indicator {
+	ind, err := indc.NewRSI(length, indc.SmoothingSMA)
+	if err != nil {
+		// unlikely to happen
+		panic(err)
+	}
+
+	return ind
+}
+
+// sma is a helper function that creates SMA used as a source indicator.
+func sma(length int) indc.Indicator {
+	ind, err := indc.NewSMA(length)
+	if err != nil {
+		// unlikely to happen
+		panic(err)
+	}
+
+	return ind
+}
+
+// smma is a helper function that creates SMMA shifted by the provided
+// amount of data points used as a source indicator.
+func smma(length, shift int) indc.Indicator {
+	ma, err := indc.NewSMMA(length)
+	if err != nil {
+		// unlikely to happen
+		panic(err)
+	}
+
+	ind, err := indc.NewOffset(ma, shift)
+	if err != nil {
+		// unlikely to happen
+		panic(err)
+	}
+
+	return ind
+}
+
+// candleLine adapts a single line of a candle indicator that calculates
+// multiple lines to indc.CandleIndicator.
+type candleLine struct {
+	// count specifies the total amount of candles needed for the
+	// calculation.
+	count int
+
+	// calc specifies the function that calculates the line.
+	calc func([]indc.Candle) (decimal.Decimal, error)
+}
+
+// CalcCandles calculates the line from the provided candles slice.
+func (cl candleLine) CalcCandles(cc []indc.Candle) (decimal.Decimal, error) {
+	return cl.calc(cc)
+}
+
+// Count determines the total amount of candles needed for the line
+// calculation.
+func (cl candleLine) Count() int {
+	return cl.count
+}
+
+// alligatorLine is a helper function that adapts the picked line of
+// Alligator to indc.CandleIndicator.
+func alligatorLine(pick func(indc.AlligatorLines) decimal.Decimal) func(indc.Alligator, error) (indc.CandleIndicator, error) {
+	return func(ind indc.Alligator, err error) (indc.CandleIndicator, error) {
+		if err != nil {
+			return nil, err
+		}
+
+		return candleLine{
+			count: ind.Count(),
+			calc: func(cc []indc.Candle) (decimal.Decimal, error) {
+				ll, err := ind.CalcLines(cc)
+				if err != nil {
+					return decimal.Zero, err
+				}
+
+				return pick(ll), nil
+			},
+		}, nil
+	}
+}
+
+// gatorLine is a helper function that adapts the picked line of Gator to
+// indc.CandleIndicator.
+func gatorLine(pick func(indc.GatorLines) decimal.Decimal) func(indc.Gator, error) (indc.CandleIndicator, error) {
+	return func(ind indc.Gator, err error) (indc.CandleIndicator, error) {
+		if err != nil {
+			return nil, err
+		}
+
+		return candleLine{
+			count: ind.Count(),
+			calc: func(cc []indc.Candle) (decimal.Decimal, error) {
+				ll, err := ind.CalcLines(cc)
+				if err != nil {
+					return decimal.Zero, err
+				}
+
+				return pick(ll), nil
+			},
+		}, nil
+	}
+}
+
+// ichimokuLine is a helper function that adapts the picked line of
+// Ichimoku to indc.CandleIndicator.
+func ichimokuLine(pick func(indc.IchimokuLines) decimal.Decimal) func(indc.Ichimoku, error) (indc.CandleIndicator, error) {
+	return func(ind indc.Ichimoku, err error) (indc.CandleIndicator, error) {
+		if err != nil {
+			return nil, err
+		}
+
+		return candleLine{
+			count: ind.Count(),
+			calc: func(cc []indc.Candle) (decimal.Decimal, error) {
+				ll, err := ind.CalcLines(cc)
+				if err != nil {
+					return decimal.Zero, err
+				}
+
+				return pick(ll), nil
+			},
+		}, nil
+	}
+}
+
+// pivotsLine is a helper function that adapts the picked level of Pivots
+// to indc.CandleIndicator.
+func pivotsLine(pick func(indc.PivotLevels) decimal.Decimal) func(indc.Pivots, error) (indc.CandleIndicator, error) {
+	return func(ind indc.Pivots, err error) (indc.CandleIndicator, error) {
+		if err != nil {
+			return nil, err
+		}
+
+		return candleLine{
+			count: ind.Count(),
+			calc: func(cc []indc.Candle) (decimal.Decimal, error) {
+				ll, err := ind.CalcLines(cc)
+				if err != nil {
+					return decimal.Zero, err
+				}
+
+				return pick(ll), nil
+			},
+		}, nil
+	}
+}
+
+// profileLine is a helper function that adapts the picked value of
+// VolumeProfile to indc.CandleIndicator.
+func profileLine(pick func(indc.Profile) decimal.Decimal) func(indc.VolumeProfile, error) (indc.CandleIndicator, error) {
+	return func(ind indc.VolumeProfile, err error) (indc.CandleIndicator, error) {
+		if err != nil {
+			return nil, err
+		}
+
+		return candleLine{
+			count: ind.Count(),
+			calc: func(cc []indc.Candle) (decimal.Decimal, error) {
+				p, err := ind.CalcProfile(cc)
+				if err != nil {
+					return decimal.Zero, err
+				}
+
+				return pick(p), nil
+			},
+		}, nil
+	}
+}
+
+// vector is a helper function that creates a new vector from the
+// indicator's constructor results.
+func vector(name, ref, exp string) func(indc.Indicator, error) Vector {
+	return func(ind indc.Indicator, err error) Vector {
+		if err != nil {
+			// vectors are static, this can only happen if an
+			// indicator's validation changes.
+			panic(err)
+		}
+
+		return Vector{
+			Name:      name,
+			Indicator: ind,
+			Expected:  decimal.RequireFromString(exp),
+			Reference: ref,
+		}
+	}
+}
+
+// candleVector is a helper function that creates a new candle vector from
+// the indicator's constructor results.
+func candleVector(name, ref, exp string) func(indc.CandleIndicator, error) CandleVector {
+	return func(ind indc.CandleIndicator, err error) CandleVector {
+		if err != nil {
+			// vectors are static, this can only happen if an
+			// indicator's validation changes.
+			panic(err)
+		}
+
+		return CandleVector{
+			Name:      name,
+			Indicator: ind,
+			Expected:  decimal.RequireFromString(exp),
+			Reference: ref,
+		}
+	}
+}
+
+// pairVector is a helper function that creates a new pair vector from the
+// indicator's constructor results.
+func pairVector(name, ref, exp string) func(indc.PairIndicator, error) PairVector {
+	return func(ind indc.PairIndicator, err error) PairVector {
+		if err != nil {
+			// vectors are static, this can only happen if an
+			// indicator's validation changes.
+			panic(err)
+		}
+
+		return PairVector{
+			Name:      name,
+			Indicator: ind,
+			Expected:  decimal.RequireFromString(exp),
+			Reference: ref,
+		}
+	}
+}
+
+// AssertDecimal checks whether the actual value differs from the expected
+// one by no more than the tolerance.
+func AssertDecimal(t TB, exp, act, tol decimal.Decimal) bool {
+	t.Helper()
+
+	if exp.Sub(act).Abs().GreaterThan(tol) {
+		t.Errorf("expected %s, got %s (tolerance %s)", exp, act, tol)
+		return false
+	}
+
+	return true
+}
+
+// AssertVector calculates the vector's indicator from the newest close
+// prices of the reference series and checks whether the result differs
+// from the expected one by no more than the tolerance.
+func AssertVector(t TB, v Vector, tol decimal.Decimal) bool {
+	t.Helper()
+
+	dd := Closes()
+	if v.Indicator.Count() > len(dd) {
+		t.Errorf("%s: indicator requires %d data points, %d available", v.Name, v.Indicator.Count(), len(dd))
+		return false
+	}
+
+	res, err := v.Indicator.Calc(dd[len(dd)-v.Indicator.Count():])
+	if err != nil {
+		t.Errorf("%s: %v", v.Name, err)
+		return false
+	}
+
+	if !AssertDecimal(t, v.Expected, res, tol) {
+		t.Errorf("%s: unexpected result", v.Name)
+		return false
+	}
+
+	return true
+}
+
+// AssertCandleVector calculates the vector's indicator from the newest
+// candles of the reference series and checks whether the result differs
+// from the expected one by no more than the tolerance.
+func AssertCandleVector(t TB, v CandleVector, tol decimal.Decimal) bool {
+	t.Helper()
+
+	cc := Candles()
+	if v.Indicator.Count() > len(cc) {
+		t.Errorf("%s: indicator requires %d candles, %d available", v.Name, v.Indicator.Count(), len(cc))
+		return false
+	}
+
+	res, err := v.Indicator.CalcCandles(cc[len(cc)-v.Indicator.Count():])
+	if err != nil {
+		t.Errorf("%s: %v", v.Name, err)
+		return false
+	}
+
+	if !AssertDecimal(t, v.Expected, res, tol) {
+		t.Errorf("%s: unexpected result", v.Name)
+		return false
+	}
+
+	return true
+}
+
+// AssertPairVector calculates the vector's indicator from the newest
+// close prices of the reference and benchmark series and checks whether
+// the result differs from the expected one by no more than the tolerance.
+func AssertPairVector(t TB, v PairVector, tol decimal.Decimal) bool {
+	t.Helper()
+
+	dd, bb := Closes(), Benchmark()
+	if v.Indicator.Count() > len(dd) {
+		t.Errorf("%s: indicator requires %d data points, %d available", v.Name, v.Indicator.Count(), len(dd))
+		return false
+	}
+
+	res, err := v.Indicator.CalcPair(dd[len(dd)-v.Indicator.Count():], bb[len(bb)-v.Indicator.Count():])
+	if err != nil {
+		t.Errorf("%s: %v", v.Name, err)
+		return false
+	}
+
+	if !AssertDecimal(t, v.Expected, res, tol) {
+		t.Errorf("%s: unexpected result", v.Name)
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,345 @@
+package indctest
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jellydator/indc"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recorder struct {
+	errs []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errs = append(r.errs, fmt.Sprintf(format, args...))
+}
+
+func Test_Candles(t *testing.T) {
+	cc := Candles()
+	require.Len(t, cc, 120)
+	assert.True(t, indc.CheckCandles(cc, 24*time.Hour).OK())
+
+	dd := Closes()
+	require.Len(t, dd, len(cc))
+
+	for i := range cc {
+		assert.Equal(t, cc[i].Close, dd[i])
+	}
+
+	assert.Len(t, Benchmark(), len(cc))
+}
+
+func Test_Vectors(t *testing.T) {
+	tol := decimal.New(1, -8)
+
+	for _, v := range Vectors() {
+		AssertVector(t, v, tol)
+	}
+}
+
+func Test_CandleVectors(t *testing.T) {
+	tol := decimal.New(1, -8)
+
+	for _, v := range CandleVectors() {
+		AssertCandleVector(t, v, tol)
+	}
+}
+
+func Test_PairVectors(t *testing.T) {
+	tol := decimal.New(1, -8)
+
+	for _, v := range PairVectors() {
+		AssertPairVector(t, v, tol)
+	}
+}
+
+func Test_ema(t *testing.T) {
+	assert.Panics(t, func() {
+		ema(0)
+	})
+
+	assert.Equal(t, 9, ema(5).Count())
+}
+
+func Test_rsi(t *testing.T) {
+	assert.Panics(t, func() {
+		rsi(0)
+	})
+
+	assert.Equal(t, 5, rsi(5).Count())
+}
+
+func Test_sma(t *testing.T) {
+	assert.Panics(t, func() {
+		sma(0)
+	})
+
+	assert.Equal(t, 5, sma(5).Count())
+}
+
+func Test_smma(t *testing.T) {
+	assert.Panics(t, func() {
+		smma(0, 1)
+	})
+
+	assert.Panics(t, func() {
+		smma(5, -1)
+	})
+
+	assert.Equal(t, 12, smma(5, 3).Count())
+}
+
+func Test_candleLine(t *testing.T) {
+	jaw := func(ll indc.AlligatorLines) decimal.Decimal { return ll.Jaw }
+
+	_, err := alligatorLine(jaw)(indc.NewAlligator(nil, nil, nil))
+	assert.Error(t, err)
+
+	ind, err := alligatorLine(jaw)(indc.NewAlligator(sma(3), sma(2), sma(1)))
+	require.NoError(t, err)
+	assert.Equal(t, 3, ind.Count())
+
+	_, err = ind.CalcCandles(Candles())
+	assert.Equal(t, indc.ErrInvalidDataSize, err)
+
+	res, err := ind.CalcCandles(Candles()[:3])
+	require.NoError(t, err)
+	assert.Equal(t, "106.795", res.String())
+}
+
+func Test_gatorLine(t *testing.T) {
+	upper := func(ll indc.GatorLines) decimal.Decimal { return ll.Upper }
+
+	_, err := gatorLine(upper)(indc.NewGator(nil, nil, nil))
+	assert.Error(t, err)
+
+	ind, err := gatorLine(upper)(indc.NewGator(sma(3), sma(2), sma(1)))
+	require.NoError(t, err)
+	assert.Equal(t, 3, ind.Count())
+
+	_, err = ind.CalcCandles(Candles())
+	assert.Equal(t, indc.ErrInvalidDataSize, err)
+}
+
+func Test_ichimokuLine(t *testing.T) {
+	tenkan := func(ll indc.IchimokuLines) decimal.Decimal { return ll.Tenkan }
+
+	_, err := ichimokuLine(tenkan)(indc.NewIchimoku(0, 1, 1))
+	assert.Error(t, err)
+
+	ind, err := ichimokuLine(tenkan)(indc.NewIchimoku(1, 1, 1))
+	require.NoError(t, err)
+	assert.Equal(t, 2, ind.Count())
+
+	_, err = ind.CalcCandles(Candles())
+	assert.Equal(t, indc.ErrInvalidDataSize, err)
+}
+
+func Test_pivotsLine(t *testing.T) {
+	pp := func(ll indc.PivotLevels) decimal.Decimal { return ll.PP }
+
+	_, err := pivotsLine(pp)(indc.NewPivots(0))
+	assert.Error(t, err)
+
+	ind, err := pivotsLine(pp)(indc.NewPivots(indc.PivotClassic))
+	require.NoError(t, err)
+	assert.Equal(t, 1, ind.Count())
+
+	_, err = ind.CalcCandles(Candles())
+	assert.Equal(t, indc.ErrInvalidDataSize, err)
+}
+
+func Test_profileLine(t *testing.T) {
+	poc := func(p indc.Profile) decimal.Decimal { return p.POC }
+
+	_, err := profileLine(poc)(indc.NewVolumeProfile(0, 1, decimal.Zero))
+	assert.Error(t, err)
+
+	ind, err := profileLine(poc)(indc.NewVolumeProfile(1, 1, decimal.Zero))
+	require.NoError(t, err)
+	assert.Equal(t, 1, ind.Count())
+
+	_, err = ind.CalcCandles(Candles())
+	assert.Equal(t, indc.ErrInvalidDataSize, err)
+}
+
+func Test_vector(t *testing.T) {
+	assert.Panics(t, func() {
+		vector("test", "TA-Lib SMA", "1")(indc.NewSMA(0))
+	})
+
+	v := vector("test", "TA-Lib SMA", "1")(indc.NewSMA(1))
+	assert.Equal(t, "test", v.Name)
+	assert.Equal(t, "1", v.Expected.String())
+	assert.Equal(t, "TA-Lib SMA", v.Reference)
+	assert.Equal(t, 1, v.Indicator.Count())
+}
+
+func Test_candleVector(t *testing.T) {
+	assert.Panics(t, func() {
+		candleVector("test", "TA-Lib ATR", "1")(indc.NewATR(0, indc.SmoothingSMA))
+	})
+
+	v := candleVector("test", "TA-Lib ATR", "1")(indc.NewATR(1, indc.SmoothingSMA))
+	assert.Equal(t, "test", v.Name)
+	assert.Equal(t, "1", v.Expected.String())
+	assert.Equal(t, "TA-Lib ATR", v.Reference)
+	assert.Equal(t, 2, v.Indicator.Count())
+}
+
+func Test_pairVector(t *testing.T) {
+	assert.Panics(t, func() {
+		pairVector("test", "", "1")(indc.NewBeta(0))
+	})
+
+	v := pairVector("test", "TA-Lib BETA", "1")(indc.NewBeta(2))
+	assert.Equal(t, "test", v.Name)
+	assert.Equal(t, "1", v.Expected.String())
+	assert.Equal(t, "TA-Lib BETA", v.Reference)
+	assert.Equal(t, 3, v.Indicator.Count())
+}
+
+func Test_AssertDecimal(t *testing.T) {
+	r := &recorder{}
+	assert.True(t, AssertDecimal(r, decimal.NewFromInt(1), decimal.RequireFromString("1.1"), decimal.RequireFromString("0.1")))
+	assert.Empty(t, r.errs)
+
+	assert.False(t, AssertDecimal(r, decimal.NewFromInt(1), decimal.RequireFromString("1.2"), decimal.RequireFromString("0.1")))
+	assert.Len(t, r.errs, 1)
+}
+
+func Test_AssertVector(t *testing.T) {
+	sma, err := indc.NewSMA(2)
+	require.NoError(t, err)
+
+	large, err := indc.NewSMA(200)
+	require.NoError(t, err)
+
+	cc := map[string]struct {
+		Vector Vector
+		Result bool
+		Errors int
+	}{
+		"Not enough data points": {
+			Vector: Vector{Name: "test", Indicator: large},
+			Errors: 1,
+		},
+		"Calculation returns an error": {
+			Vector: Vector{Name: "test", Indicator: indc.SMA{}},
+			Errors: 1,
+		},
+		"Unexpected result": {
+			Vector: Vector{Name: "test", Indicator: sma, Expected: decimal.NewFromInt(1)},
+			Errors: 2,
+		},
+		"Successful assertion": {
+			Vector: Vector{Name: "test", Indicator: sma, Expected: decimal.RequireFromString("102.195")},
+			Result: true,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			r := &recorder{}
+			assert.Equal(t, c.Result, AssertVector(r, c.Vector, decimal.Zero))
+			assert.Len(t, r.errs, c.Errors)
+		})
+	}
+}
+
+func Test_AssertCandleVector(t *testing.T) {
+	atr, err := indc.NewATR(1, indc.SmoothingSMA)
+	require.NoError(t, err)
+
+	large, err := indc.NewATR(200, indc.SmoothingSMA)
+	require.NoError(t, err)
+
+	cc := map[string]struct {
+		Vector CandleVector
+		Result bool
+		Errors int
+	}{
+		"Not enough candles": {
+			Vector: CandleVector{Name: "test", Indicator: large},
+			Errors: 1,
+		},
+		"Calculation returns an error": {
+			Vector: CandleVector{Name: "test", Indicator: indc.ATR{}},
+			Errors: 1,
+		},
+		"Unexpected result": {
+			Vector: CandleVector{Name: "test", Indicator: atr, Expected: decimal.NewFromInt(1)},
+			Errors: 2,
+		},
+		"Successful assertion": {
+			Vector: CandleVector{Name: "test", Indicator: atr, Expected: decimal.RequireFromString("2.17")},
+			Result: true,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			r := &recorder{}
+			assert.Equal(t, c.Result, AssertCandleVector(r, c.Vector, decimal.Zero))
+			assert.Len(t, r.errs, c.Errors)
+		})
+	}
+}
+
+func Test_AssertPairVector(t *testing.T) {
+	rsc, err := indc.NewRSC(2)
+	require.NoError(t, err)
+
+	large, err := indc.NewRSC(200)
+	require.NoError(t, err)
+
+	cc := map[string]struct {
+		Vector PairVector
+		Result bool
+		Errors int
+	}{
+		"Not enough data points": {
+			Vector: PairVector{Name: "test", Indicator: large},
+			Errors: 1,
+		},
+		"Calculation returns an error": {
+			Vector: PairVector{Name: "test", Indicator: indc.RSC{}},
+			Errors: 1,
+		},
+		"Unexpected result": {
+			Vector: PairVector{Name: "test", Indicator: rsc, Expected: decimal.NewFromInt(1)},
+			Errors: 2,
+		},
+		"Successful assertion": {
+			Vector: PairVector{Name: "test", Indicator: rsc, Expected: decimal.RequireFromString("0.3652358507537869")},
+			Result: true,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			r := &recorder{}
+			assert.Equal(t, c.Result, AssertPairVector(r, c.Vector, decimal.Zero))
+			assert.Len(t, r.errs, c.Errors)
+		})
+	}
+}
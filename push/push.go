@@ -0,0 +1,209 @@
+// Package push provides a server that feeds incoming data points into
+// indicator streams and pushes their values to the subscribed clients
+// using server-sent events.
+package push
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/jellydator/indc"
+	"github.com/shopspring/decimal"
+)
+
+// Value holds a freshly computed indicator value of a single symbol.
+type Value struct {
+	// Symbol specifies which symbol the value belongs to.
+	Symbol string `json:"symbol"`
+
+	// Value specifies the computed indicator value.
+	Value decimal.Decimal `json:"value"`
+}
+
+// Server keeps a stream per symbol, adds every new data point to it and
+// pushes the updated values to the subscribed clients. Every symbol is
+// guarded by its own lock, so data points of different symbols are
+// processed concurrently.
+// The zero value is not usable.
+type Server struct {
+	// newStream specifies the function that creates the stream of a new
+	// symbol.
+	newStream func() (indc.Stream, error)
+
+	// buffer specifies how many values can be queued for a single
+	// client before new values are dropped.
+	buffer int
+
+	// mu protects streams and subs.
+	mu sync.Mutex
+
+	// streams holds the stream of every symbol.
+	streams map[string]*symbolStream
+
+	// subs holds the channels of the subscribed clients of every
+	// symbol.
+	subs map[string]map[chan Value]struct{}
+}
+
+// symbolStream holds the stream of a single symbol.
+type symbolStream struct {
+	// mu protects stream and keeps the values of the symbol in order.
+	mu sync.Mutex
+
+	// stream specifies the symbol's indicator stream.
+	stream indc.Stream
+}
+
+// NewServer creates new server instance that computes values using
+// streams created by the provided function, e.g.
+// func() (indc.Stream, error) { return indc.NewEMAStream(20) }.
+// Buffer specifies how many values can be queued for a single slow
+// client before new values are dropped.
+func NewServer(newStream func() (indc.Stream, error), buffer int) (*Server, error) {
+	if newStream == nil {
+		return nil, indc.ErrInvalidIndicator
+	}
+
+	if buffer < 1 {
+		return nil, indc.ErrInvalidLength
+	}
+
+	return &Server{
+		newStream: newStream,
+		buffer:    buffer,
+		streams:   make(map[string]*symbolStream),
+		subs:      make(map[string]map[chan Value]struct{}),
+	}, nil
+}
+
+// Add adds the data point to the symbol's stream. Once the stream is
+// ready, its updated value is pushed to all clients subscribed to the
+// symbol.
+func (s *Server) Add(symbol string, d decimal.Decimal) error {
+	ss, err := s.stream(symbol)
+	if err != nil {
+		return err
+	}
+
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+
+	res := ss.stream.Add(d)
+	if !ss.stream.Ready() {
+		return nil
+	}
+
+	s.publish(Value{Symbol: symbol, Value: res})
+
+	return nil
+}
+
+// stream returns the stream of the symbol, creating it on the first
+// data point.
+func (s *Server) stream(symbol string) (*symbolStream, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if ss, ok := s.streams[symbol]; ok {
+		return ss, nil
+	}
+
+	st, err := s.newStream()
+	if err != nil {
+		return nil, err
+	}
+
+	ss := &symbolStream{stream: st}
+	s.streams[symbol] = ss
+
+	return ss, nil
+}
+
+// publish pushes the value to all clients subscribed to its symbol.
+func (s *Server) publish(v Value) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for ch := range s.subs[v.Symbol] {
+		select {
+		case ch <- v:
+		default:
+			// client is too slow, value is dropped.
+		}
+	}
+}
+
+// subscribe registers new client of the symbol.
+func (s *Server) subscribe(symbol string) chan Value {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ch := make(chan Value, s.buffer)
+
+	if s.subs[symbol] == nil {
+		s.subs[symbol] = make(map[chan Value]struct{})
+	}
+
+	s.subs[symbol][ch] = struct{}{}
+
+	return ch
+}
+
+// unsubscribe removes the client of the symbol.
+func (s *Server) unsubscribe(symbol string, ch chan Value) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.subs[symbol], ch)
+
+	if len(s.subs[symbol]) == 0 {
+		delete(s.subs, symbol)
+	}
+}
+
+// ServeHTTP subscribes the client to the symbol provided via the
+// "symbol" query parameter and streams computed values as server-sent
+// events until the client disconnects.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	symbol := r.URL.Query().Get("symbol")
+	if symbol == "" {
+		http.Error(w, "symbol is required", http.StatusBadRequest)
+		return
+	}
+
+	fl, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	ch := s.subscribe(symbol)
+	defer s.unsubscribe(symbol, ch)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	fl.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case v := <-ch:
+			d, err := json.Marshal(v)
+			if err != nil {
+				// unlikely to happen
+				return
+			}
+
+			if _, err = fmt.Fprintf(w, "data: %s\n\n", d); err != nil {
+				return
+			}
+
+			fl.Flush()
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package push
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jellydator/indc"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func smaStream(length int) func() (indc.Stream, error) {
+	return func() (indc.Stream, error) {
+		return indc.NewSMAStream(length)
+	}
+}
+
+func Test_NewServer(t *testing.T) {
+	_, err := NewServer(nil, 1)
+	assert.Equal(t, indc.ErrInvalidIndicator, err)
+
+	_, err = NewServer(smaStream(2), 0)
+	assert.Equal(t, indc.ErrInvalidLength, err)
+
+	s, err := NewServer(smaStream(2), 1)
+	require.NoError(t, err)
+	assert.NotNil(t, s.newStream)
+	assert.Equal(t, 1, s.buffer)
+	assert.NotNil(t, s.streams)
+	assert.NotNil(t, s.subs)
+}
+
+func Test_Server_Add(t *testing.T) {
+	s, err := NewServer(smaStream(0), 1)
+	require.NoError(t, err)
+	assert.ErrorIs(t, s.Add("a", decimal.NewFromInt(1)), indc.ErrInvalidLength)
+	assert.Empty(t, s.streams)
+
+	s, err = NewServer(smaStream(2), 1)
+	require.NoError(t, err)
+
+	ch := s.subscribe("a")
+	other := s.subscribe("b")
+
+	require.NoError(t, s.Add("a", decimal.NewFromInt(1)))
+	assert.Len(t, ch, 0)
+
+	require.NoError(t, s.Add("a", decimal.NewFromInt(3)))
+	require.NoError(t, s.Add("a", decimal.NewFromInt(5)))
+	assert.Len(t, s.streams, 1)
+	assert.Len(t, other, 0)
+	require.Len(t, ch, 1)
+
+	v := <-ch
+	assert.Equal(t, "a", v.Symbol)
+	assert.Equal(t, "2", v.Value.String())
+
+	require.NoError(t, s.Add("b", decimal.NewFromInt(4)))
+	assert.Len(t, s.streams, 2)
+	assert.Len(t, other, 0)
+
+	s.unsubscribe("a", ch)
+	s.unsubscribe("b", other)
+	assert.Empty(t, s.subs)
+}
+
+func Test_Server_Add_concurrent(t *testing.T) {
+	s, err := NewServer(smaStream(1), 100)
+	require.NoError(t, err)
+
+	ch := s.subscribe("a")
+
+	var wg sync.WaitGroup
+
+	for _, symbol := range []string{"a", "b", "c"} {
+		wg.Add(1)
+
+		go func(symbol string) {
+			defer wg.Done()
+
+			for i := 0; i < 100; i++ {
+				assert.NoError(t, s.Add(symbol, decimal.NewFromInt(int64(i))))
+			}
+		}(symbol)
+	}
+
+	wg.Wait()
+
+	require.Len(t, ch, 100)
+
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, int64(i), (<-ch).Value.IntPart())
+	}
+}
+
+type noFlushWriter struct {
+	http.ResponseWriter
+}
+
+func Test_Server_ServeHTTP(t *testing.T) {
+	s, err := NewServer(smaStream(1), 1)
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	s.ServeHTTP(noFlushWriter{rec}, httptest.NewRequest(http.MethodGet, "/?symbol=a", nil))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"?symbol=a", nil)
+	require.NoError(t, err)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	require.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		return len(s.subs["a"]) == 1
+	}, time.Second, time.Millisecond)
+
+	require.NoError(t, s.Add("a", decimal.NewFromInt(7)))
+
+	sc := bufio.NewScanner(resp.Body)
+	require.True(t, sc.Scan())
+	assert.Equal(t, `data: {"symbol":"a","value":"7"}`, sc.Text())
+
+	cancel()
+
+	assert.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		return len(s.subs) == 0
+	}, time.Second, time.Millisecond)
+}
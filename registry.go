@@ -0,0 +1,167 @@
+package indc
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"reflect"
+	"sync"
+
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// _registry holds the factories of custom indicators, so that they can be
+// encoded and decoded the same way as the built-in ones.
+var _registry = struct {
+	mu        sync.RWMutex
+	factories map[string]func() Indicator
+	names     map[reflect.Type]string
+}{
+	factories: make(map[string]func() Indicator),
+	names:     make(map[reflect.Type]string),
+}
+
+// Register makes a custom indicator available for encoding and decoding
+// under the provided name. Every name and indicator type can be
+// registered only once. The factory should return a pointer to a new
+// indicator instance, so that its configuration can be decoded into it.
+// The configuration itself is encoded by the indicator's exported fields
+// or custom marshaling methods of the used format. Decoded indicators
+// that implement Validator are validated before they are returned.
+func Register(name string, factory func() Indicator) error {
+	if name == "" || factory == nil {
+		return ErrInvalidIndicator
+	}
+
+	ind := factory()
+	if ind == nil {
+		return ErrInvalidIndicator
+	}
+
+	if _, err := newSpec(name); err == nil {
+		return ErrDuplicateIndicator
+	}
+
+	_registry.mu.Lock()
+	defer _registry.mu.Unlock()
+
+	t := reflect.TypeOf(ind)
+
+	if _, ok := _registry.factories[name]; ok {
+		return ErrDuplicateIndicator
+	}
+
+	if _, ok := _registry.names[t]; ok {
+		return ErrDuplicateIndicator
+	}
+
+	_registry.factories[name] = factory
+	_registry.names[t] = name
+
+	if t.Kind() == reflect.Ptr {
+		_registry.names[t.Elem()] = name
+	}
+
+	return nil
+}
+
+// Validator is implemented by custom indicators that can check whether
+// their decoded configuration is valid.
+type Validator interface {
+	// Validate checks whether the indicator has valid configuration
+	// properties.
+	Validate() error
+}
+
+// registeredSpec creates an empty spec of the custom indicator with the
+// provided name.
+func registeredSpec(name string) (spec, error) {
+	_registry.mu.RLock()
+	factory, ok := _registry.factories[name]
+	_registry.mu.RUnlock()
+
+	if !ok {
+		return nil, ErrUnknownIndicator
+	}
+
+	return &customSpec{n: name, ind: factory()}, nil
+}
+
+// registeredSpecOf extracts the spec of the provided custom indicator.
+func registeredSpecOf(v interface{}) (spec, error) {
+	ind, ok := v.(Indicator)
+	if !ok {
+		return nil, ErrUnknownIndicator
+	}
+
+	_registry.mu.RLock()
+	name, ok := _registry.names[reflect.TypeOf(v)]
+	_registry.mu.RUnlock()
+
+	if !ok {
+		return nil, ErrUnknownIndicator
+	}
+
+	return customSpec{n: name, ind: ind}, nil
+}
+
+// customSpec is the encodable configuration of a custom indicator.
+type customSpec struct {
+	// n specifies the name that the indicator was registered with.
+	n string
+
+	// ind specifies the indicator itself.
+	ind Indicator
+}
+
+// name returns the name of the custom indicator.
+func (s customSpec) name() string {
+	return s.n
+}
+
+// build validates the decoded custom indicator and returns it.
+func (s customSpec) build() (interface{}, error) {
+	if v, ok := s.ind.(Validator); ok {
+		if err := v.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.ind, nil
+}
+
+// GobEncode encodes the custom indicator into gob format.
+func (s customSpec) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := gob.NewEncoder(&buf).Encode(s.ind); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes the custom indicator from gob format.
+func (s *customSpec) GobDecode(d []byte) error {
+	return gob.NewDecoder(bytes.NewReader(d)).Decode(s.ind)
+}
+
+// EncodeMsgpack encodes the custom indicator into MessagePack format.
+func (s customSpec) EncodeMsgpack(enc *msgpack.Encoder) error {
+	return enc.Encode(s.ind)
+}
+
+// DecodeMsgpack decodes the custom indicator from MessagePack format.
+func (s *customSpec) DecodeMsgpack(dec *msgpack.Decoder) error {
+	return dec.Decode(s.ind)
+}
+
+// MarshalJSON encodes the custom indicator into JSON format.
+func (s customSpec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ind)
+}
+
+// UnmarshalJSON decodes the custom indicator from JSON format.
+func (s *customSpec) UnmarshalJSON(d []byte) error {
+	return json.Unmarshal(d, s.ind)
+}
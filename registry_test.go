@@ -0,0 +1,153 @@
+package indc
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// customIndicator is a third-party indicator used to check the registry.
+type customIndicator struct {
+	Length int `json:"length" msgpack:"length"`
+}
+
+// Calc returns the length of the indicator.
+func (ci customIndicator) Calc(_ []decimal.Decimal) (decimal.Decimal, error) {
+	return decimal.NewFromInt(int64(ci.Length)), nil
+}
+
+// Count returns the length of the indicator.
+func (ci customIndicator) Count() int {
+	return ci.Length
+}
+
+// Describe returns structured information about the indicator.
+func (ci customIndicator) Describe() Description {
+	return Description{Name: "custom", Input: InputClose}
+}
+
+// Validate checks whether the length of the indicator is valid.
+func (ci customIndicator) Validate() error {
+	if ci.Length < 1 {
+		return ErrInvalidLength
+	}
+
+	return nil
+}
+
+// otherCustomIndicator is a third-party indicator of a different type
+// used to check the registry.
+type otherCustomIndicator struct {
+	customIndicator
+}
+
+// resetRegistry removes all custom indicators once the test completes.
+func resetRegistry(t *testing.T) {
+	t.Helper()
+
+	t.Cleanup(func() {
+		_registry.mu.Lock()
+		defer _registry.mu.Unlock()
+
+		_registry.factories = make(map[string]func() Indicator)
+		_registry.names = make(map[reflect.Type]string)
+	})
+}
+
+func Test_Register(t *testing.T) {
+	resetRegistry(t)
+
+	factory := func() Indicator {
+		return &otherCustomIndicator{}
+	}
+
+	assert.Equal(t, ErrInvalidIndicator, Register("", factory))
+	assert.Equal(t, ErrInvalidIndicator, Register("custom_register", nil))
+	assert.Equal(t, ErrInvalidIndicator, Register("custom_register", func() Indicator {
+		return nil
+	}))
+	assert.Equal(t, ErrDuplicateIndicator, Register(NameSMA, factory))
+
+	require.NoError(t, Register("custom_register", factory))
+	assert.Equal(t, ErrDuplicateIndicator, Register("custom_register", func() Indicator {
+		return &customIndicator{}
+	}))
+	assert.Equal(t, ErrDuplicateIndicator, Register("custom_other", factory))
+
+	s, err := newSpec("custom_register")
+	require.NoError(t, err)
+	assert.Equal(t, "custom_register", s.name())
+
+	ind := otherCustomIndicator{customIndicator{Length: 2}}
+
+	s, err = specOf(ind)
+	require.NoError(t, err)
+	assert.Equal(t, customSpec{n: "custom_register", ind: ind}, s)
+
+	_, err = specOf(decimal.Zero)
+	assert.Equal(t, ErrUnknownIndicator, err)
+}
+
+func Test_customSpec(t *testing.T) {
+	resetRegistry(t)
+
+	require.NoError(t, Register("custom_encoding", func() Indicator {
+		return &customIndicator{}
+	}))
+
+	offset, err := NewOffset(&customIndicator{Length: 3}, 2)
+	require.NoError(t, err)
+
+	cc := map[string]struct {
+		Marshal   func(interface{}) ([]byte, error)
+		Unmarshal func([]byte, interface{}) error
+	}{
+		"Gob": {
+			Marshal:   MarshalGob,
+			Unmarshal: UnmarshalGob,
+		},
+		"Msgpack": {
+			Marshal:   MarshalMsgpack,
+			Unmarshal: UnmarshalMsgpack,
+		},
+		"JSON": {
+			Marshal:   MarshalJSON,
+			Unmarshal: UnmarshalJSON,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			for _, ind := range []interface{}{&customIndicator{Length: 3}, offset} {
+				d, err := c.Marshal(ind)
+				require.NoError(t, err)
+
+				var res interface{}
+				require.NoError(t, c.Unmarshal(d, &res))
+				assert.Equal(t, ind, res)
+			}
+		})
+	}
+
+	d, err := MarshalJSON(customIndicator{Length: 3})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"custom_encoding","length":3}`, string(d))
+
+	var ci customIndicator
+	require.NoError(t, UnmarshalJSON(d, &ci))
+	assert.Equal(t, customIndicator{Length: 3}, ci)
+
+	var cp *customIndicator
+	require.NoError(t, UnmarshalJSON(d, &cp))
+	assert.Equal(t, &customIndicator{Length: 3}, cp)
+
+	err = UnmarshalJSON([]byte(`{"name":"custom_encoding","length":0}`), &ci)
+	assert.Equal(t, &ConfigError{Indicator: "custom_encoding", Err: ErrInvalidLength}, err)
+}
@@ -0,0 +1,86 @@
+package indc
+
+import "github.com/shopspring/decimal"
+
+// Brick holds a single Renko brick.
+type Brick struct {
+	// Index specifies the position of the data point that completed the
+	// brick in the original series.
+	Index int `json:"index"`
+
+	// Open specifies the price at which the brick starts.
+	Open decimal.Decimal `json:"open"`
+
+	// Close specifies the price at which the brick ends.
+	Close decimal.Decimal `json:"close"`
+}
+
+// Candle converts the brick to a candle, so candle indicators can be
+// calculated from Renko bricks. The volume of the candle is zero.
+func (b Brick) Candle() Candle {
+	return Candle{
+		Open:  b.Open,
+		High:  decimal.Max(b.Open, b.Close),
+		Low:   decimal.Min(b.Open, b.Close),
+		Close: b.Close,
+	}
+}
+
+// Renko converts the series into Renko bricks of the provided size.
+// A new brick is added every time the price moves by the brick size
+// beyond the latest brick, while a reversal requires the price to move
+// by the brick size beyond the opposite end of the latest brick.
+// The first data point is used as the starting price.
+func Renko(dd []decimal.Decimal, size decimal.Decimal) ([]Brick, error) {
+	if size.LessThanOrEqual(decimal.Zero) {
+		return nil, ErrInvalidBrickSize
+	}
+
+	res := []Brick{}
+
+	if len(dd) == 0 {
+		return res, nil
+	}
+
+	top, bottom := dd[0], dd[0]
+
+	for i := 1; i < len(dd); i++ {
+		for dd[i].GreaterThanOrEqual(top.Add(size)) {
+			res = append(res, Brick{Index: i, Open: top, Close: top.Add(size)})
+			bottom, top = top, top.Add(size)
+		}
+
+		for dd[i].LessThanOrEqual(bottom.Sub(size)) {
+			res = append(res, Brick{Index: i, Open: bottom, Close: bottom.Sub(size)})
+			top, bottom = bottom, bottom.Sub(size)
+		}
+	}
+
+	return res, nil
+}
+
+// RenkoCandles converts the close prices of the candles into Renko bricks
+// of the provided size.
+func RenkoCandles(cc []Candle, size decimal.Decimal) ([]Brick, error) {
+	return Renko(closes(cc), size)
+}
+
+// RenkoATR converts the close prices of the candles into Renko bricks
+// whose size is the average true range of the newest candles.
+func RenkoATR(cc []Candle, atr ATR) ([]Brick, error) {
+	if !atr.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(cc) < atr.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	size, err := atr.CalcCandles(cc[len(cc)-atr.Count():])
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	return RenkoCandles(cc, size)
+}
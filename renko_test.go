@@ -0,0 +1,149 @@
+package indc
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// brick is a helper function that creates a brick from integers.
+func brick(index int, open, close int64) Brick {
+	return Brick{
+		Index: index,
+		Open:  decimal.NewFromInt(open),
+		Close: decimal.NewFromInt(close),
+	}
+}
+
+// assertEqualBricks checks that the bricks have equal indices and
+// equal prices, regardless of the prices' precision.
+func assertEqualBricks(t *testing.T, exp, res []Brick) {
+	t.Helper()
+
+	if !assert.Len(t, res, len(exp)) {
+		return
+	}
+
+	for i := range exp {
+		assert.Equal(t, exp[i].Index, res[i].Index)
+		assert.Equal(t, exp[i].Open.String(), res[i].Open.String())
+		assert.Equal(t, exp[i].Close.String(), res[i].Close.String())
+	}
+}
+
+func Test_Brick_Candle(t *testing.T) {
+	assert.Equal(t, Candle{
+		Open:  decimal.NewFromInt(3),
+		High:  decimal.NewFromInt(3),
+		Low:   decimal.NewFromInt(2),
+		Close: decimal.NewFromInt(2),
+	}, brick(1, 3, 2).Candle())
+
+	assert.Equal(t, Candle{
+		Open:  decimal.NewFromInt(2),
+		High:  decimal.NewFromInt(3),
+		Low:   decimal.NewFromInt(2),
+		Close: decimal.NewFromInt(3),
+	}, brick(1, 2, 3).Candle())
+}
+
+func Test_Renko(t *testing.T) {
+	cc := map[string]struct {
+		Data   []decimal.Decimal
+		Size   decimal.Decimal
+		Result []Brick
+		Error  error
+	}{
+		"Invalid brick size": {
+			Error: ErrInvalidBrickSize,
+		},
+		"Empty series": {
+			Size:   decimal.NewFromInt(1),
+			Result: []Brick{},
+		},
+		"Price does not move enough": {
+			Data:   series(10, 10, 11),
+			Size:   decimal.NewFromInt(2),
+			Result: []Brick{},
+		},
+		"Successful conversion": {
+			Data: series(10, 11, 13, 12, 11, 8),
+			Size: decimal.NewFromInt(1),
+			Result: []Brick{
+				brick(1, 10, 11),
+				brick(2, 11, 12),
+				brick(2, 12, 13),
+				brick(4, 12, 11),
+				brick(5, 11, 10),
+				brick(5, 10, 9),
+				brick(5, 9, 8),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := Renko(c.Data, c.Size)
+			assertEqualError(t, c.Error, err)
+			assertEqualBricks(t, c.Result, res)
+		})
+	}
+}
+
+func Test_RenkoCandles(t *testing.T) {
+	res, err := RenkoCandles(testCandles(t), decimal.NewFromInt(2))
+	assert.NoError(t, err)
+	assertEqualBricks(t, []Brick{
+		brick(2, 9, 11),
+		brick(4, 11, 13),
+	}, res)
+}
+
+func Test_RenkoATR(t *testing.T) {
+	atr := ATR{valid: true, length: 2, smoothing: SmoothingSMA}
+
+	cc := map[string]struct {
+		ATR     ATR
+		Candles []Candle
+		Result  []Brick
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			ATR:     atr,
+			Candles: testCandles(t)[:2],
+			Error:   ErrInvalidDataSize,
+		},
+		"Invalid brick size": {
+			ATR:     atr,
+			Candles: []Candle{{}, {}, {}},
+			Error:   ErrInvalidBrickSize,
+		},
+		"Successful conversion": {
+			ATR:     atr,
+			Candles: testCandles(t),
+			Result: []Brick{
+				brick(4, 9, 12),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := RenkoATR(c.Candles, c.ATR)
+			assertEqualError(t, c.Error, err)
+			assertEqualBricks(t, c.Result, res)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package indc
+
+import (
+	"math"
+
+	"github.com/shopspring/decimal"
+)
+
+// Series holds data points ordered from the oldest to the newest one.
+// It can be converted to and from []decimal.Decimal directly.
+type Series []decimal.Decimal
+
+// Map applies the function to every data point and returns the results
+// as a new series.
+func (s Series) Map(fn func(decimal.Decimal) decimal.Decimal) Series {
+	res := make(Series, len(s))
+
+	for i := range s {
+		res[i] = fn(s[i])
+	}
+
+	return res
+}
+
+// Window returns all consecutive sub-series of the provided length,
+// starting with the oldest one. The returned sub-series share memory with
+// the original series.
+func (s Series) Window(length int) ([]Series, error) {
+	if length < 1 {
+		return nil, ErrInvalidLength
+	}
+
+	if len(s) < length {
+		return []Series{}, nil
+	}
+
+	res := make([]Series, len(s)-length+1)
+
+	for i := range res {
+		res[i] = s[i : i+length]
+	}
+
+	return res, nil
+}
+
+// Shift moves every data point by n positions and returns the results as
+// a new series of the same length. Positive n moves data points towards
+// the end of the series, negative n towards the beginning. Positions
+// without data are filled with the provided fill value.
+func (s Series) Shift(n int, fill decimal.Decimal) Series {
+	res := make(Series, len(s))
+
+	for i := range res {
+		j := i - n
+		if j < 0 || j >= len(s) {
+			res[i] = fill
+			continue
+		}
+
+		res[i] = s[j]
+	}
+
+	return res
+}
+
+// Diff returns differences between every two consecutive data points.
+// The returned series is one data point shorter than the original one.
+func (s Series) Diff() Series {
+	if len(s) < 2 {
+		return Series{}
+	}
+
+	res := make(Series, len(s)-1)
+
+	for i := 1; i < len(s); i++ {
+		res[i-1] = s[i].Sub(s[i-1])
+	}
+
+	return res
+}
+
+// CumSum returns the cumulative sum of the data points.
+func (s Series) CumSum() Series {
+	res := make(Series, len(s))
+	sum := decimal.Zero
+
+	for i := range s {
+		sum = sum.Add(s[i])
+		res[i] = sum
+	}
+
+	return res
+}
+
+// CalcAll slides the indicator across the data points and returns the
+// value of every consecutive window, starting with the oldest one. The
+// returned series is ind.Count()-1 data points shorter than the original
+// one.
+func CalcAll(ind Indicator, dd []decimal.Decimal) (Series, error) {
+	if ind == nil {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < ind.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	ww, err := Series(dd).Window(ind.Count())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(Series, len(ww))
+
+	for i := range ww {
+		res[i], err = ind.Calc(ww[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// SeriesFromFloats converts float64 data points into a series.
+// ErrInvalidValue is returned when any of the data points is NaN or
+// infinite, since they have no decimal representation.
+func SeriesFromFloats(ff []float64) (Series, error) {
+	res := make(Series, len(ff))
+
+	for i := range ff {
+		if math.IsNaN(ff[i]) || math.IsInf(ff[i], 0) {
+			return nil, ErrInvalidValue
+		}
+
+		res[i] = decimal.NewFromFloat(ff[i])
+	}
+
+	return res, nil
+}
+
+// Floats converts the data points into float64 values. Precision may be
+// lost during the conversion.
+func (s Series) Floats() []float64 {
+	res := make([]float64, len(s))
+
+	for i := range s {
+		res[i], _ = s[i].Float64()
+	}
+
+	return res
+}
+
+// CalcFloat calculates the indicator from the provided float64 data
+// points and returns the result as float64. It is a convenience wrapper
+// for callers that don't need exact decimal arithmetic at the edges, the
+// calculation itself is still performed with decimals.
+func CalcFloat(ind Indicator, ff []float64) (float64, error) {
+	if ind == nil {
+		return 0, ErrInvalidIndicator
+	}
+
+	dd, err := SeriesFromFloats(ff)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := ind.Calc(dd)
+	if err != nil {
+		return 0, err
+	}
+
+	f, _ := res.Float64()
+
+	return f, nil
+}
+
+// CalcAllFloat works the same way as CalcAll, but accepts and returns
+// float64 data points.
+func CalcAllFloat(ind Indicator, ff []float64) ([]float64, error) {
+	dd, err := SeriesFromFloats(ff)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := CalcAll(ind, dd)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Floats(), nil
+}
@@ -0,0 +1,227 @@
+package indc
+
+import (
+	"math"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// series is a helper function that creates a series from integers.
+func series(vv ...int64) Series {
+	res := make(Series, len(vv))
+
+	for i := range vv {
+		res[i] = decimal.NewFromInt(vv[i])
+	}
+
+	return res
+}
+
+func Test_Series_Map(t *testing.T) {
+	assert.Equal(t, series(2, 4, 6), series(1, 2, 3).Map(func(d decimal.Decimal) decimal.Decimal {
+		return d.Mul(decimal.NewFromInt(2))
+	}))
+}
+
+func Test_Series_Window(t *testing.T) {
+	cc := map[string]struct {
+		Series Series
+		Length int
+		Result []Series
+		Error  error
+	}{
+		"Invalid length": {
+			Error: ErrInvalidLength,
+		},
+		"Series is too short": {
+			Series: series(1, 2),
+			Length: 3,
+			Result: []Series{},
+		},
+		"Successfully created windows": {
+			Series: series(1, 2, 3, 4),
+			Length: 3,
+			Result: []Series{
+				series(1, 2, 3),
+				series(2, 3, 4),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Series.Window(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Series_Shift(t *testing.T) {
+	cc := map[string]struct {
+		Series Series
+		N      int
+		Result Series
+	}{
+		"No shift": {
+			Series: series(1, 2, 3),
+			Result: series(1, 2, 3),
+		},
+		"Shift towards the end": {
+			Series: series(1, 2, 3),
+			N:      2,
+			Result: series(0, 0, 1),
+		},
+		"Shift towards the beginning": {
+			Series: series(1, 2, 3),
+			N:      -1,
+			Result: series(2, 3, 0),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, c.Result, c.Series.Shift(c.N, decimal.NewFromInt(0)))
+		})
+	}
+}
+
+func Test_Series_Diff(t *testing.T) {
+	assert.Equal(t, Series{}, series(1).Diff())
+	assert.Equal(t, series(2, -1), series(1, 3, 2).Diff())
+}
+
+func Test_Series_CumSum(t *testing.T) {
+	assert.Equal(t, Series{}, Series{}.CumSum())
+	assert.Equal(t, series(1, 4, 6), series(1, 3, 2).CumSum())
+}
+
+func Test_CalcAll(t *testing.T) {
+	cc := map[string]struct {
+		Indicator Indicator
+		Data      []decimal.Decimal
+		Result    []string
+		Error     error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			Indicator: SMA{valid: true, length: 3},
+			Data:      series(1, 2),
+			Error:     ErrInvalidDataSize,
+		},
+		"Indicator calculation error": {
+			Indicator: SMA{length: 3},
+			Data:      series(1, 2, 3),
+			Error:     ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			Indicator: SMA{valid: true, length: 3},
+			Data:      series(3, 6, 9, 3, 6, 12),
+			Result:    []string{"6", "6", "6", "7"},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := CalcAll(c.Indicator, c.Data)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, decimalStrings(res))
+		})
+	}
+}
+
+func Test_SeriesFromFloats(t *testing.T) {
+	res, err := SeriesFromFloats([]float64{1.5, -2, 0})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1.5", "-2", "0"}, decimalStrings(res))
+
+	res, err = SeriesFromFloats(nil)
+	assert.NoError(t, err)
+	assert.Empty(t, res)
+
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		res, err = SeriesFromFloats([]float64{1, f})
+		assert.Equal(t, ErrInvalidValue, err)
+		assert.Nil(t, res)
+	}
+}
+
+func Test_Series_Floats(t *testing.T) {
+	assert.Equal(t, []float64{1.5, -2, 0}, Series{
+		decimal.RequireFromString("1.5"),
+		decimal.NewFromInt(-2),
+		decimal.Zero,
+	}.Floats())
+}
+
+func Test_CalcFloat(t *testing.T) {
+	cc := map[string]struct {
+		Indicator Indicator
+		Data      []float64
+		Result    float64
+		Error     error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid value": {
+			Indicator: SMA{valid: true, length: 2},
+			Data:      []float64{1, math.NaN()},
+			Error:     ErrInvalidValue,
+		},
+		"Indicator calculation error": {
+			Indicator: SMA{valid: true, length: 3},
+			Data:      []float64{1, 2},
+			Error:     ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			Indicator: SMA{valid: true, length: 4},
+			Data:      []float64{1.5, 2.5, 3, 4},
+			Result:    2.75,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := CalcFloat(c.Indicator, c.Data)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_CalcAllFloat(t *testing.T) {
+	_, err := CalcAllFloat(nil, nil)
+	assert.Equal(t, ErrInvalidIndicator, err)
+
+	_, err = CalcAllFloat(SMA{valid: true, length: 2}, []float64{1, math.Inf(1), 4})
+	assert.Equal(t, ErrInvalidValue, err)
+
+	res, err := CalcAllFloat(SMA{valid: true, length: 2}, []float64{1, 2, 4, 8})
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{1.5, 3, 6}, res)
+}
@@ -0,0 +1,526 @@
+package indc
+
+import "github.com/shopspring/decimal"
+
+// Stream is an interface that every incremental indicator should
+// implement. Streams keep their own state, so every added data point is
+// processed in constant time instead of recalculating the whole window.
+// The state of the built-in streams can be persisted with MarshalJSON,
+// MarshalGob or MarshalMsgpack and restored with their counterparts.
+type Stream interface {
+	// Add should add the next data point to the stream and return the
+	// updated value.
+	Add(decimal.Decimal) decimal.Decimal
+
+	// Value should return the current value of the stream.
+	Value() decimal.Decimal
+
+	// Ready should report whether enough data points were added for
+	// the value to be meaningful.
+	Ready() bool
+
+	// Reset should clear the stream's state while keeping its
+	// configuration.
+	Reset()
+}
+
+// CandleStream is an interface that every incremental candle indicator
+// should implement. Streams keep their own state, so every added candle
+// is processed in constant time instead of recalculating the whole
+// window. The state of the built-in candle streams can be persisted the
+// same way as the state of Stream.
+type CandleStream interface {
+	// Add should add the next candle to the stream and return the
+	// updated value.
+	Add(Candle) decimal.Decimal
+
+	// Value should return the current value of the stream.
+	Value() decimal.Decimal
+
+	// Ready should report whether enough candles were added for the
+	// value to be meaningful.
+	Ready() bool
+
+	// Reset should clear the stream's state while keeping its
+	// configuration.
+	Reset()
+}
+
+// ring holds the newest data points of a fixed size window.
+type ring struct {
+	// vv specifies the data points of the window.
+	vv []decimal.Decimal
+
+	// next specifies the position that will be overwritten by the next
+	// data point.
+	next int
+
+	// full specifies whether the window is filled.
+	full bool
+}
+
+// newRing creates a new ring of the provided size.
+func newRing(size int) ring {
+	return ring{vv: make([]decimal.Decimal, size)}
+}
+
+// push adds the data point to the window and returns the data point that
+// was evicted from it, if the window was already filled.
+func (r *ring) push(d decimal.Decimal) (decimal.Decimal, bool) {
+	if len(r.vv) == 0 {
+		return d, true
+	}
+
+	old, evicted := r.vv[r.next], r.full
+	r.vv[r.next] = d
+	r.next++
+
+	if r.next == len(r.vv) {
+		r.next = 0
+		r.full = true
+	}
+
+	return old, evicted
+}
+
+// values returns the data points of the window from the oldest to the
+// newest one.
+func (r ring) values() []decimal.Decimal {
+	if !r.full {
+		return append([]decimal.Decimal(nil), r.vv[:r.next]...)
+	}
+
+	return append(append([]decimal.Decimal(nil), r.vv[r.next:]...), r.vv[:r.next]...)
+}
+
+// reset clears all data points of the window.
+func (r *ring) reset() {
+	*r = newRing(len(r.vv))
+}
+
+// SMAStream calculates simple moving average one data point at a time.
+type SMAStream struct {
+	// window specifies the newest data points.
+	window ring
+
+	// sum specifies the sum of the window's data points.
+	sum decimal.Decimal
+
+	// value specifies the current moving average.
+	value decimal.Decimal
+}
+
+// NewSMAStream validates provided configuration options and
+// creates new SMAStream instance.
+func NewSMAStream(length int) (*SMAStream, error) {
+	if _, err := NewSMA(length); err != nil {
+		return nil, fieldError(err, NameSMAStream, "")
+	}
+
+	return &SMAStream{
+		window: newRing(length),
+	}, nil
+}
+
+// Add adds the next data point to the stream and returns the updated
+// moving average. The value stays zero until the window is filled.
+func (s *SMAStream) Add(d decimal.Decimal) decimal.Decimal {
+	old, evicted := s.window.push(d)
+	if evicted {
+		s.sum = s.sum.Sub(old)
+	}
+
+	s.sum = s.sum.Add(d)
+
+	if s.window.full {
+		s.value = s.sum.Div(decimal.NewFromInt(int64(len(s.window.vv))))
+	}
+
+	return s.value
+}
+
+// Value returns the current moving average.
+func (s *SMAStream) Value() decimal.Decimal {
+	return s.value
+}
+
+// Ready checks whether the window is filled.
+func (s *SMAStream) Ready() bool {
+	return s.window.full
+}
+
+// Reset clears the stream's state.
+func (s *SMAStream) Reset() {
+	s.window.reset()
+	s.sum = decimal.Zero
+	s.value = decimal.Zero
+}
+
+// EMAStream calculates exponential moving average one data point at a
+// time. It is seeded with the simple average of the first data points,
+// just like EMA.
+type EMAStream struct {
+	// sma specifies the stream that seeds the moving average.
+	sma SMAStream
+
+	// multiplier specifies the weight of the newest data point.
+	multiplier decimal.Decimal
+
+	// value specifies the current moving average.
+	value decimal.Decimal
+}
+
+// NewEMAStream validates provided configuration options and
+// creates new EMAStream instance.
+func NewEMAStream(length int) (*EMAStream, error) {
+	ema, err := NewEMA(length)
+	if err != nil {
+		return nil, fieldError(err, NameEMAStream, "")
+	}
+
+	return &EMAStream{
+		sma:        SMAStream{window: newRing(length)},
+		multiplier: ema.multiplier(),
+	}, nil
+}
+
+// Add adds the next data point to the stream and returns the updated
+// moving average. The value stays zero until the seeding window is
+// filled.
+func (s *EMAStream) Add(d decimal.Decimal) decimal.Decimal {
+	if s.sma.Ready() {
+		s.value = d.Mul(s.multiplier).Add(s.value.Mul(_one.Sub(s.multiplier)))
+		return s.value
+	}
+
+	s.value = s.sma.Add(d)
+
+	return s.value
+}
+
+// Value returns the current moving average.
+func (s *EMAStream) Value() decimal.Decimal {
+	return s.value
+}
+
+// Ready checks whether the seeding window is filled.
+func (s *EMAStream) Ready() bool {
+	return s.sma.Ready()
+}
+
+// Reset clears the stream's state.
+func (s *EMAStream) Reset() {
+	s.sma.Reset()
+	s.value = decimal.Zero
+}
+
+// RSIStream calculates relative strength index one data point at a time.
+// With SmoothingSMA the value matches RSI calculated from the newest data
+// points. With exponential smoothing the averages are seeded the same way
+// as RSI and then keep smoothing recursively over the whole history, which
+// matches the canonical RSI of TradingView and TA-Lib.
+type RSIStream struct {
+	// rsi specifies the configuration of the stream.
+	rsi RSI
+
+	// count specifies how many data points were added, up to the
+	// amount needed for RSI calculation.
+	count int
+
+	// prev specifies the latest added data point.
+	prev decimal.Decimal
+
+	// changes specifies the newest changes between consecutive data
+	// points. It is only used by SmoothingSMA.
+	changes ring
+
+	// multiplier specifies the weight of the newest change. It is only
+	// used by exponential smoothing.
+	multiplier decimal.Decimal
+
+	// up specifies the sum of the window's increases or, once seeded,
+	// their exponential average.
+	up decimal.Decimal
+
+	// down specifies the sum of the window's absolute decreases or, once
+	// seeded, their exponential average.
+	down decimal.Decimal
+
+	// value specifies the current relative strength index.
+	value decimal.Decimal
+}
+
+// NewRSIStream validates provided configuration options and
+// creates new RSIStream instance.
+func NewRSIStream(length int, smoothing Smoothing) (*RSIStream, error) {
+	rsi, err := NewRSI(length, smoothing)
+	if err != nil {
+		return nil, fieldError(err, NameRSIStream, "")
+	}
+
+	s := &RSIStream{
+		rsi: rsi,
+	}
+
+	if smoothing == SmoothingSMA {
+		s.changes = newRing(length - 1)
+	} else {
+		s.multiplier = smoothing.multiplier(length)
+	}
+
+	return s, nil
+}
+
+// Add adds the next data point to the stream and returns the updated
+// relative strength index. The value stays zero until enough data points
+// are added.
+func (s *RSIStream) Add(d decimal.Decimal) decimal.Decimal {
+	if s.count > 0 {
+		diff := d.Sub(s.prev)
+		up, down := decimal.Max(diff, decimal.Zero), decimal.Min(diff, decimal.Zero).Abs()
+
+		if s.rsi.smoothing == SmoothingSMA {
+			s.addWindow(diff, up, down)
+		} else {
+			s.addSmoothed(up, down)
+		}
+	}
+
+	s.prev = d
+
+	if s.count < s.rsi.Count() {
+		s.count++
+	}
+
+	if !s.Ready() {
+		return s.value
+	}
+
+	s.value = rsiValue(s.up, s.down)
+
+	return s.value
+}
+
+// addWindow adds the change to the sums of the window and removes the
+// evicted change from them.
+func (s *RSIStream) addWindow(diff, up, down decimal.Decimal) {
+	if old, evicted := s.changes.push(diff); evicted {
+		s.up = s.up.Sub(decimal.Max(old, decimal.Zero))
+		s.down = s.down.Sub(decimal.Min(old, decimal.Zero).Abs())
+	}
+
+	s.up = s.up.Add(up)
+	s.down = s.down.Add(down)
+}
+
+// addSmoothed adds the change to the exponential averages. The first
+// length changes are summed up and averaged to seed them.
+func (s *RSIStream) addSmoothed(up, down decimal.Decimal) {
+	switch n := s.count; {
+	case n < s.rsi.length:
+		s.up = s.up.Add(up)
+		s.down = s.down.Add(down)
+	case n == s.rsi.length:
+		length := decimal.NewFromInt(int64(s.rsi.length))
+
+		s.up = s.up.Add(up).Div(length)
+		s.down = s.down.Add(down).Div(length)
+	default:
+		s.up = up.Mul(s.multiplier).Add(s.up.Mul(_one.Sub(s.multiplier)))
+		s.down = down.Mul(s.multiplier).Add(s.down.Mul(_one.Sub(s.multiplier)))
+	}
+}
+
+// Value returns the current relative strength index.
+func (s *RSIStream) Value() decimal.Decimal {
+	return s.value
+}
+
+// Ready checks whether enough data points were added to calculate RSI.
+func (s *RSIStream) Ready() bool {
+	return s.count == s.rsi.Count()
+}
+
+// Reset clears the stream's state.
+func (s *RSIStream) Reset() {
+	s.count = 0
+	s.prev = decimal.Zero
+	s.changes.reset()
+	s.up = decimal.Zero
+	s.down = decimal.Zero
+	s.value = decimal.Zero
+}
+
+// StochStream calculates stochastic oscillator one data point at a time.
+// The value matches Stoch calculated from the newest data points.
+type StochStream struct {
+	// high specifies the highest data point of the window.
+	high extreme
+
+	// low specifies the lowest data point of the window.
+	low extreme
+
+	// value specifies the current stochastic oscillator value.
+	value decimal.Decimal
+}
+
+// NewStochStream validates provided configuration options and
+// creates new StochStream instance.
+func NewStochStream(length int) (*StochStream, error) {
+	if _, err := NewStoch(length); err != nil {
+		return nil, fieldError(err, NameStochStream, "")
+	}
+
+	return &StochStream{
+		high: extreme{
+			length:    length,
+			dominates: decimal.Decimal.GreaterThanOrEqual,
+		},
+		low: extreme{
+			length:    length,
+			dominates: decimal.Decimal.LessThanOrEqual,
+		},
+	}, nil
+}
+
+// Add adds the next data point to the stream and returns the updated
+// stochastic oscillator value. The value stays zero until the window is
+// filled.
+func (s *StochStream) Add(d decimal.Decimal) decimal.Decimal {
+	high := s.high.push(d)
+	low := s.low.push(d)
+
+	if !s.Ready() {
+		return s.value
+	}
+
+	s.value = decimal.Zero
+
+	if dnm := high.Sub(low); !dnm.Equal(decimal.Zero) {
+		s.value = d.Sub(low).Div(dnm).Mul(_hundred)
+	}
+
+	return s.value
+}
+
+// Value returns the current stochastic oscillator value.
+func (s *StochStream) Value() decimal.Decimal {
+	return s.value
+}
+
+// Ready checks whether the window is filled.
+func (s *StochStream) Ready() bool {
+	return s.high.count >= s.high.length
+}
+
+// Reset clears the stream's state.
+func (s *StochStream) Reset() {
+	s.high.reset()
+	s.low.reset()
+	s.value = decimal.Zero
+}
+
+// NVIStream accumulates negative volume index one candle at a time, which
+// makes it suitable for unbounded candle streams.
+// The zero value is ready to use.
+type NVIStream struct {
+	// vi specifies the accumulated index.
+	vi volumeIndex
+}
+
+// Add adds the next candle to the stream and returns the updated
+// negative volume index. The first candle starts the index at 1000.
+func (s *NVIStream) Add(c Candle) decimal.Decimal {
+	return s.vi.add(c, false)
+}
+
+// Value returns the current negative volume index.
+func (s *NVIStream) Value() decimal.Decimal {
+	return s.vi.value
+}
+
+// Ready checks whether at least one candle was added.
+func (s *NVIStream) Ready() bool {
+	return s.vi.started
+}
+
+// Reset clears the stream's state.
+func (s *NVIStream) Reset() {
+	*s = NVIStream{}
+}
+
+// OBVStream accumulates on-balance volume one candle at a time, which
+// makes it suitable for unbounded candle streams.
+// The zero value is ready to use.
+type OBVStream struct {
+	// started specifies whether at least one candle was added.
+	started bool
+
+	// prev specifies the close price of the latest added candle.
+	prev decimal.Decimal
+
+	// value specifies the accumulated on-balance volume.
+	value decimal.Decimal
+}
+
+// Add adds the next candle to the stream and returns the updated
+// on-balance volume. The first candle only sets the reference close price.
+func (s *OBVStream) Add(c Candle) decimal.Decimal {
+	if s.started {
+		switch {
+		case c.Close.GreaterThan(s.prev):
+			s.value = s.value.Add(c.Volume)
+		case c.Close.LessThan(s.prev):
+			s.value = s.value.Sub(c.Volume)
+		}
+	}
+
+	s.started = true
+	s.prev = c.Close
+
+	return s.value
+}
+
+// Value returns the current on-balance volume.
+func (s *OBVStream) Value() decimal.Decimal {
+	return s.value
+}
+
+// Ready checks whether at least one candle was added.
+func (s *OBVStream) Ready() bool {
+	return s.started
+}
+
+// Reset clears the stream's state.
+func (s *OBVStream) Reset() {
+	*s = OBVStream{}
+}
+
+// PVIStream accumulates positive volume index one candle at a time, which
+// makes it suitable for unbounded candle streams.
+// The zero value is ready to use.
+type PVIStream struct {
+	// vi specifies the accumulated index.
+	vi volumeIndex
+}
+
+// Add adds the next candle to the stream and returns the updated
+// positive volume index. The first candle starts the index at 1000.
+func (s *PVIStream) Add(c Candle) decimal.Decimal {
+	return s.vi.add(c, true)
+}
+
+// Value returns the current positive volume index.
+func (s *PVIStream) Value() decimal.Decimal {
+	return s.vi.value
+}
+
+// Ready checks whether at least one candle was added.
+func (s *PVIStream) Ready() bool {
+	return s.vi.started
+}
+
+// Reset clears the stream's state.
+func (s *PVIStream) Reset() {
+	*s = PVIStream{}
+}
@@ -0,0 +1,324 @@
+package indc
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// assertStream checks that the stream's values match the indicator's
+// values calculated from the newest data points, once the stream is
+// ready.
+func assertStream(t *testing.T, stream Stream, ind Indicator, dd []decimal.Decimal) {
+	t.Helper()
+
+	for i := range dd {
+		res := stream.Add(dd[i])
+		assert.Equal(t, res, stream.Value())
+
+		if i+1 < ind.Count() {
+			assert.False(t, stream.Ready())
+			assert.True(t, res.Equal(decimal.Zero))
+
+			continue
+		}
+
+		assert.True(t, stream.Ready())
+
+		exp, err := ind.Calc(dd[i+1-ind.Count() : i+1])
+		require.NoError(t, err)
+		assert.Equal(t, exp.Round(8).String(), res.Round(8).String())
+	}
+
+	stream.Reset()
+	assert.False(t, stream.Ready())
+	assert.True(t, stream.Value().Equal(decimal.Zero))
+}
+
+func Test_ring(t *testing.T) {
+	r := newRing(2)
+
+	_, evicted := r.push(decimal.NewFromInt(1))
+	assert.False(t, evicted)
+
+	_, evicted = r.push(decimal.NewFromInt(2))
+	assert.False(t, evicted)
+	assert.True(t, r.full)
+
+	old, evicted := r.push(decimal.NewFromInt(3))
+	assert.True(t, evicted)
+	assert.Equal(t, "1", old.String())
+
+	assert.Equal(t, []string{"2", "3"}, decimalStrings(r.values()))
+
+	r.reset()
+	assert.Equal(t, newRing(2), r)
+	assert.Empty(t, r.values())
+
+	r.push(decimal.NewFromInt(4))
+	assert.Equal(t, []string{"4"}, decimalStrings(r.values()))
+
+	r = newRing(0)
+	old, evicted = r.push(decimal.NewFromInt(3))
+	assert.True(t, evicted)
+	assert.Equal(t, "3", old.String())
+}
+
+func Test_NewSMAStream(t *testing.T) {
+	_, err := NewSMAStream(0)
+	assert.Equal(t, &ConfigError{
+		Indicator: NameSMAStream,
+		Field:     "length",
+		Value:     0,
+		Err:       ErrInvalidLength,
+	}, err)
+
+	stream, err := NewSMAStream(3)
+	require.NoError(t, err)
+
+	assertStream(t, stream, SMA{valid: true, length: 3}, series(3, 5, 4, 8, 6, 7, 2, 9))
+}
+
+func Test_NewEMAStream(t *testing.T) {
+	_, err := NewEMAStream(0)
+	assert.Equal(t, &ConfigError{
+		Indicator: NameEMAStream,
+		Field:     "length",
+		Value:     0,
+		Err:       ErrInvalidLength,
+	}, err)
+
+	stream, err := NewEMAStream(3)
+	require.NoError(t, err)
+
+	dd := series(3, 5, 4, 8, 6, 7, 2, 9)
+	ema := EMA{valid: true, sma: SMA{valid: true, length: 3}}
+
+	for i := range dd {
+		res := stream.Add(dd[i])
+		assert.Equal(t, res, stream.Value())
+		assert.Equal(t, i >= 2, stream.Ready())
+
+		if i < 2 {
+			assert.True(t, res.Equal(decimal.Zero))
+		}
+	}
+
+	exp, err := ema.Calc(dd[:ema.Count()])
+	require.NoError(t, err)
+
+	stream.Reset()
+
+	for _, d := range dd[:ema.Count()] {
+		stream.Add(d)
+	}
+
+	assert.Equal(t, exp.Round(8).String(), stream.Value().Round(8).String())
+
+	stream.Reset()
+	assert.False(t, stream.Ready())
+	assert.True(t, stream.Value().Equal(decimal.Zero))
+}
+
+func Test_NewRSIStream(t *testing.T) {
+	_, err := NewRSIStream(0, SmoothingSMA)
+	assert.Equal(t, &ConfigError{
+		Indicator: NameRSIStream,
+		Field:     "length",
+		Value:     0,
+		Err:       ErrInvalidLength,
+	}, err)
+
+	_, err = NewRSIStream(2, 70)
+	assert.Equal(t, &ConfigError{
+		Indicator: NameRSIStream,
+		Field:     "smoothing",
+		Value:     Smoothing(70),
+		Err:       ErrInvalidSmoothing,
+	}, err)
+
+	stream, err := NewRSIStream(1, SmoothingSMA)
+	require.NoError(t, err)
+
+	assertStream(t, stream, RSI{valid: true, length: 1, smoothing: SmoothingSMA}, series(3, 5, 4))
+
+	stream, err = NewRSIStream(4, SmoothingSMA)
+	require.NoError(t, err)
+
+	assertStream(t, stream, RSI{valid: true, length: 4, smoothing: SmoothingSMA}, series(3, 5, 4, 8, 6, 7, 2, 9, 9, 10, 11, 12))
+
+	stream, err = NewRSIStream(3, SmoothingSMA)
+	require.NoError(t, err)
+
+	flat := RSI{valid: true, length: 3, smoothing: SmoothingSMA}
+	dd := series(7, 7, 7, 7, 8, 8, 8)
+
+	assertStream(t, stream, flat, dd)
+
+	for i := range dd[:flat.Count()] {
+		stream.Add(dd[i])
+	}
+
+	exp, err := flat.Calc(dd[:flat.Count()])
+	require.NoError(t, err)
+	assert.Equal(t, "50", exp.String())
+	assert.Equal(t, exp.String(), stream.Value().String())
+
+	stream, err = NewRSIStream(2, SmoothingWilder)
+	require.NoError(t, err)
+
+	rsi := RSI{valid: true, length: 2, smoothing: SmoothingWilder}
+	dd = series(3, 5, 4, 8, 6, 7)
+	res := make([]decimal.Decimal, 0, len(dd))
+
+	for i := range dd {
+		res = append(res, stream.Add(dd[i]))
+		assert.Equal(t, i+1 >= rsi.Count(), stream.Ready())
+	}
+
+	exp, err = rsi.Calc(dd[:rsi.Count()])
+	require.NoError(t, err)
+	assert.Equal(t, exp.Round(8).String(), res[rsi.Count()-1].Round(8).String())
+
+	rr := make([]string, len(res))
+
+	for i := range res {
+		rr[i] = res[i].Round(8).String()
+	}
+
+	// the averages keep smoothing recursively instead of being reseeded.
+	assert.Equal(t, []string{"0", "0", "0", "90.90909091", "52.63157895", "66.66666667"}, rr)
+
+	stream.Reset()
+	assert.False(t, stream.Ready())
+	assert.True(t, stream.Value().Equal(decimal.Zero))
+
+	stream, err = NewRSIStream(3, SmoothingEMA)
+	require.NoError(t, err)
+
+	assertStream(t, stream, RSI{valid: true, length: 3, smoothing: SmoothingEMA}, series(3, 5, 4, 8, 6, 7))
+
+	stream, err = NewRSIStream(2, SmoothingWilder)
+	require.NoError(t, err)
+
+	for _, d := range series(8, 8, 8, 8) {
+		stream.Add(d)
+	}
+
+	exp, err = RSI{valid: true, length: 2, smoothing: SmoothingWilder}.Calc(series(8, 8, 8, 8))
+	require.NoError(t, err)
+	assert.Equal(t, "50", stream.Value().String())
+	assert.Equal(t, exp.String(), stream.Value().String())
+}
+
+func Test_RSIStream_reference(t *testing.T) {
+	dd := wilderReference(t)
+
+	stream, err := NewRSIStream(14, SmoothingWilder)
+	require.NoError(t, err)
+
+	for _, d := range dd[:15] {
+		stream.Add(d)
+	}
+
+	// the first period is seeded with the simple averages of the first
+	// 14 changes, just like TA-Lib.
+	assert.Equal(t, "70.53", rsiValue(stream.up, stream.down).Round(2).String())
+
+	for _, d := range dd[15:27] {
+		stream.Add(d)
+	}
+
+	res := make([]string, 0, len(dd)-27)
+
+	for _, d := range dd[27:] {
+		res = append(res, stream.Add(d).Round(2).String())
+	}
+
+	assert.Equal(t, []string{"41.46", "41.87", "45.46", "37.3", "33.08", "37.77"}, res)
+}
+
+func Test_NewStochStream(t *testing.T) {
+	_, err := NewStochStream(0)
+	assert.Equal(t, &ConfigError{
+		Indicator: NameStochStream,
+		Field:     "length",
+		Value:     0,
+		Err:       ErrInvalidLength,
+	}, err)
+
+	stream, err := NewStochStream(3)
+	require.NoError(t, err)
+
+	assertStream(t, stream, Stoch{valid: true, length: 3}, series(3, 5, 4, 8, 6, 7, 2, 9, 9, 9, 9))
+}
+
+func Test_NVIStream(t *testing.T) {
+	var stream CandleStream = &NVIStream{}
+
+	assert.False(t, stream.Ready())
+
+	res := make([]decimal.Decimal, 0, 5)
+
+	for _, c := range testCandles(t) {
+		res = append(res, stream.Add(c))
+	}
+
+	assert.Equal(t, "1540", res[len(res)-1].Round(8).String())
+	assert.Equal(t, "1540", stream.Value().Round(8).String())
+
+	assert.True(t, stream.Ready())
+
+	stream.Reset()
+	assert.Equal(t, &NVIStream{}, stream)
+	assert.False(t, stream.Ready())
+}
+
+func Test_OBVStream(t *testing.T) {
+	var stream CandleStream = &OBVStream{}
+
+	assert.False(t, stream.Ready())
+
+	res := make([]decimal.Decimal, 0, 6)
+
+	cc := append(testCandles(t), Candle{
+		Close:  decimal.NewFromInt(14),
+		Volume: decimal.NewFromInt(50),
+	})
+
+	for i := range cc {
+		res = append(res, stream.Add(cc[i]))
+	}
+
+	assert.Equal(t, []string{"0", "200", "350", "50", "300", "300"}, decimalStrings(res))
+	assert.Equal(t, "300", stream.Value().String())
+
+	assert.True(t, stream.Ready())
+
+	stream.Reset()
+	assert.Equal(t, &OBVStream{}, stream)
+	assert.False(t, stream.Ready())
+}
+
+func Test_PVIStream(t *testing.T) {
+	var stream CandleStream = &PVIStream{}
+
+	assert.False(t, stream.Ready())
+
+	res := make([]decimal.Decimal, 0, 5)
+
+	for _, c := range testCandles(t) {
+		res = append(res, stream.Add(c))
+	}
+
+	assert.Equal(t, "1010.1010101", res[len(res)-1].Round(8).String())
+	assert.Equal(t, "1010.1010101", stream.Value().Round(8).String())
+
+	assert.True(t, stream.Ready())
+
+	stream.Reset()
+	assert.Equal(t, &PVIStream{}, stream)
+	assert.False(t, stream.Ready())
+}
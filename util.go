@@ -36,6 +36,56 @@ var (
 	// ErrInvalidMA is returned when ma doesn't match any of the
 	// availabble ma types.
 	ErrInvalidMA = errors.New("invalid moving average")
+
+	// ErrInvalidInput is returned when input doesn't match any of the
+	// available input types.
+	ErrInvalidInput = errors.New("invalid input")
+
+	// ErrInvalidFactor is returned when incorrect factor is provided.
+	ErrInvalidFactor = errors.New("invalid factor")
+
+	// ErrInvalidShift is returned when incorrect shift is provided.
+	ErrInvalidShift = errors.New("invalid shift")
+
+	// ErrInvalidScaling is returned when scaling doesn't match any of
+	// the available scaling types.
+	ErrInvalidScaling = errors.New("invalid scaling")
+
+	// ErrInvalidSmoothing is returned when smoothing doesn't match any
+	// of the available smoothing types.
+	ErrInvalidSmoothing = errors.New("invalid smoothing")
+
+	// ErrInvalidPivotMethod is returned when pivot method doesn't match any
+	// of the available pivot methods.
+	ErrInvalidPivotMethod = errors.New("invalid pivot method")
+
+	// ErrInvalidPrice is returned when price doesn't match any of the
+	// available candle prices.
+	ErrInvalidPrice = errors.New("invalid price")
+
+	// ErrInvalidBrickSize is returned when incorrect Renko brick size is
+	// provided.
+	ErrInvalidBrickSize = errors.New("invalid brick size")
+
+	// ErrUnknownIndicator is returned when indicator's name or type
+	// doesn't match any of the available indicators.
+	ErrUnknownIndicator = errors.New("unknown indicator")
+
+	// ErrInvalidTarget is returned when decoded indicator cannot be
+	// stored into the provided value.
+	ErrInvalidTarget = errors.New("invalid target")
+
+	// ErrDuplicateIndicator is returned when indicator's name is already
+	// used by another indicator.
+	ErrDuplicateIndicator = errors.New("duplicate indicator")
+
+	// ErrInvalidValue is returned when data point cannot be represented
+	// as a decimal number, e.g. NaN or infinite float.
+	ErrInvalidValue = errors.New("invalid value")
+
+	// ErrOverflow is returned when the calculation result is too large
+	// to be represented.
+	ErrOverflow = errors.New("result overflow")
 )
 
 // avg is a helper function that calculates average decimal number of
@@ -92,6 +142,331 @@ func sdev(dd []decimal.Decimal) decimal.Decimal {
 	return sqrt(res)
 }
 
+// moments calculates the second, third and fourth central moments of
+// given slice.
+func moments(dd []decimal.Decimal) (m2, m3, m4 decimal.Decimal) {
+	m2, m3, m4 = decimal.Zero, decimal.Zero, decimal.Zero
+
+	if len(dd) == 0 {
+		return m2, m3, m4
+	}
+
+	length := decimal.NewFromInt(int64(len(dd)))
+	mean := avg(dd)
+
+	for i := range dd {
+		dev := dd[i].Sub(mean)
+		sq := dev.Mul(dev)
+
+		m2 = m2.Add(sq)
+		m3 = m3.Add(sq.Mul(dev))
+		m4 = m4.Add(sq.Mul(sq))
+	}
+
+	return m2.Div(length), m3.Div(length), m4.Div(length)
+}
+
+// extreme tracks the extreme value of a fixed size window by using a
+// monotonic deque, so every data point is compared only a constant
+// amount of times.
+type extreme struct {
+	// length specifies the size of the window.
+	length int
+
+	// dominates specifies whether the first value takes precedence over
+	// the second one.
+	dominates func(a, b decimal.Decimal) bool
+
+	// count specifies how many data points were added.
+	count int
+
+	// idx specifies the positions of the deque's data points.
+	idx []int
+
+	// vv specifies the data points of the deque.
+	vv []decimal.Decimal
+}
+
+// push adds the data point to the window and returns the extreme value
+// of the window.
+func (e *extreme) push(d decimal.Decimal) decimal.Decimal {
+	for len(e.vv) > 0 && !e.dominates(e.vv[len(e.vv)-1], d) {
+		e.idx = e.idx[:len(e.idx)-1]
+		e.vv = e.vv[:len(e.vv)-1]
+	}
+
+	e.idx = append(e.idx, e.count)
+	e.vv = append(e.vv, d)
+
+	if e.idx[0] <= e.count-e.length {
+		e.idx = e.idx[1:]
+		e.vv = e.vv[1:]
+	}
+
+	e.count++
+
+	return e.vv[0]
+}
+
+// reset clears all data points of the window.
+func (e *extreme) reset() {
+	e.count = 0
+	e.idx = nil
+	e.vv = nil
+}
+
+// rollingExtremes calculates the extreme value of every consecutive window
+// of the provided length. The dominates function should report whether
+// the first value takes precedence over the second one.
+func rollingExtremes(dd []decimal.Decimal, length int, dominates func(a, b decimal.Decimal) bool) []decimal.Decimal {
+	if length < 1 || len(dd) < length {
+		return nil
+	}
+
+	res := make([]decimal.Decimal, 0, len(dd)-length+1)
+	e := extreme{
+		length:    length,
+		dominates: dominates,
+	}
+
+	for i := range dd {
+		v := e.push(dd[i])
+
+		if i >= length-1 {
+			res = append(res, v)
+		}
+	}
+
+	return res
+}
+
+// hilbert is a helper function that applies Ehlers' discrete Hilbert
+// transform to the value at the provided index. Values before the start
+// of the slice must be accessible, i.e. the index has to be at least 6.
+// The symmetric values are subtracted first, so that constant values are
+// transformed to exact zeros.
+func hilbert(xx []float64, i int) float64 {
+	return 0.0962*(xx[i]-xx[i-6]) + 0.5769*(xx[i-2]-xx[i-4])
+}
+
+// roofingFilter applies Ehlers' roofing filter to given values. The
+// two-pole high-pass filter removes the cycles longer than the max period
+// and the SuperSmoother removes the ones shorter than the min period.
+// Both filters are seeded with zeros.
+func roofingFilter(xx []float64, min, max int) []float64 {
+	w := math.Sqrt2 * math.Pi / float64(max)
+	alpha := (math.Cos(w) + math.Sin(w) - 1) / math.Cos(w)
+
+	hp := make([]float64, len(xx))
+
+	for i := 2; i < len(xx); i++ {
+		hp[i] = (1-alpha/2)*(1-alpha/2)*(xx[i]-2*xx[i-1]+xx[i-2]) +
+			2*(1-alpha)*hp[i-1] - (1-alpha)*(1-alpha)*hp[i-2]
+	}
+
+	a := math.Exp(-math.Sqrt2 * math.Pi / float64(min))
+	c2 := 2 * a * math.Cos(math.Sqrt2*math.Pi/float64(min))
+	c3 := -a * a
+	c1 := 1 - c2 - c3
+
+	res := make([]float64, len(xx))
+
+	for i := 2; i < len(xx); i++ {
+		res[i] = c1*(hp[i]+hp[i-1])/2 + c2*res[i-1] + c3*res[i-2]
+	}
+
+	return res
+}
+
+// correlation calculates Pearson correlation coefficient of given slices.
+// Both slices must be of the same length.
+func correlation(xx, yy []decimal.Decimal) decimal.Decimal {
+	dnm := sdev(xx).Mul(sdev(yy))
+	if dnm.Equal(decimal.Zero) {
+		return decimal.Zero
+	}
+
+	return covariance(xx, yy).Div(dnm)
+}
+
+// covariance calculates population covariance of given slices.
+// Both slices must be of the same length.
+func covariance(xx, yy []decimal.Decimal) decimal.Decimal {
+	if len(xx) == 0 {
+		return decimal.Zero
+	}
+
+	mx, my := avg(xx), avg(yy)
+	res := decimal.Zero
+
+	for i := range xx {
+		res = res.Add(xx[i].Sub(mx).Mul(yy[i].Sub(my)))
+	}
+
+	return res.Div(decimal.NewFromInt(int64(len(xx))))
+}
+
+// returns calculates simple returns between consecutive values of given
+// slice. Returns from zero values are treated as zero.
+func returns(dd []decimal.Decimal) []decimal.Decimal {
+	if len(dd) < 2 {
+		return nil
+	}
+
+	res := make([]decimal.Decimal, len(dd)-1)
+
+	for i := range res {
+		if dd[i].Equal(decimal.Zero) {
+			res[i] = decimal.Zero
+			continue
+		}
+
+		res[i] = dd[i+1].Div(dd[i]).Sub(_one)
+	}
+
+	return res
+}
+
+// rateOfChange calculates the percentage change from the oldest to the
+// newest value of given slice. Changes from a zero value are treated as
+// zero.
+func rateOfChange(dd []decimal.Decimal) decimal.Decimal {
+	prev := dd[0]
+	curr := dd[len(dd)-1]
+
+	if prev.Equal(decimal.Zero) {
+		return decimal.Zero
+	}
+
+	return curr.Div(prev).Sub(_one).Mul(_hundred)
+}
+
+// annualReturn converts the growth factor achieved over the provided
+// count of periods into a compounded annual return. Zero periods per year
+// means that the total return is returned as is. Non-positive growth
+// cannot be compounded and is treated as a total loss.
+func annualReturn(growth decimal.Decimal, count, periods int) (decimal.Decimal, error) {
+	if growth.LessThanOrEqual(decimal.Zero) {
+		return _one.Neg(), nil
+	}
+
+	if periods == 0 {
+		return growth.Sub(_one), nil
+	}
+
+	f, _ := growth.Float64()
+
+	res := math.Pow(f, float64(periods)/float64(count))
+	if math.IsInf(res, 0) || math.IsNaN(res) {
+		return decimal.Zero, ErrOverflow
+	}
+
+	return decimal.NewFromFloat(res).Sub(_one), nil
+}
+
+// annualRatio divides the average of given returns by the provided
+// deviation of returns. Zero periods per year means that the ratio is
+// not annualized, otherwise, unlike annualReturn which compounds the
+// growth, the ratio is scaled by the square root of periods, since the
+// average grows linearly and the deviation with the square root of time.
+func annualRatio(rr []decimal.Decimal, dev decimal.Decimal, periods int) decimal.Decimal {
+	if len(rr) == 0 || dev.Equal(decimal.Zero) {
+		return decimal.Zero
+	}
+
+	res := avg(rr).Div(dev)
+
+	if periods > 0 {
+		res = res.Mul(sqrt(decimal.NewFromInt(int64(periods))))
+	}
+
+	return res
+}
+
+// bandWidth calculates the distance between the upper and lower bands
+// relative to the middle line. It is shared by all band indicators, so
+// their widths are comparable.
+func bandWidth(upper, middle, lower decimal.Decimal) decimal.Decimal {
+	if middle.Equal(decimal.Zero) {
+		return decimal.Zero
+	}
+
+	return upper.Sub(lower).Div(middle)
+}
+
+// maxDrawdown calculates the largest relative decline from a peak to a
+// subsequent trough of given slice. Non-positive peaks are skipped.
+func maxDrawdown(dd []decimal.Decimal) decimal.Decimal {
+	res := decimal.Zero
+
+	if len(dd) == 0 {
+		return res
+	}
+
+	peak := dd[0]
+
+	for i := range dd {
+		if dd[i].GreaterThan(peak) {
+			peak = dd[i]
+		}
+
+		if peak.LessThanOrEqual(decimal.Zero) {
+			continue
+		}
+
+		if v := peak.Sub(dd[i]).Div(peak); v.GreaterThan(res) {
+			res = v
+		}
+	}
+
+	return res
+}
+
+// gains is a helper function that calculates the sums of absolute
+// increases (up) and decreases (down) between consecutive values of
+// given slice.
+func gains(dd []decimal.Decimal) (up, down decimal.Decimal) {
+	up, down = decimal.Zero, decimal.Zero
+
+	for i := 1; i < len(dd); i++ {
+		diff := dd[i].Sub(dd[i-1])
+		if diff.LessThan(decimal.Zero) {
+			down = down.Add(diff.Abs())
+		} else {
+			up = up.Add(diff)
+		}
+	}
+
+	return up, down
+}
+
+// linreg is a helper function that fits a least squares line through
+// given slice, where the x coordinate of every value is its index.
+func linreg(dd []decimal.Decimal) (slope, intercept decimal.Decimal) {
+	if len(dd) == 0 {
+		return decimal.Zero, decimal.Zero
+	}
+
+	xm := decimal.NewFromInt(int64(len(dd) - 1)).Div(decimal.NewFromInt(2))
+	ym := avg(dd)
+
+	num := decimal.Zero
+	dnm := decimal.Zero
+
+	for i := range dd {
+		dx := decimal.NewFromInt(int64(i)).Sub(xm)
+		num = num.Add(dx.Mul(dd[i].Sub(ym)))
+		dnm = dnm.Add(dx.Mul(dx))
+	}
+
+	slope = decimal.Zero
+	if !dnm.Equal(decimal.Zero) {
+		slope = num.Div(dnm)
+	}
+
+	return slope, ym.Sub(slope.Mul(xm))
+}
+
 // Trend specifies which trend should be used.
 type Trend int
 
@@ -210,6 +585,7 @@ const (
 	MATypeHMA
 	MATypeSMA
 	MATypeWMA
+	MATypeSMMA
 )
 
 // Initialize tries to construct new moving average based on the provided
@@ -226,6 +602,8 @@ func (mat MAType) Initialize(length int) (Indicator, error) {
 		return NewSMA(length)
 	case MATypeWMA:
 		return NewWMA(length)
+	case MATypeSMMA:
+		return NewSMMA(length)
 	default:
 		return nil, ErrInvalidMA
 	}
@@ -246,6 +624,8 @@ func (mat MAType) MarshalText() ([]byte, error) {
 		v = "sma"
 	case MATypeWMA:
 		v = "wma"
+	case MATypeSMMA:
+		v = "smma"
 	default:
 		return nil, ErrInvalidMA
 	}
@@ -266,6 +646,8 @@ func (mat *MAType) UnmarshalText(d []byte) error {
 		*mat = MATypeSMA
 	case "wma":
 		*mat = MATypeWMA
+	case "smma":
+		*mat = MATypeSMMA
 	default:
 		return ErrInvalidMA
 	}
@@ -273,6 +655,470 @@ func (mat *MAType) UnmarshalText(d []byte) error {
 	return nil
 }
 
+// Scaling specifies how indicator values should be rescaled.
+type Scaling int
+
+// Available scaling types.
+const (
+	// ScalingMinMax specifies that values should be rescaled relative
+	// to the lowest and the highest value of the window.
+	ScalingMinMax Scaling = iota + 1
+
+	// ScalingPercentRank specifies that values should be rescaled to
+	// the percentage of preceding window values that are lower than or
+	// equal to them.
+	ScalingPercentRank
+)
+
+// Validate checks whether the scaling is one of supported scaling types.
+func (sc Scaling) Validate() error {
+	switch sc {
+	case ScalingMinMax, ScalingPercentRank:
+		return nil
+	default:
+		return ErrInvalidScaling
+	}
+}
+
+// MarshalText turns scaling into appropriate string representation.
+func (sc Scaling) MarshalText() ([]byte, error) {
+	var v string
+
+	switch sc {
+	case ScalingMinMax:
+		v = "min_max"
+	case ScalingPercentRank:
+		v = "percent_rank"
+	default:
+		return nil, ErrInvalidScaling
+	}
+
+	return []byte(v), nil
+}
+
+// UnmarshalText turns string to appropriate scaling value.
+func (sc *Scaling) UnmarshalText(d []byte) error {
+	switch string(d) {
+	case "min_max":
+		*sc = ScalingMinMax
+	case "percent_rank":
+		*sc = ScalingPercentRank
+	default:
+		return ErrInvalidScaling
+	}
+
+	return nil
+}
+
+// Smoothing specifies how a series of values should be averaged.
+type Smoothing int
+
+// Available smoothing types.
+const (
+	// SmoothingWilder specifies Wilder's smoothing, which is an
+	// exponential moving average with 1/length multiplier.
+	SmoothingWilder Smoothing = iota + 1
+
+	// SmoothingEMA specifies exponential moving average smoothing.
+	SmoothingEMA
+
+	// SmoothingSMA specifies simple moving average smoothing.
+	SmoothingSMA
+)
+
+// Validate checks whether the smoothing is one of supported smoothing
+// types.
+func (sm Smoothing) Validate() error {
+	switch sm {
+	case SmoothingWilder, SmoothingEMA, SmoothingSMA:
+		return nil
+	default:
+		return ErrInvalidSmoothing
+	}
+}
+
+// MarshalText turns smoothing into appropriate string representation.
+func (sm Smoothing) MarshalText() ([]byte, error) {
+	var v string
+
+	switch sm {
+	case SmoothingWilder:
+		v = "wilder"
+	case SmoothingEMA:
+		v = "ema"
+	case SmoothingSMA:
+		v = "sma"
+	default:
+		return nil, ErrInvalidSmoothing
+	}
+
+	return []byte(v), nil
+}
+
+// UnmarshalText turns string to appropriate smoothing value.
+func (sm *Smoothing) UnmarshalText(d []byte) error {
+	switch string(d) {
+	case "wilder":
+		*sm = SmoothingWilder
+	case "ema":
+		*sm = SmoothingEMA
+	case "sma":
+		*sm = SmoothingSMA
+	default:
+		return ErrInvalidSmoothing
+	}
+
+	return nil
+}
+
+// count determines the total amount of values needed to smooth a series
+// with the provided length.
+func (sm Smoothing) count(length int) int {
+	if sm == SmoothingSMA {
+		return length
+	}
+
+	// exponential smoothing is seeded with the simple average of the
+	// first length values.
+	return length*2 - 1
+}
+
+// multiplier returns the weight of the newest value of exponential
+// smoothing.
+func (sm Smoothing) multiplier(length int) decimal.Decimal {
+	if sm == SmoothingEMA {
+		return decimal.NewFromInt(2).Div(decimal.NewFromInt(int64(length) + 1))
+	}
+
+	return _one.Div(decimal.NewFromInt(int64(length)))
+}
+
+// calc smooths the provided values and returns the newest result.
+func (sm Smoothing) calc(dd []decimal.Decimal, length int) (decimal.Decimal, error) {
+	if err := sm.Validate(); err != nil {
+		return decimal.Zero, err
+	}
+
+	if length < 1 || len(dd) != sm.count(length) {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	res := avg(dd[:length])
+
+	if sm == SmoothingSMA {
+		return res, nil
+	}
+
+	mtp := sm.multiplier(length)
+
+	for i := length; i < len(dd); i++ {
+		res = dd[i].Mul(mtp).Add(res.Mul(_one.Sub(mtp)))
+	}
+
+	return res, nil
+}
+
+// Input specifies what kind of data points an indicator requires.
+type Input int
+
+// Available indicator input types.
+const (
+	// InputClose specifies that a single price series, usually close
+	// prices, is required.
+	InputClose Input = iota + 1
+
+	// InputCandle specifies that full candles are required.
+	InputCandle
+
+	// InputPair specifies that two price series, usually close prices of
+	// an asset and its benchmark, are required.
+	InputPair
+)
+
+// Validate checks whether the input is one of supported input types.
+func (in Input) Validate() error {
+	switch in {
+	case InputClose, InputCandle, InputPair:
+		return nil
+	default:
+		return ErrInvalidInput
+	}
+}
+
+// MarshalText turns input into appropriate string representation.
+func (in Input) MarshalText() ([]byte, error) {
+	var v string
+
+	switch in {
+	case InputClose:
+		v = "close"
+	case InputCandle:
+		v = "candle"
+	case InputPair:
+		v = "pair"
+	default:
+		return nil, ErrInvalidInput
+	}
+
+	return []byte(v), nil
+}
+
+// UnmarshalText turns string to appropriate input value.
+func (in *Input) UnmarshalText(d []byte) error {
+	switch string(d) {
+	case "close":
+		*in = InputClose
+	case "candle":
+		*in = InputCandle
+	case "pair":
+		*in = InputPair
+	default:
+		return ErrInvalidInput
+	}
+
+	return nil
+}
+
+// PivotMethod specifies how pivot point levels should be calculated.
+type PivotMethod int
+
+// Available pivot methods.
+const (
+	// PivotClassic specifies classic (floor) pivot points.
+	PivotClassic PivotMethod = iota + 1
+
+	// PivotFibonacci specifies pivot points with levels based on
+	// Fibonacci ratios of the prior period's range.
+	PivotFibonacci
+
+	// PivotCamarilla specifies Camarilla pivot points with levels
+	// based on the prior period's close price.
+	PivotCamarilla
+
+	// PivotWoodie specifies Woodie pivot points which give more weight
+	// to the prior period's close price.
+	PivotWoodie
+)
+
+// Validate checks whether the pivot method is one of supported pivot methods.
+func (pm PivotMethod) Validate() error {
+	switch pm {
+	case PivotClassic, PivotFibonacci, PivotCamarilla, PivotWoodie:
+		return nil
+	default:
+		return ErrInvalidPivotMethod
+	}
+}
+
+// MarshalText turns pivot method into appropriate string representation.
+func (pm PivotMethod) MarshalText() ([]byte, error) {
+	var v string
+
+	switch pm {
+	case PivotClassic:
+		v = "classic"
+	case PivotFibonacci:
+		v = "fibonacci"
+	case PivotCamarilla:
+		v = "camarilla"
+	case PivotWoodie:
+		v = "woodie"
+	default:
+		return nil, ErrInvalidPivotMethod
+	}
+
+	return []byte(v), nil
+}
+
+// UnmarshalText turns string to appropriate pivot method value.
+func (pm *PivotMethod) UnmarshalText(d []byte) error {
+	switch string(d) {
+	case "classic":
+		*pm = PivotClassic
+	case "fibonacci":
+		*pm = PivotFibonacci
+	case "camarilla":
+		*pm = PivotCamarilla
+	case "woodie":
+		*pm = PivotWoodie
+	default:
+		return ErrInvalidPivotMethod
+	}
+
+	return nil
+}
+
+// Price specifies which price of a candle should be used.
+type Price int
+
+// Available candle prices.
+const (
+	// PriceOpen specifies the open price.
+	PriceOpen Price = iota + 1
+
+	// PriceHigh specifies the high price.
+	PriceHigh
+
+	// PriceLow specifies the low price.
+	PriceLow
+
+	// PriceClose specifies the close price.
+	PriceClose
+
+	// PriceHL2 specifies the average of the high and low prices.
+	PriceHL2
+
+	// PriceHLC3 specifies the average of the high, low and close prices.
+	PriceHLC3
+
+	// PriceHLCC4 specifies the average of the high, low and twice
+	// weighted close prices.
+	PriceHLCC4
+
+	// PriceOHLC4 specifies the average of the open, high, low and close
+	// prices.
+	PriceOHLC4
+)
+
+// Validate checks whether the price is one of supported candle prices.
+func (p Price) Validate() error {
+	switch p {
+	case PriceOpen, PriceHigh, PriceLow, PriceClose, PriceHL2, PriceHLC3,
+		PriceHLCC4, PriceOHLC4:
+		return nil
+	default:
+		return ErrInvalidPrice
+	}
+}
+
+// MarshalText turns price into appropriate string representation.
+func (p Price) MarshalText() ([]byte, error) {
+	var v string
+
+	switch p {
+	case PriceOpen:
+		v = "open"
+	case PriceHigh:
+		v = "high"
+	case PriceLow:
+		v = "low"
+	case PriceClose:
+		v = "close"
+	case PriceHL2:
+		v = "hl2"
+	case PriceHLC3:
+		v = "hlc3"
+	case PriceHLCC4:
+		v = "hlcc4"
+	case PriceOHLC4:
+		v = "ohlc4"
+	default:
+		return nil, ErrInvalidPrice
+	}
+
+	return []byte(v), nil
+}
+
+// UnmarshalText turns string to appropriate price value.
+func (p *Price) UnmarshalText(d []byte) error {
+	switch string(d) {
+	case "open":
+		*p = PriceOpen
+	case "high":
+		*p = PriceHigh
+	case "low":
+		*p = PriceLow
+	case "close":
+		*p = PriceClose
+	case "hl2":
+		*p = PriceHL2
+	case "hlc3":
+		*p = PriceHLC3
+	case "hlcc4":
+		*p = PriceHLCC4
+	case "ohlc4":
+		*p = PriceOHLC4
+	default:
+		return ErrInvalidPrice
+	}
+
+	return nil
+}
+
+// of returns the price of the provided candle.
+func (p Price) of(c Candle) decimal.Decimal {
+	switch p {
+	case PriceOpen:
+		return c.Open
+	case PriceHigh:
+		return c.High
+	case PriceLow:
+		return c.Low
+	case PriceHL2:
+		return medianPrice(c)
+	case PriceHLC3:
+		return typicalPrice(c)
+	case PriceHLCC4:
+		return weightedClose(c)
+	case PriceOHLC4:
+		return c.Open.Add(c.High).Add(c.Low).Add(c.Close).Div(decimal.NewFromInt(4))
+	default:
+		return c.Close
+	}
+}
+
+// Description holds structured information about an indicator that
+// can be used to render and scale its output.
+type Description struct {
+	// Name specifies the name of the indicator.
+	Name string `json:"name"`
+
+	// Input specifies what kind of data points are required.
+	Input Input `json:"input"`
+
+	// Overlay specifies whether the output is measured in the same
+	// units as the input and can be drawn on top of it.
+	Overlay bool `json:"overlay"`
+
+	// Bounded specifies whether the output always stays within the
+	// Min and Max range.
+	Bounded bool `json:"bounded"`
+
+	// Min specifies the lowest possible output value. It is only
+	// meaningful when Bounded is true.
+	Min decimal.Decimal `json:"min"`
+
+	// Max specifies the highest possible output value. It is only
+	// meaningful when Bounded is true.
+	Max decimal.Decimal `json:"max"`
+
+	// Lag specifies the theoretical average lag of the output,
+	// measured in data points. It is zero when the output is not
+	// comparable with the input.
+	Lag decimal.Decimal `json:"lag"`
+}
+
+// Describer is an interface that indicators, which can provide structured
+// information about themselves, should implement. Every indicator of this
+// package implements it.
+type Describer interface {
+	// Describe should return structured information about the
+	// indicator and its output.
+	Describe() Description
+}
+
+// describe returns structured information about the provided indicator.
+// Indicators that don't implement Describer are described as the ones
+// calculated from close prices.
+func describe(ind Indicator) Description {
+	d, ok := ind.(Describer)
+	if !ok {
+		return Description{Input: InputClose}
+	}
+
+	return d.Describe()
+}
+
 // Indicator is an interface that every indicator should implement.
 type Indicator interface {
 	// Calc should return calculation results based on provided data
@@ -283,3 +1129,27 @@ type Indicator interface {
 	// the calculation.
 	Count() int
 }
+
+// CandleIndicator is an interface that every indicator which requires
+// full candles should implement.
+type CandleIndicator interface {
+	// CalcCandles should return calculation results based on provided
+	// candles slice.
+	CalcCandles([]Candle) (decimal.Decimal, error)
+
+	// Count should determine the total amount of candles required for
+	// the calculation.
+	Count() int
+}
+
+// PairIndicator is an interface that every indicator which compares two
+// data point series should implement.
+type PairIndicator interface {
+	// CalcPair should return calculation results based on provided
+	// asset and benchmark data points slices.
+	CalcPair(dd, bb []decimal.Decimal) (decimal.Decimal, error)
+
+	// Count should determine the total amount of data points of every
+	// series required for the calculation.
+	Count() int
+}
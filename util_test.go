@@ -23,6 +23,32 @@ func assertEqualError(t *testing.T, exp, err error) {
 
 	assert.NoError(t, err)
 }
+
+func assertEqualDescription(t *testing.T, exp, act Description) {
+	t.Helper()
+
+	assert.Equal(t, exp.Min.String(), act.Min.String())
+	assert.Equal(t, exp.Max.String(), act.Max.String())
+	assert.Equal(t, exp.Lag.String(), act.Lag.String())
+
+	exp.Min, exp.Max, exp.Lag = decimal.Zero, decimal.Zero, decimal.Zero
+	act.Min, act.Max, act.Lag = decimal.Zero, decimal.Zero, decimal.Zero
+
+	assert.Equal(t, exp, act)
+}
+
+// decimalStrings is a helper function that converts decimals to their
+// string representations.
+func decimalStrings(dd []decimal.Decimal) []string {
+	res := make([]string, len(dd))
+
+	for i := range dd {
+		res[i] = dd[i].String()
+	}
+
+	return res
+}
+
 func Test_mdev(t *testing.T) {
 	cc := map[string]struct {
 		Data   []decimal.Decimal
@@ -101,6 +127,169 @@ func Test_sdev(t *testing.T) {
 	}
 }
 
+func Test_linreg(t *testing.T) {
+	slope, intercept := linreg(nil)
+	assert.Equal(t, "0", slope.String())
+	assert.Equal(t, "0", intercept.String())
+
+	slope, intercept = linreg(series(5))
+	assert.Equal(t, "0", slope.String())
+	assert.Equal(t, "5", intercept.String())
+
+	slope, intercept = linreg(series(1, 3, 2, 4))
+	assert.Equal(t, "0.8", slope.String())
+	assert.Equal(t, "1.3", intercept.String())
+}
+
+func Test_moments(t *testing.T) {
+	m2, m3, m4 := moments(nil)
+	assert.Equal(t, "0", m2.String())
+	assert.Equal(t, "0", m3.String())
+	assert.Equal(t, "0", m4.String())
+
+	m2, m3, m4 = moments(series(1, 2, 3, 10))
+	assert.Equal(t, "12.5", m2.String())
+	assert.Equal(t, "45", m3.String())
+	assert.Equal(t, "348.5", m4.String())
+}
+
+func Test_extreme(t *testing.T) {
+	e := extreme{
+		length:    3,
+		dominates: decimal.Decimal.GreaterThanOrEqual,
+	}
+
+	res := make([]decimal.Decimal, 0, 6)
+
+	for _, d := range series(1, 5, 3, 2, 4, 1) {
+		res = append(res, e.push(d))
+	}
+
+	assert.Equal(t, []string{"1", "5", "5", "5", "4", "4"}, decimalStrings(res))
+
+	e.reset()
+	assert.Zero(t, e.count)
+	assert.Empty(t, e.vv)
+}
+
+func Test_rollingExtremes(t *testing.T) {
+	greater := func(a, b decimal.Decimal) bool {
+		return a.GreaterThan(b)
+	}
+
+	assert.Nil(t, rollingExtremes(series(1, 2), 0, greater))
+	assert.Nil(t, rollingExtremes(series(1, 2), 3, greater))
+
+	res := rollingExtremes(series(1, 3, 2, 2, 1, 5, 4), 3, greater)
+	assert.Equal(t, []string{"3", "3", "2", "5", "5"}, decimalStrings(res))
+}
+
+func Test_hilbert(t *testing.T) {
+	assert.InDelta(t, 0.8655, hilbert([]float64{1, 0, 2, 0, 3, 0, 4}, 6), 1e-9)
+}
+
+func Test_rateOfChange(t *testing.T) {
+	assert.Equal(t, "0", rateOfChange(series(0, 10)).String())
+	assert.Equal(t, "42.85714286", rateOfChange(series(7, 16, 24, 16, 10)).Round(8).String())
+}
+
+func Test_roofingFilter(t *testing.T) {
+	assert.Equal(t, []float64{0, 0, 0, 0, 0}, roofingFilter([]float64{1, 2, 3, 4, 5}, 2, 4))
+
+	res := roofingFilter([]float64{1, 2, 1, 0, 1, 2, 1, 0}, 2, 4)
+	assert.Len(t, res, 8)
+	assert.NotEqual(t, 0.0, res[len(res)-1])
+}
+
+func Test_correlation(t *testing.T) {
+	assert.Equal(t, "0", correlation(series(1, 1, 1), series(1, 2, 3)).String())
+	assert.Equal(t, "1", correlation(series(1, 2, 3), series(2, 4, 6)).Round(8).String())
+	assert.Equal(t, "-1", correlation(series(1, 2, 3), series(3, 2, 1)).Round(8).String())
+}
+
+func Test_covariance(t *testing.T) {
+	assert.Equal(t, "0", covariance(nil, nil).String())
+	assert.Equal(t, "1.33333333", covariance(series(1, 2, 3), series(2, 4, 6)).Round(8).String())
+}
+
+func Test_returns(t *testing.T) {
+	assert.Nil(t, returns(series(1)))
+	assert.Equal(t, []string{"1", "-1", "0"}, decimalStrings(returns(series(1, 2, 0, 3))))
+}
+
+func Test_annualReturn(t *testing.T) {
+	cc := map[string]struct {
+		Growth  decimal.Decimal
+		Count   int
+		Periods int
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Successful calculation of total loss": {
+			Growth:  decimal.RequireFromString("-0.5"),
+			Count:   3,
+			Periods: 250,
+			Result:  decimal.NewFromInt(-1),
+		},
+		"Successful calculation without annualization": {
+			Growth: decimal.RequireFromString("1.2"),
+			Count:  3,
+			Result: decimal.RequireFromString("0.2"),
+		},
+		"Successful annualized calculation": {
+			Growth:  decimal.RequireFromString("1.21"),
+			Count:   4,
+			Periods: 2,
+			Result:  decimal.RequireFromString("0.1"),
+		},
+		"Overflow": {
+			Growth:  decimal.NewFromInt(2000),
+			Count:   2,
+			Periods: 252,
+			Error:   ErrOverflow,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := annualReturn(c.Growth, c.Count, c.Periods)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_annualRatio(t *testing.T) {
+	assert.Equal(t, "0", annualRatio(nil, decimal.Zero, 252).String())
+	assert.Equal(t, "0", annualRatio(series(1, 2), decimal.Zero, 252).String())
+	assert.Equal(t, "1.5", annualRatio(series(1, 2), _one, 0).String())
+	assert.Equal(t, "3", annualRatio(series(1, 2), _one, 4).String())
+}
+
+func Test_maxDrawdown(t *testing.T) {
+	assert.Equal(t, "0", maxDrawdown(nil).String())
+	assert.Equal(t, "0", maxDrawdown(series(0, -1, -2)).String())
+	assert.Equal(t, "0.5", maxDrawdown(series(10, 8, 12, 6, 11)).String())
+}
+
+func Test_gains(t *testing.T) {
+	up, down := gains(nil)
+	assert.Equal(t, "0", up.String())
+	assert.Equal(t, "0", down.String())
+
+	up, down = gains(series(1, 3, 2, 2, 6, 1))
+	assert.Equal(t, "6", up.String())
+	assert.Equal(t, "6", down.String())
+}
+
 func Test_Trend_Validate(t *testing.T) {
 	cc := map[string]struct {
 		Trend Trend
@@ -380,6 +569,14 @@ func Test_MAType_Initialize(t *testing.T) {
 				},
 			},
 		},
+		"Successful MATypeSMMA initialization": {
+			Type:   MATypeSMMA,
+			Length: 1,
+			Indicator: SMMA{
+				valid:  true,
+				length: 1,
+			},
+		},
 		"Successful MATypeSMA initialization": {
 			Type:   MATypeSMA,
 			Length: 1,
@@ -437,6 +634,10 @@ func Test_MAType_MarshalText(t *testing.T) {
 			Type: MATypeHMA,
 			Text: "hma",
 		},
+		"Successful MATypeSMMA marshal": {
+			Type: MATypeSMMA,
+			Text: "smma",
+		},
 		"Successful MATypeSMA marshal": {
 			Type: MATypeSMA,
 			Text: "sma",
@@ -486,6 +687,10 @@ func Test_MAType_UnmarshalText(t *testing.T) {
 			Text:   "hma",
 			Result: MATypeHMA,
 		},
+		"Successful MATypeSMMA unmarshal": {
+			Text:   "smma",
+			Result: MATypeSMMA,
+		},
 		"Successful MATypeSMA unmarshal": {
 			Text:   "sma",
 			Result: MATypeSMA,
@@ -513,3 +718,751 @@ func Test_MAType_UnmarshalText(t *testing.T) {
 		})
 	}
 }
+
+func Test_Scaling_Validate(t *testing.T) {
+	cc := map[string]struct {
+		Scaling Scaling
+		Err     error
+	}{
+		"Invalid Scaling": {
+			Err: ErrInvalidScaling,
+		},
+		"Successful ScalingMinMax validation": {
+			Scaling: ScalingMinMax,
+		},
+		"Successful ScalingPercentRank validation": {
+			Scaling: ScalingPercentRank,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.Scaling.Validate()
+			assertEqualError(t, c.Err, err)
+		})
+	}
+}
+
+func Test_Scaling_MarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Scaling Scaling
+		Text    string
+		Err     error
+	}{
+		"Invalid Scaling": {
+			Err: ErrInvalidScaling,
+		},
+		"Successful ScalingMinMax marshal": {
+			Scaling: ScalingMinMax,
+			Text:    "min_max",
+		},
+		"Successful ScalingPercentRank marshal": {
+			Scaling: ScalingPercentRank,
+			Text:    "percent_rank",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Scaling.MarshalText()
+			assertEqualError(t, c.Err, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Text, string(res))
+		})
+	}
+}
+
+func Test_Scaling_UnmarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Text   string
+		Result Scaling
+		Err    error
+	}{
+		"Invalid Scaling": {
+			Err: ErrInvalidScaling,
+		},
+		"Successful ScalingMinMax unmarshal": {
+			Text:   "min_max",
+			Result: ScalingMinMax,
+		},
+		"Successful ScalingPercentRank unmarshal": {
+			Text:   "percent_rank",
+			Result: ScalingPercentRank,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			var sc Scaling
+			err := sc.UnmarshalText([]byte(c.Text))
+			assertEqualError(t, c.Err, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, sc)
+		})
+	}
+}
+
+func Test_Smoothing_Validate(t *testing.T) {
+	cc := map[string]struct {
+		Smoothing Smoothing
+		Err       error
+	}{
+		"Invalid Smoothing": {
+			Err: ErrInvalidSmoothing,
+		},
+		"Successful SmoothingWilder validation": {
+			Smoothing: SmoothingWilder,
+		},
+		"Successful SmoothingEMA validation": {
+			Smoothing: SmoothingEMA,
+		},
+		"Successful SmoothingSMA validation": {
+			Smoothing: SmoothingSMA,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.Smoothing.Validate()
+			assertEqualError(t, c.Err, err)
+		})
+	}
+}
+
+func Test_Smoothing_MarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Smoothing Smoothing
+		Text      string
+		Err       error
+	}{
+		"Invalid Smoothing": {
+			Err: ErrInvalidSmoothing,
+		},
+		"Successful SmoothingWilder marshal": {
+			Smoothing: SmoothingWilder,
+			Text:      "wilder",
+		},
+		"Successful SmoothingEMA marshal": {
+			Smoothing: SmoothingEMA,
+			Text:      "ema",
+		},
+		"Successful SmoothingSMA marshal": {
+			Smoothing: SmoothingSMA,
+			Text:      "sma",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Smoothing.MarshalText()
+			assertEqualError(t, c.Err, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Text, string(res))
+		})
+	}
+}
+
+func Test_Smoothing_UnmarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Text   string
+		Result Smoothing
+		Err    error
+	}{
+		"Invalid Smoothing": {
+			Err: ErrInvalidSmoothing,
+		},
+		"Successful SmoothingWilder unmarshal": {
+			Text:   "wilder",
+			Result: SmoothingWilder,
+		},
+		"Successful SmoothingEMA unmarshal": {
+			Text:   "ema",
+			Result: SmoothingEMA,
+		},
+		"Successful SmoothingSMA unmarshal": {
+			Text:   "sma",
+			Result: SmoothingSMA,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			var sm Smoothing
+			err := sm.UnmarshalText([]byte(c.Text))
+			assertEqualError(t, c.Err, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, sm)
+		})
+	}
+}
+
+func Test_Smoothing_count(t *testing.T) {
+	assert.Equal(t, 5, SmoothingSMA.count(5))
+	assert.Equal(t, 9, SmoothingEMA.count(5))
+	assert.Equal(t, 9, SmoothingWilder.count(5))
+}
+
+func Test_Smoothing_multiplier(t *testing.T) {
+	assert.Equal(t, "0.25", SmoothingWilder.multiplier(4).String())
+	assert.Equal(t, "0.4", SmoothingEMA.multiplier(4).String())
+}
+
+func Test_Smoothing_calc(t *testing.T) {
+	cc := map[string]struct {
+		Smoothing Smoothing
+		Data      []decimal.Decimal
+		Length    int
+		Result    decimal.Decimal
+		Error     error
+	}{
+		"Invalid smoothing": {
+			Error: ErrInvalidSmoothing,
+		},
+		"Invalid length": {
+			Smoothing: SmoothingSMA,
+			Error:     ErrInvalidDataSize,
+		},
+		"Invalid data size": {
+			Smoothing: SmoothingSMA,
+			Length:    2,
+			Data:      []decimal.Decimal{decimal.NewFromInt(1)},
+			Error:     ErrInvalidDataSize,
+		},
+		"Successful SmoothingSMA calculation": {
+			Smoothing: SmoothingSMA,
+			Length:    2,
+			Data:      []decimal.Decimal{decimal.NewFromInt(1), decimal.NewFromInt(4)},
+			Result:    decimal.RequireFromString("2.5"),
+		},
+		"Successful SmoothingEMA calculation": {
+			Smoothing: SmoothingEMA,
+			Length:    2,
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(5),
+			},
+			Result: decimal.NewFromInt(4),
+		},
+		"Successful SmoothingWilder calculation": {
+			Smoothing: SmoothingWilder,
+			Length:    2,
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(5),
+			},
+			Result: decimal.RequireFromString("3.5"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Smoothing.calc(c.Data, c.Length)
+			assertEqualError(t, c.Error, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.Round(8).String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_Input_Validate(t *testing.T) {
+	cc := map[string]struct {
+		Input Input
+		Err   error
+	}{
+		"Invalid Input": {
+			Err: ErrInvalidInput,
+		},
+		"Successful InputClose validation": {
+			Input: InputClose,
+		},
+		"Successful InputCandle validation": {
+			Input: InputCandle,
+		},
+		"Successful InputPair validation": {
+			Input: InputPair,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.Input.Validate()
+			assertEqualError(t, c.Err, err)
+		})
+	}
+}
+
+func Test_Input_MarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Input Input
+		Text  string
+		Err   error
+	}{
+		"Invalid Input": {
+			Err: ErrInvalidInput,
+		},
+		"Successful InputClose marshal": {
+			Input: InputClose,
+			Text:  "close",
+		},
+		"Successful InputCandle marshal": {
+			Input: InputCandle,
+			Text:  "candle",
+		},
+		"Successful InputPair marshal": {
+			Input: InputPair,
+			Text:  "pair",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Input.MarshalText()
+			assertEqualError(t, c.Err, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Text, string(res))
+		})
+	}
+}
+
+func Test_Input_UnmarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Text   string
+		Result Input
+		Err    error
+	}{
+		"Invalid Input": {
+			Err: ErrInvalidInput,
+		},
+		"Successful InputClose unmarshal": {
+			Text:   "close",
+			Result: InputClose,
+		},
+		"Successful InputCandle unmarshal": {
+			Text:   "candle",
+			Result: InputCandle,
+		},
+		"Successful InputPair unmarshal": {
+			Text:   "pair",
+			Result: InputPair,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			var in Input
+			err := in.UnmarshalText([]byte(c.Text))
+			assertEqualError(t, c.Err, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, in)
+		})
+	}
+}
+
+func Test_PivotMethod_Validate(t *testing.T) {
+	cc := map[string]struct {
+		PivotMethod PivotMethod
+		Err         error
+	}{
+		"Invalid PivotMethod": {
+			Err: ErrInvalidPivotMethod,
+		},
+		"Successful PivotClassic validation": {
+			PivotMethod: PivotClassic,
+		},
+		"Successful PivotFibonacci validation": {
+			PivotMethod: PivotFibonacci,
+		},
+		"Successful PivotCamarilla validation": {
+			PivotMethod: PivotCamarilla,
+		},
+		"Successful PivotWoodie validation": {
+			PivotMethod: PivotWoodie,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.PivotMethod.Validate()
+			assertEqualError(t, c.Err, err)
+		})
+	}
+}
+
+func Test_PivotMethod_MarshalText(t *testing.T) {
+	cc := map[string]struct {
+		PivotMethod PivotMethod
+		Text        string
+		Err         error
+	}{
+		"Invalid PivotMethod": {
+			Err: ErrInvalidPivotMethod,
+		},
+		"Successful PivotClassic marshal": {
+			PivotMethod: PivotClassic,
+			Text:        "classic",
+		},
+		"Successful PivotFibonacci marshal": {
+			PivotMethod: PivotFibonacci,
+			Text:        "fibonacci",
+		},
+		"Successful PivotCamarilla marshal": {
+			PivotMethod: PivotCamarilla,
+			Text:        "camarilla",
+		},
+		"Successful PivotWoodie marshal": {
+			PivotMethod: PivotWoodie,
+			Text:        "woodie",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.PivotMethod.MarshalText()
+			assertEqualError(t, c.Err, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Text, string(res))
+		})
+	}
+}
+
+func Test_PivotMethod_UnmarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Text   string
+		Result PivotMethod
+		Err    error
+	}{
+		"Invalid PivotMethod": {
+			Err: ErrInvalidPivotMethod,
+		},
+		"Successful PivotClassic unmarshal": {
+			Text:   "classic",
+			Result: PivotClassic,
+		},
+		"Successful PivotFibonacci unmarshal": {
+			Text:   "fibonacci",
+			Result: PivotFibonacci,
+		},
+		"Successful PivotCamarilla unmarshal": {
+			Text:   "camarilla",
+			Result: PivotCamarilla,
+		},
+		"Successful PivotWoodie unmarshal": {
+			Text:   "woodie",
+			Result: PivotWoodie,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			var pm PivotMethod
+			err := pm.UnmarshalText([]byte(c.Text))
+			assertEqualError(t, c.Err, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, pm)
+		})
+	}
+}
+
+func Test_Price_Validate(t *testing.T) {
+	cc := map[string]struct {
+		Price Price
+		Err   error
+	}{
+		"Invalid Price": {
+			Err: ErrInvalidPrice,
+		},
+		"Successful PriceOpen validation": {
+			Price: PriceOpen,
+		},
+		"Successful PriceHigh validation": {
+			Price: PriceHigh,
+		},
+		"Successful PriceLow validation": {
+			Price: PriceLow,
+		},
+		"Successful PriceClose validation": {
+			Price: PriceClose,
+		},
+		"Successful PriceHL2 validation": {
+			Price: PriceHL2,
+		},
+		"Successful PriceHLC3 validation": {
+			Price: PriceHLC3,
+		},
+		"Successful PriceHLCC4 validation": {
+			Price: PriceHLCC4,
+		},
+		"Successful PriceOHLC4 validation": {
+			Price: PriceOHLC4,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.Price.Validate()
+			assertEqualError(t, c.Err, err)
+		})
+	}
+}
+
+func Test_Price_MarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Price Price
+		Text  string
+		Err   error
+	}{
+		"Invalid Price": {
+			Err: ErrInvalidPrice,
+		},
+		"Successful PriceOpen marshal": {
+			Price: PriceOpen,
+			Text:  "open",
+		},
+		"Successful PriceHigh marshal": {
+			Price: PriceHigh,
+			Text:  "high",
+		},
+		"Successful PriceLow marshal": {
+			Price: PriceLow,
+			Text:  "low",
+		},
+		"Successful PriceClose marshal": {
+			Price: PriceClose,
+			Text:  "close",
+		},
+		"Successful PriceHL2 marshal": {
+			Price: PriceHL2,
+			Text:  "hl2",
+		},
+		"Successful PriceHLC3 marshal": {
+			Price: PriceHLC3,
+			Text:  "hlc3",
+		},
+		"Successful PriceHLCC4 marshal": {
+			Price: PriceHLCC4,
+			Text:  "hlcc4",
+		},
+		"Successful PriceOHLC4 marshal": {
+			Price: PriceOHLC4,
+			Text:  "ohlc4",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Price.MarshalText()
+			assertEqualError(t, c.Err, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Text, string(res))
+		})
+	}
+}
+
+func Test_Price_UnmarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Text   string
+		Result Price
+		Err    error
+	}{
+		"Invalid Price": {
+			Err: ErrInvalidPrice,
+		},
+		"Successful PriceOpen unmarshal": {
+			Text:   "open",
+			Result: PriceOpen,
+		},
+		"Successful PriceHigh unmarshal": {
+			Text:   "high",
+			Result: PriceHigh,
+		},
+		"Successful PriceLow unmarshal": {
+			Text:   "low",
+			Result: PriceLow,
+		},
+		"Successful PriceClose unmarshal": {
+			Text:   "close",
+			Result: PriceClose,
+		},
+		"Successful PriceHL2 unmarshal": {
+			Text:   "hl2",
+			Result: PriceHL2,
+		},
+		"Successful PriceHLC3 unmarshal": {
+			Text:   "hlc3",
+			Result: PriceHLC3,
+		},
+		"Successful PriceHLCC4 unmarshal": {
+			Text:   "hlcc4",
+			Result: PriceHLCC4,
+		},
+		"Successful PriceOHLC4 unmarshal": {
+			Text:   "ohlc4",
+			Result: PriceOHLC4,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			var p Price
+			err := p.UnmarshalText([]byte(c.Text))
+			assertEqualError(t, c.Err, err)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, p)
+		})
+	}
+}
+
+func Test_Price_of(t *testing.T) {
+	c := testCandles(t)[4]
+
+	assert.Equal(t, "10", PriceOpen.of(c).String())
+	assert.Equal(t, "15", PriceHigh.of(c).String())
+	assert.Equal(t, "11", PriceLow.of(c).String())
+	assert.Equal(t, "14", PriceClose.of(c).String())
+	assert.Equal(t, "13", PriceHL2.of(c).String())
+	assert.Equal(t, "13.33333333", PriceHLC3.of(c).Round(8).String())
+	assert.Equal(t, "13.5", PriceHLCC4.of(c).String())
+	assert.Equal(t, "12.5", PriceOHLC4.of(c).String())
+}
+
+// plainIndicator is an indicator that doesn't implement Describer.
+type plainIndicator struct{}
+
+// Calc returns zero.
+func (plainIndicator) Calc(_ []decimal.Decimal) (decimal.Decimal, error) {
+	return decimal.Zero, nil
+}
+
+// Count returns one.
+func (plainIndicator) Count() int {
+	return 1
+}
+
+func Test_describe(t *testing.T) {
+	assertEqualDescription(t, Description{
+		Input: InputClose,
+	}, describe(plainIndicator{}))
+
+	assertEqualDescription(t, SMA{length: 3}.Describe(), describe(SMA{length: 3}))
+}
+
+// wilderReference returns the reference data points of Wilder's RSI.
+func wilderReference(t *testing.T) []decimal.Decimal {
+	t.Helper()
+
+	ss := []string{
+		"44.3389", "44.0902", "44.1497", "43.6124", "44.3278", "44.8264",
+		"45.0955", "45.4245", "45.8433", "46.0826", "45.8931", "46.0328",
+		"45.6140", "46.2820", "46.2820", "46.0028", "46.0328", "46.4116",
+		"46.2222", "45.6439", "46.2122", "46.2521", "45.7137", "46.4515",
+		"45.7835", "45.3548", "44.0288", "44.1783", "44.2181", "44.5672",
+		"43.4205", "42.6628", "43.1314",
+	}
+
+	res := make([]decimal.Decimal, len(ss))
+
+	for i := range ss {
+		res[i] = decimal.RequireFromString(ss[i])
+	}
+
+	return res
+}